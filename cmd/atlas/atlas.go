@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -26,10 +27,16 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/commonerrors"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/root"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/config/migrations"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/telemetry"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/watchers"
 	"github.com/spf13/cobra"
 )
 
+// timeoutExitCode is used when a watch times out, matching timeout(1).
+const timeoutExitCode = 124
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func execute(ctx context.Context, rootCmd *cobra.Command) {
@@ -37,7 +44,22 @@ func execute(ctx context.Context, rootCmd *cobra.Command) {
 	rootCmd.Long += `
 
 To learn more, see our documentation: https://www.mongodb.com/docs/atlas/cli/stable/connect-atlas-cli/`
-	if cmd, err := rootCmd.ExecuteContextC(ctx); err != nil {
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	if traceErr := tracing.Finish(ctx, err); traceErr != nil {
+		rootCmd.PrintErrln("could not export traces:", traceErr)
+	}
+	if err != nil {
+		// the command reached a request that is only printed in dry run mode
+		if errors.Is(err, dryrun.ErrRequestSkipped) {
+			telemetry.FinishTrackingCommand(telemetry.TrackOptions{})
+			return
+		}
+
+		exitCode := 1
+		if errors.Is(err, watchers.ErrTimeout) {
+			exitCode = timeoutExitCode
+		}
+
 		err := commonerrors.Check(err)
 		rootCmd.PrintErrln(rootCmd.ErrPrefix(), err)
 		if !telemetry.StartedTrackingCommand() {
@@ -47,7 +69,7 @@ To learn more, see our documentation: https://www.mongodb.com/docs/atlas/cli/sta
 		telemetry.FinishTrackingCommand(telemetry.TrackOptions{
 			Err: err,
 		})
-		os.Exit(1)
+		os.Exit(exitCode)
 	}
 }
 
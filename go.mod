@@ -57,6 +57,9 @@ require (
 	go.mongodb.org/atlas-sdk/v20240530005 v20240530005.0.0
 	go.mongodb.org/atlas-sdk/v20250312023 v20250312023.1.0
 	go.mongodb.org/mongo-driver v1.17.9
+	go.opentelemetry.io/otel v1.44.0
+	go.opentelemetry.io/otel/sdk v1.44.0
+	go.opentelemetry.io/otel/trace v1.44.0
 	go.uber.org/mock v0.6.0
 	golang.org/x/mod v0.40.0
 	golang.org/x/net v0.58.0
@@ -90,8 +93,6 @@ require (
 	github.com/sergi/go-diff v1.4.0 // indirect
 	go.mongodb.org/atlas-sdk/v20250312006 v20250312006.1.0 // indirect
 	go.opencensus.io v0.24.0 // indirect
-	go.opentelemetry.io/otel v1.44.0 // indirect
-	go.opentelemetry.io/otel/trace v1.44.0 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	google.golang.org/grpc v1.83.0 // indirect
 	k8s.io/klog/v2 v2.130.1 // indirect
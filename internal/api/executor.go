@@ -22,8 +22,14 @@ import (
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-core/transport"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/retry"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -76,6 +82,7 @@ func NewDefaultExecutor(formatter ResponseFormatter) (*Executor, error) {
 	if err != nil {
 		return nil, err
 	}
+	client = dryrun.WrapClient(audit.WrapClient(retry.WrapClient(tracing.WrapClient(client))))
 
 	configWrapper := NewAuthenticatedConfigWrapper(profile)
 	commandConverter, err := NewDefaultCommandConverter(configWrapper)
@@ -99,9 +106,17 @@ func (e *Executor) ensureInitialized() {
 	}
 }
 
-func (e *Executor) ExecuteCommand(ctx context.Context, commandRequest CommandRequest) (*CommandResponse, error) {
+func (e *Executor) ExecuteCommand(ctx context.Context, commandRequest CommandRequest) (_ *CommandResponse, err error) {
 	e.ensureInitialized()
 
+	ctx, span := tracing.Start(ctx, "api "+commandRequest.Command.OperationID, trace.WithAttributes(
+		attribute.String("api.operation_id", commandRequest.Command.OperationID),
+	))
+	if commandRequest.Version != nil {
+		span.SetAttributes(attribute.String("api.version", commandRequest.Version.String()))
+	}
+	defer func() { tracing.End(span, err) }()
+
 	// Set the content type
 	if err := e.SetContentType(&commandRequest); err != nil {
 		return nil, err
@@ -129,6 +144,7 @@ func (e *Executor) ExecuteCommand(ctx context.Context, commandRequest CommandReq
 	isSuccess := httpResponse.StatusCode >= 200 && httpResponse.StatusCode < 300
 	httpCode := httpResponse.StatusCode
 	output := httpResponse.Body
+	span.SetAttributes(attribute.Int("http.response.status_code", httpCode))
 
 	response := CommandResponse{
 		IsSuccess: isSuccess,
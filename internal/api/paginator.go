@@ -0,0 +1,314 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/tools/shared/api"
+)
+
+const (
+	PageNumParameter      = "pageNum"
+	ItemsPerPageParameter = "itemsPerPage"
+	nextLinkRel           = "next"
+	// maxPages guards against APIs that never stop returning next links.
+	maxPages = 10000
+)
+
+var (
+	ErrPaginatorInvalidPageNum       = errors.New("invalid pageNum parameter")
+	ErrPaginatorFailedToDecodePage   = errors.New("failed to decode page")
+	ErrPaginatorFailedToFetchPage    = errors.New("failed to fetch page")
+	ErrPaginatorUnexpectedStatusCode = errors.New("unexpected status code while fetching page")
+	ErrPaginatorTooManyPages         = errors.New("too many pages")
+)
+
+// PaginationMode controls how the results of all pages are merged.
+type PaginationMode int
+
+const (
+	// PaginationModeMerged writes a single JSON document with a merged results array.
+	PaginationModeMerged PaginationMode = iota
+	// PaginationModeNDJSON writes every result on a separate line.
+	PaginationModeNDJSON
+)
+
+// IsPaginated returns true when the command accepts both the pageNum and itemsPerPage query parameters.
+func IsPaginated(command api.Command) bool {
+	hasPageNum := false
+	hasItemsPerPage := false
+
+	for _, parameter := range command.RequestParameters.QueryParameters {
+		switch parameter.Name {
+		case PageNumParameter:
+			hasPageNum = true
+		case ItemsPerPageParameter:
+			hasItemsPerPage = true
+		}
+	}
+
+	return hasPageNum && hasItemsPerPage
+}
+
+// Paginator follows the links/totalCount envelope of list operations and merges all pages into one output.
+type Paginator struct {
+	executor CommandExecutor
+	request  CommandRequest
+	maxItems int
+	mode     PaginationMode
+}
+
+type pageLink struct {
+	Rel  string `json:"rel"`
+	Href string `json:"href"`
+}
+
+type page struct {
+	Links      []pageLink        `json:"links"`
+	Results    []json.RawMessage `json:"results"`
+	TotalCount *int              `json:"totalCount"`
+}
+
+func (p *page) hasNextLink() bool {
+	for _, link := range p.Links {
+		if link.Rel == nextLinkRel {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameResults returns true when both pages have the exact same results, which happens when the API ignores pageNum.
+func (p *page) sameResults(other *page) bool {
+	return slices.EqualFunc(p.Results, other.Results, func(a, b json.RawMessage) bool {
+		return bytes.Equal(a, b)
+	})
+}
+
+// Create a new paginator
+//
+//   - executor: executor used to make the API calls
+//   - request: request of the first page, pageNum is used as the starting page when set
+//   - maxItems: maximum number of results to return, 0 means no limit, when set totalCount is the number of results returned
+//   - mode: how the results should be written
+func NewPaginator(executor CommandExecutor, request CommandRequest, maxItems int, mode PaginationMode) (*Paginator, error) {
+	if executor == nil {
+		return nil, errors.Join(ErrMissingDependency, errors.New("executor is nil"))
+	}
+
+	return &Paginator{
+		executor: executor,
+		request:  request,
+		maxItems: maxItems,
+		mode:     mode,
+	}, nil
+}
+
+// ExecuteCommand fetches the first page synchronously and streams the remaining pages.
+//
+// When the first page returns a non-success status code, that response is returned as is so the caller can handle it like any other response.
+// Errors happening on later pages are returned when reading from the output.
+func (p *Paginator) ExecuteCommand(ctx context.Context) (*CommandResponse, error) {
+	pageNum, err := p.startPageNum()
+	if err != nil {
+		return nil, err
+	}
+
+	firstResponse, err := p.fetch(ctx, pageNum)
+	if err != nil {
+		return nil, err
+	}
+
+	if !firstResponse.IsSuccess {
+		return firstResponse, nil
+	}
+
+	firstPage, err := decodePage(firstResponse.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(p.stream(ctx, writer, pageNum, firstPage))
+	}()
+
+	return &CommandResponse{
+		IsSuccess: true,
+		HTTPCode:  firstResponse.HTTPCode,
+		Output:    reader,
+	}, nil
+}
+
+func (p *Paginator) startPageNum() (int, error) {
+	values, ok := p.request.Parameters[PageNumParameter]
+	if !ok || len(values) == 0 {
+		return 1, nil
+	}
+
+	pageNum, err := strconv.Atoi(values[0])
+	if err != nil || pageNum < 1 {
+		return 0, fmt.Errorf("%w: %s", ErrPaginatorInvalidPageNum, values[0])
+	}
+
+	return pageNum, nil
+}
+
+func (p *Paginator) fetch(ctx context.Context, pageNum int) (*CommandResponse, error) {
+	request := p.request
+	request.Parameters = make(map[string][]string, len(p.request.Parameters)+1)
+	for key, values := range p.request.Parameters {
+		// the request converter escapes values in place, so every page gets its own copy
+		request.Parameters[key] = slices.Clone(values)
+	}
+	request.Parameters[PageNumParameter] = []string{strconv.Itoa(pageNum)}
+
+	response, err := p.executor.ExecuteCommand(ctx, request)
+	if err != nil {
+		return nil, errors.Join(ErrPaginatorFailedToFetchPage, err)
+	}
+
+	return response, nil
+}
+
+func decodePage(output io.ReadCloser) (*page, error) {
+	defer output.Close()
+
+	var result page
+	if err := json.NewDecoder(output).Decode(&result); err != nil {
+		return nil, errors.Join(ErrPaginatorFailedToDecodePage, err)
+	}
+
+	return &result, nil
+}
+
+// stream writes the current page and keeps fetching pages until one of the following happens:
+// - the page has no results
+// - the page has the same results as the previous one
+// - the page has no next link and all items from totalCount have been returned
+// - maxItems has been reached.
+func (p *Paginator) stream(ctx context.Context, w io.Writer, pageNum int, current *page) error {
+	written := 0
+	fetched := 1
+	totalCount := current.TotalCount
+
+	if p.mode == PaginationModeMerged {
+		if _, err := io.WriteString(w, `{"results":[`); err != nil {
+			return err
+		}
+	}
+
+	for {
+		for _, result := range current.Results {
+			if p.maxItems > 0 && written >= p.maxItems {
+				break
+			}
+
+			if err := p.writeResult(w, result, written); err != nil {
+				return err
+			}
+			written++
+		}
+
+		if !p.hasMore(current, written, totalCount) {
+			break
+		}
+
+		if fetched >= maxPages {
+			return fmt.Errorf("%w: stopped after %d pages", ErrPaginatorTooManyPages, fetched)
+		}
+
+		pageNum++
+		fetched++
+		response, err := p.fetch(ctx, pageNum)
+		if err != nil {
+			return err
+		}
+
+		if !response.IsSuccess {
+			_ = response.Output.Close()
+			return fmt.Errorf("%w: %d (pageNum=%d)", ErrPaginatorUnexpectedStatusCode, response.HTTPCode, pageNum)
+		}
+
+		next, err := decodePage(response.Output)
+		if err != nil {
+			return err
+		}
+
+		if next.sameResults(current) {
+			break
+		}
+		current = next
+	}
+
+	if p.mode == PaginationModeNDJSON {
+		return nil
+	}
+
+	// maxItems can stop before all results are returned, totalCount then reports the number of results returned
+	if totalCount == nil || p.maxItems > 0 {
+		totalCount = &written
+	}
+
+	_, err := fmt.Fprintf(w, `],"totalCount":%d}`, *totalCount)
+	return err
+}
+
+func (p *Paginator) hasMore(current *page, written int, totalCount *int) bool {
+	if len(current.Results) == 0 {
+		return false
+	}
+
+	if p.maxItems > 0 && written >= p.maxItems {
+		return false
+	}
+
+	if current.hasNextLink() {
+		return true
+	}
+
+	return totalCount != nil && written < *totalCount
+}
+
+func (p *Paginator) writeResult(w io.Writer, result json.RawMessage, index int) error {
+	if p.mode == PaginationModeNDJSON {
+		var buffer bytes.Buffer
+		if err := json.Compact(&buffer, result); err != nil {
+			return errors.Join(ErrPaginatorFailedToDecodePage, err)
+		}
+		buffer.WriteByte('\n')
+
+		_, err := w.Write(buffer.Bytes())
+		return err
+	}
+
+	if index > 0 {
+		if _, err := io.WriteString(w, ","); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write(result)
+	return err
+}
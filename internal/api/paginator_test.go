@@ -0,0 +1,223 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/tools/shared/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func pageResponse(httpCode int, body string) *CommandResponse {
+	return &CommandResponse{
+		IsSuccess: httpCode == http.StatusOK,
+		HTTPCode:  httpCode,
+		Output:    io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func expectPages(executor *MockCommandExecutor, pages map[string]*CommandResponse) {
+	executor.EXPECT().
+		ExecuteCommand(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request CommandRequest) (*CommandResponse, error) {
+			return pages[request.Parameters[PageNumParameter][0]], nil
+		}).
+		Times(len(pages))
+}
+
+func TestIsPaginated(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   []api.Parameter
+		expected bool
+	}{
+		{
+			name:     "no parameters",
+			expected: false,
+		},
+		{
+			name:     "only pageNum",
+			params:   []api.Parameter{{Name: PageNumParameter}},
+			expected: false,
+		},
+		{
+			name:     "pageNum and itemsPerPage",
+			params:   []api.Parameter{{Name: ItemsPerPageParameter}, {Name: "envelope"}, {Name: PageNumParameter}},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := api.Command{RequestParameters: api.RequestParameters{QueryParameters: tt.params}}
+			assert.Equal(t, tt.expected, IsPaginated(command))
+		})
+	}
+}
+
+func TestPaginatorMergesAllPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	expectPages(executor, map[string]*CommandResponse{
+		"1": pageResponse(http.StatusOK, `{"links":[{"rel":"self"},{"rel":"next"}],"results":[{"id":1},{"id":2}],"totalCount":3}`),
+		"2": pageResponse(http.StatusOK, `{"links":[{"rel":"previous"}],"results":[{"id":3}],"totalCount":3}`),
+	})
+
+	paginator, err := NewPaginator(executor, CommandRequest{Parameters: map[string][]string{}}, 0, PaginationModeMerged)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+	require.True(t, response.IsSuccess)
+
+	output, err := io.ReadAll(response.Output)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"results":[{"id":1},{"id":2},{"id":3}],"totalCount":3}`, string(output))
+}
+
+func TestPaginatorUsesTotalCountWithoutLinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	expectPages(executor, map[string]*CommandResponse{
+		"1": pageResponse(http.StatusOK, `{"results":[{"id":1}],"totalCount":2}`),
+		"2": pageResponse(http.StatusOK, `{"results":[{"id":2}],"totalCount":2}`),
+	})
+
+	paginator, err := NewPaginator(executor, CommandRequest{}, 0, PaginationModeNDJSON)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+
+	output, err := io.ReadAll(response.Output)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n", string(output))
+}
+
+func TestPaginatorMaxItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	expectPages(executor, map[string]*CommandResponse{
+		"2": pageResponse(http.StatusOK, `{"links":[{"rel":"next"}],"results":[{"id":3},{"id":4}],"totalCount":10}`),
+		"3": pageResponse(http.StatusOK, `{"links":[{"rel":"next"}],"results":[{"id":5},{"id":6}],"totalCount":10}`),
+	})
+
+	request := CommandRequest{Parameters: map[string][]string{PageNumParameter: {"2"}}}
+	paginator, err := NewPaginator(executor, request, 3, PaginationModeMerged)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+
+	output, err := io.ReadAll(response.Output)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"results":[{"id":3},{"id":4},{"id":5}],"totalCount":3}`, string(output))
+}
+
+func TestPaginatorStopsWhenPageNumIsIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	expectPages(executor, map[string]*CommandResponse{
+		"1": pageResponse(http.StatusOK, `{"links":[{"rel":"next"}],"results":[{"id":1},{"id":2}],"totalCount":10}`),
+		"2": pageResponse(http.StatusOK, `{"links":[{"rel":"next"}],"results":[{"id":1},{"id":2}],"totalCount":10}`),
+	})
+
+	paginator, err := NewPaginator(executor, CommandRequest{}, 0, PaginationModeMerged)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+
+	output, err := io.ReadAll(response.Output)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"results":[{"id":1},{"id":2}],"totalCount":10}`, string(output))
+}
+
+func TestPaginatorTooManyPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	executor.EXPECT().
+		ExecuteCommand(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request CommandRequest) (*CommandResponse, error) {
+			pageNum := request.Parameters[PageNumParameter][0]
+			return pageResponse(http.StatusOK, `{"links":[{"rel":"next"}],"results":[`+pageNum+`]}`), nil
+		}).
+		Times(maxPages)
+
+	paginator, err := NewPaginator(executor, CommandRequest{}, 0, PaginationModeNDJSON)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+
+	_, err = io.ReadAll(response.Output)
+	require.ErrorIs(t, err, ErrPaginatorTooManyPages)
+}
+
+func TestPaginatorFirstPageError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	expectPages(executor, map[string]*CommandResponse{
+		"1": pageResponse(http.StatusNotFound, `{"error":404}`),
+	})
+
+	paginator, err := NewPaginator(executor, CommandRequest{}, 0, PaginationModeMerged)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+	require.False(t, response.IsSuccess)
+
+	output, err := io.ReadAll(response.Output)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":404}`, string(output))
+}
+
+func TestPaginatorLaterPageError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+	expectPages(executor, map[string]*CommandResponse{
+		"1": pageResponse(http.StatusOK, `{"links":[{"rel":"next"}],"results":[{"id":1}]}`),
+		"2": pageResponse(http.StatusInternalServerError, `{"error":500}`),
+	})
+
+	paginator, err := NewPaginator(executor, CommandRequest{}, 0, PaginationModeMerged)
+	require.NoError(t, err)
+
+	response, err := paginator.ExecuteCommand(t.Context())
+	require.NoError(t, err)
+
+	_, err = io.ReadAll(response.Output)
+	require.ErrorIs(t, err, ErrPaginatorUnexpectedStatusCode)
+}
+
+func TestPaginatorInvalidPageNum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executor := NewMockCommandExecutor(ctrl)
+
+	request := CommandRequest{Parameters: map[string][]string{PageNumParameter: {"zero"}}}
+	paginator, err := NewPaginator(executor, request, 0, PaginationModeMerged)
+	require.NoError(t, err)
+
+	_, err = paginator.ExecuteCommand(t.Context())
+	require.ErrorIs(t, err, ErrPaginatorInvalidPageNum)
+}
@@ -26,7 +26,9 @@ import (
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/tools/shared/api"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
@@ -161,7 +163,11 @@ func buildRequestParameters(requestParams map[string][]string, responseBody []by
 // - This function does not sleep
 // - This function only fails when the HTTP call fails, not when the endpoint returns a non-OK response.
 func (w *Watcher) WatchOne(ctx context.Context) (bool, error) {
-	return watchInner(ctx, w.executor, w.expect, *w.request)
+	ctx, span := tracing.Start(ctx, "watcher poll")
+	done, err := watchInner(ctx, w.executor, w.expect, *w.request)
+	span.SetAttributes(attribute.Bool("watcher.done", done))
+	tracing.End(span, err)
+	return done, err
 }
 
 // Actual watcher logic without handling.
@@ -0,0 +1,215 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit provides an opt-in local journal of the mutating requests sent by the CLI.
+package audit
+
+import (
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	EnabledProperty = "audit_log"
+
+	dirName  = "audit"
+	fileExt  = ".jsonl"
+	redacted = "[redacted]"
+)
+
+var (
+	readMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+	}
+	sensitiveFlags = []string{
+		"password",
+		"secret",
+		"key",
+		"token",
+		"cert",
+	}
+	journal *Journal
+	current Command
+)
+
+// Command is the CLI invocation that sent a request.
+type Command struct {
+	Path      string            `json:"command"`
+	Flags     map[string]string `json:"flags,omitempty"`
+	Profile   string            `json:"profile"`
+	ProjectID string            `json:"projectId,omitempty"`
+	OrgID     string            `json:"orgId,omitempty"`
+	User      string            `json:"user,omitempty"`
+	Host      string            `json:"host,omitempty"`
+}
+
+// Entry is a journal line, one for each mutating request.
+type Entry struct {
+	Time time.Time `json:"time"`
+	Command
+	Method     string `json:"method"`
+	URL        string `json:"url"`
+	Status     int    `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// Properties returns the profile properties used to configure the journal.
+func Properties() []string {
+	return []string{EnabledProperty}
+}
+
+// IsEnabled returns true when the profile opted in to the journal.
+func IsEnabled(get func(string) string) bool {
+	return config.IsTrue(get(EnabledProperty))
+}
+
+// Path returns the journal file of a profile.
+func Path(profile string) (string, error) {
+	home, err := config.CLIConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, dirName, profile+fileExt), nil
+}
+
+// ProfileGetter is the profile context recorded with every request.
+type ProfileGetter interface {
+	Name() string
+	ProjectID() string
+	OrgID() string
+}
+
+// NewCommand describes cmd, values of flags that may hold credentials are redacted.
+func NewCommand(cmd *cobra.Command, c ProfileGetter) Command {
+	command := Command{
+		Path:      cmd.CommandPath(),
+		Profile:   c.Name(),
+		ProjectID: c.ProjectID(),
+		OrgID:     c.OrgID(),
+	}
+
+	if cmd.Flags().NFlag() > 0 {
+		command.Flags = make(map[string]string, cmd.Flags().NFlag())
+		cmd.Flags().Visit(func(f *pflag.Flag) {
+			command.Flags[f.Name] = sanitize(f)
+		})
+	}
+	if projectID, _ := cmd.Flags().GetString(flag.ProjectID); projectID != "" {
+		command.ProjectID = projectID
+	}
+	if orgID, _ := cmd.Flags().GetString(flag.OrgID); orgID != "" {
+		command.OrgID = orgID
+	}
+	if u, err := user.Current(); err == nil {
+		command.User = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		command.Host = host
+	}
+
+	return command
+}
+
+func sanitize(f *pflag.Flag) string {
+	name := strings.ToLower(f.Name)
+	if slices.ContainsFunc(sensitiveFlags, func(s string) bool { return strings.Contains(name, s) }) {
+		return redacted
+	}
+	return f.Value.String()
+}
+
+// Enable makes WrapClient record the mutating requests sent by c in j.
+func Enable(j *Journal, c Command) {
+	journal = j
+	current = c
+}
+
+// Enabled returns true when the journal is enabled.
+func Enabled() bool {
+	return journal != nil
+}
+
+// Transport records POST, PUT, PATCH and DELETE requests once they complete, read requests are not recorded.
+// Failing to write to the journal never fails the request.
+type Transport struct {
+	base    http.RoundTripper
+	journal *Journal
+	command Command
+	now     func() time.Time
+}
+
+// NewTransport wraps base, http.DefaultTransport is used when base is nil.
+func NewTransport(base http.RoundTripper, j *Journal, c Command) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		base:    base,
+		journal: j,
+		command: c,
+		now:     time.Now,
+	}
+}
+
+// WrapClient replaces the transport of the client when the journal is enabled.
+func WrapClient(client *http.Client) *http.Client {
+	if client != nil && Enabled() {
+		client.Transport = NewTransport(client.Transport, journal, current)
+	}
+	return client
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if slices.Contains(readMethods, req.Method) {
+		return t.base.RoundTrip(req)
+	}
+
+	start := t.now()
+	resp, err := t.base.RoundTrip(req)
+
+	e := &Entry{
+		Time:       start.UTC(),
+		Command:    t.command,
+		Method:     req.Method,
+		URL:        req.URL.Redacted(),
+		DurationMs: t.now().Sub(start).Milliseconds(),
+	}
+	if resp != nil {
+		e.Status = resp.StatusCode
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	if appendErr := t.journal.Append(e); appendErr != nil {
+		_, _ = log.Debugf("could not write to the audit log: %v\n", appendErr)
+	}
+
+	return resp, err
+}
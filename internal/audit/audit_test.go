@@ -0,0 +1,107 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	j := NewJournal(fs, "/audit/default.jsonl")
+	errNetwork := errors.New("connection reset")
+
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodDelete {
+			return nil, errNetwork
+		}
+		return &http.Response{StatusCode: http.StatusCreated, Request: req}, nil
+	})
+	transport := NewTransport(base, j, Command{Path: "atlas clusters create", Profile: "default"})
+	start := time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC)
+	calls := 0
+	transport.now = func() time.Time {
+		calls++
+		return start.Add(time.Duration(calls-1) * 250 * time.Millisecond)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodDelete} {
+		req := httptest.NewRequest(method, "https://cloud.mongodb.com/api/atlas/v2/groups/1/clusters", nil)
+		_, _ = transport.RoundTrip(req) //nolint:bodyclose // no body in tests
+	}
+
+	entries, err := j.Read()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, Entry{
+		Time:       start,
+		Command:    Command{Path: "atlas clusters create", Profile: "default"},
+		Method:     http.MethodPost,
+		URL:        "https://cloud.mongodb.com/api/atlas/v2/groups/1/clusters",
+		Status:     http.StatusCreated,
+		DurationMs: 250,
+	}, entries[0])
+	assert.Equal(t, http.MethodDelete, entries[1].Method)
+	assert.Equal(t, errNetwork.Error(), entries[1].Error)
+	assert.Zero(t, entries[1].Status)
+}
+
+type testProfile struct{}
+
+func (testProfile) Name() string      { return "default" }
+func (testProfile) ProjectID() string { return "5e2211c17a3e5a48f5497de3" }
+func (testProfile) OrgID() string     { return "5dd5aaef7a3e5a6c5bd12de4" }
+
+func TestNewCommand(t *testing.T) {
+	root := &cobra.Command{Use: "atlas"}
+	cmd := &cobra.Command{Use: "create"}
+	root.AddCommand(cmd)
+	cmd.Flags().String(flag.ProjectID, "", "")
+	cmd.Flags().String(flag.Password, "", "")
+	cmd.Flags().String("privateKey", "", "")
+	cmd.Flags().String("tier", "", "")
+	cmd.Flags().String(flag.OrgID, "", "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--projectId", "6e2211c17a3e5a48f5497de3", "--password", "s3cr3t", "--privateKey", "k", "--tier", "M10"}))
+
+	c := NewCommand(cmd, testProfile{})
+
+	assert.Equal(t, "atlas create", c.Path)
+	assert.Equal(t, "default", c.Profile)
+	assert.Equal(t, "6e2211c17a3e5a48f5497de3", c.ProjectID)
+	assert.Equal(t, "5dd5aaef7a3e5a6c5bd12de4", c.OrgID)
+	assert.Equal(t, map[string]string{
+		flag.ProjectID: "6e2211c17a3e5a48f5497de3",
+		flag.Password:  redacted,
+		"privateKey":   redacted,
+		"tier":         "M10",
+	}, c.Flags)
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	DefaultMaxSize  = 10 << 20 // 10 MiB
+	DefaultMaxFiles = 5
+
+	maxLineSize = 1 << 20
+)
+
+// Journal is an append-only JSONL file, rotated to <path>.1 up to <path>.<maxFiles> once it reaches maxSize.
+type Journal struct {
+	fs       afero.Fs
+	path     string
+	maxSize  int64
+	maxFiles int
+	mu       sync.Mutex
+}
+
+// NewJournal returns a journal using the default rotation settings.
+func NewJournal(fs afero.Fs, path string) *Journal {
+	return &Journal{
+		fs:       fs,
+		path:     path,
+		maxSize:  DefaultMaxSize,
+		maxFiles: DefaultMaxFiles,
+	}
+}
+
+// Append writes e as a new line, rotating the journal first when the line doesn't fit.
+func (j *Journal) Append(e *Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.fs.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+
+	if info, err := j.fs.Stat(j.path); err == nil && info.Size() > 0 && info.Size()+int64(len(line)) > j.maxSize {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+
+	f, err := j.fs.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(line)
+	return err
+}
+
+func (j *Journal) rotated(n int) string {
+	return fmt.Sprintf("%s.%d", j.path, n)
+}
+
+// rotate shifts every file by one, the oldest one is removed.
+func (j *Journal) rotate() error {
+	if err := j.fs.Remove(j.rotated(j.maxFiles)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for n := j.maxFiles - 1; n >= 1; n-- {
+		if err := j.fs.Rename(j.rotated(n), j.rotated(n+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return j.fs.Rename(j.path, j.rotated(1))
+}
+
+// Read returns every entry, oldest first. Lines that can't be parsed are skipped.
+func (j *Journal) Read() ([]Entry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var entries []Entry
+	for n := j.maxFiles; n >= 0; n-- {
+		path := j.path
+		if n > 0 {
+			path = j.rotated(n)
+		}
+		fileEntries, err := j.readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	return entries, nil
+}
+
+func (j *Journal) readFile(path string) ([]Entry, error) {
+	f, err := j.fs.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, scanner.Err()
+}
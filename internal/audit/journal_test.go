@@ -0,0 +1,89 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal_AppendRead(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	j := NewJournal(fs, "/home/audit/default.jsonl")
+
+	for _, method := range []string{"POST", "PATCH", "DELETE"} {
+		require.NoError(t, j.Append(&Entry{Time: time.Now(), Method: method, Command: Command{Path: "atlas clusters create"}}))
+	}
+
+	entries, err := j.Read()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "POST", entries[0].Method)
+	assert.Equal(t, "DELETE", entries[2].Method)
+	assert.Equal(t, "atlas clusters create", entries[2].Path)
+
+	info, err := fs.Stat("/home/audit/default.jsonl")
+	require.NoError(t, err)
+	assert.Equal(t, "-rw-------", info.Mode().Perm().String())
+}
+
+func TestJournal_Rotate(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	j := NewJournal(fs, "/audit/default.jsonl")
+	j.maxSize = 1
+	j.maxFiles = 2
+
+	for _, method := range []string{"POST", "PUT", "PATCH", "DELETE"} {
+		require.NoError(t, j.Append(&Entry{Method: method}))
+	}
+
+	for path, want := range map[string]bool{
+		"/audit/default.jsonl":   true,
+		"/audit/default.jsonl.1": true,
+		"/audit/default.jsonl.2": true,
+		"/audit/default.jsonl.3": false,
+	} {
+		exists, err := afero.Exists(fs, path)
+		require.NoError(t, err)
+		assert.Equal(t, want, exists, path)
+	}
+
+	entries, err := j.Read()
+	require.NoError(t, err)
+	methods := make([]string, 0, len(entries))
+	for _, e := range entries {
+		methods = append(methods, e.Method)
+	}
+	assert.Equal(t, []string{"PUT", "PATCH", "DELETE"}, methods)
+}
+
+func TestJournal_ReadSkipsInvalidLines(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/audit/default.jsonl", []byte("{\"method\":\"POST\"}\nnot json\n{\"method\":\"DELETE\"}\n"), 0o600))
+
+	entries, err := NewJournal(fs, "/audit/default.jsonl").Read()
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestJournal_ReadMissing(t *testing.T) {
+	entries, err := NewJournal(afero.NewMemMapFs(), "/audit/default.jsonl").Read()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
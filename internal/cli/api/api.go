@@ -41,6 +41,11 @@ var (
 	ErrAPICommandsHasNoVersions          = errors.New("api command has no versions")
 	ErrFormattingOutput                  = errors.New("error formatting output")
 	ErrRunningWatcher                    = errors.New("error while running watcher")
+	ErrPaginationRequiresJSON            = errors.New("--allPages is only supported for json output")
+	ErrNDJSONRequiresAllPages            = errors.New("--ndjson can only be used together with --allPages")
+	ErrNDJSONWithGoTemplate              = errors.New("--ndjson can't be used together with a go template output")
+	ErrMaxItemsRequiresAllPages          = errors.New("--maxItems can only be used together with --allPages")
+	ErrInvalidMaxItems                   = errors.New("--maxItems must be a positive number")
 	BinaryOutputTypes                    = []string{"gzip"}
 )
 
@@ -118,6 +123,7 @@ func convertAPIToCobraCommand(command shared_api.Command) (*cobra.Command, error
 	version, err := defaultAPIVersion(command)
 	watch := false
 	watchTimeout := int64(0)
+	pagination := paginationOpts{}
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +173,7 @@ func convertAPIToCobraCommand(command shared_api.Command) (*cobra.Command, error
 				}
 			}
 
-			return nil
+			return pagination.validate(format)
 		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			// Get the request input if needed
@@ -205,7 +211,8 @@ func convertAPIToCobraCommand(command shared_api.Command) (*cobra.Command, error
 			// Execute the api command request
 			// This function will return an error if the http request failed
 			// When the http request returns a non-success code error will still be nil
-			result, err := executor.ExecuteCommand(cmd.Context(), *commandRequest)
+			// When --allPages is set, all pages are fetched and merged into a single output
+			result, err := pagination.execute(cmd.Context(), executor, *commandRequest)
 			if err != nil {
 				return err
 			}
@@ -271,7 +278,7 @@ func convertAPIToCobraCommand(command shared_api.Command) (*cobra.Command, error
 				}
 
 				// Wait until we're in the desired state or until an error occures when watching
-				if err := watcher.Wait(cmd.Context(), time.Duration(watchTimeout)); err != nil {
+				if err := watcher.Wait(cmd.Context(), time.Duration(watchTimeout)*time.Second); err != nil {
 					return errors.Join(ErrRunningWatcher, err)
 				}
 			}
@@ -290,6 +297,7 @@ func convertAPIToCobraCommand(command shared_api.Command) (*cobra.Command, error
 
 	// Common flags
 	addWatchFlagIfNeeded(cmd, command, &watch, &watchTimeout)
+	addPaginationFlagsIfNeeded(cmd, command, &pagination)
 	addVersionFlag(cmd, command, &version)
 
 	if needsFileFlag(command) {
@@ -0,0 +1,101 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/api"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	shared_api "github.com/mongodb/mongodb-atlas-cli/atlascli/tools/shared/api"
+	"github.com/spf13/cobra"
+)
+
+const jsonContentType = "json"
+
+// paginationOpts holds the values of the pagination flags of a generated api command.
+type paginationOpts struct {
+	allPages bool
+	maxItems int
+	ndjson   bool
+}
+
+func (opts *paginationOpts) mode() api.PaginationMode {
+	if opts.ndjson {
+		return api.PaginationModeNDJSON
+	}
+
+	return api.PaginationModeMerged
+}
+
+// validate makes sure the pagination flags are compatible with the selected output format.
+func (opts *paginationOpts) validate(format string) error {
+	if opts.maxItems < 0 {
+		return ErrInvalidMaxItems
+	}
+
+	if !opts.allPages {
+		if opts.ndjson {
+			return ErrNDJSONRequiresAllPages
+		}
+
+		if opts.maxItems > 0 {
+			return ErrMaxItemsRequiresAllPages
+		}
+
+		return nil
+	}
+
+	contentType, err := api.NewFormatter().ContentType(format)
+	if err != nil {
+		return err
+	}
+
+	if contentType != jsonContentType {
+		return ErrPaginationRequiresJSON
+	}
+
+	// A go template is applied on the merged document, which doesn't exist in NDJSON mode
+	if opts.ndjson && format != jsonContentType {
+		return ErrNDJSONWithGoTemplate
+	}
+
+	return nil
+}
+
+// execute runs the command request once, or follows all pages when --allPages is set.
+func (opts *paginationOpts) execute(ctx context.Context, executor api.CommandExecutor, commandRequest api.CommandRequest) (*api.CommandResponse, error) {
+	if !opts.allPages {
+		return executor.ExecuteCommand(ctx, commandRequest)
+	}
+
+	paginator, err := api.NewPaginator(executor, commandRequest, opts.maxItems, opts.mode())
+	if err != nil {
+		return nil, err
+	}
+
+	return paginator.ExecuteCommand(ctx)
+}
+
+func addPaginationFlagsIfNeeded(cmd *cobra.Command, apiCommand shared_api.Command, opts *paginationOpts) {
+	if !api.IsPaginated(apiCommand) {
+		return
+	}
+
+	cmd.Flags().BoolVar(&opts.allPages, flag.AllPages, false, usage.AllPages)
+	cmd.Flags().IntVar(&opts.maxItems, flag.MaxItems, 0, usage.MaxItems)
+	cmd.Flags().BoolVar(&opts.ndjson, flag.NDJSON, false, usage.NDJSON)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	shared_api "github.com/mongodb/mongodb-atlas-cli/atlascli/tools/shared/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginationOptsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    paginationOpts
+		format  string
+		wantErr error
+	}{
+		{
+			name:   "pagination disabled",
+			opts:   paginationOpts{},
+			format: "csv",
+		},
+		{
+			name:   "all pages with json",
+			opts:   paginationOpts{allPages: true, maxItems: 10},
+			format: "json",
+		},
+		{
+			name:   "all pages with go template",
+			opts:   paginationOpts{allPages: true},
+			format: "{{.totalCount}}",
+		},
+		{
+			name:   "ndjson with json",
+			opts:   paginationOpts{allPages: true, ndjson: true},
+			format: "json",
+		},
+		{
+			name:    "all pages with csv",
+			opts:    paginationOpts{allPages: true},
+			format:  "csv",
+			wantErr: ErrPaginationRequiresJSON,
+		},
+		{
+			name:    "ndjson without all pages",
+			opts:    paginationOpts{ndjson: true},
+			format:  "json",
+			wantErr: ErrNDJSONRequiresAllPages,
+		},
+		{
+			name:    "max items without all pages",
+			opts:    paginationOpts{maxItems: 10},
+			format:  "json",
+			wantErr: ErrMaxItemsRequiresAllPages,
+		},
+		{
+			name:    "negative max items",
+			opts:    paginationOpts{allPages: true, maxItems: -1},
+			format:  "json",
+			wantErr: ErrInvalidMaxItems,
+		},
+		{
+			name:    "ndjson with go template",
+			opts:    paginationOpts{allPages: true, ndjson: true},
+			format:  "{{.totalCount}}",
+			wantErr: ErrNDJSONWithGoTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.validate(tt.format)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAddPaginationFlagsIfNeeded(t *testing.T) {
+	paginated := shared_api.Command{
+		RequestParameters: shared_api.RequestParameters{
+			QueryParameters: []shared_api.Parameter{
+				{Name: "itemsPerPage"},
+				{Name: "pageNum"},
+			},
+		},
+	}
+
+	cmd := &cobra.Command{}
+	addPaginationFlagsIfNeeded(cmd, paginated, &paginationOpts{})
+	assert.NotNil(t, cmd.Flag(flag.AllPages))
+	assert.NotNil(t, cmd.Flag(flag.MaxItems))
+	assert.NotNil(t, cmd.Flag(flag.NDJSON))
+
+	cmd = &cobra.Command{}
+	addPaginationFlagsIfNeeded(cmd, shared_api.Command{}, &paginationOpts{})
+	assert.Nil(t, cmd.Flag(flag.AllPages))
+}
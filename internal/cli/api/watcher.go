@@ -16,13 +16,13 @@ package api
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/api"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/terminal"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/watchers"
 	shared_api "github.com/mongodb/mongodb-atlas-cli/atlascli/tools/shared/api"
 )
 
@@ -71,35 +71,29 @@ func (w *Watcher) Wait(ctx context.Context, timeout time.Duration) error {
 
 	// If timeout is 0, use the original context without timeout
 	// Otherwise, create a new context with timeout
-	if timeout > 0 {
-		watchCtx, cancel := context.WithTimeout(ctx, timeout)
-		defer cancel()
-		ctx = watchCtx
-	}
+	ctx, cancel := watchers.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	// Keep calling WatchOne until one of the following events happens
 	// - watcher completes successfully
 	// - watcher returns an error
-	// - the context is cancelled (example user presses crtl+c)
+	// - the context is cancelled (example user presses crtl+c) or times out
 	for {
-		select {
-		case <-ctx.Done():
-			// Handle both timeout and cancellation
-			if ctx.Err() == context.DeadlineExceeded {
-				return fmt.Errorf("watching operation timed out after %s", timeout)
-			}
-			return ctx.Err()
-		default:
-			done, err := w.apiWatcher.WatchOne(ctx)
-			if err != nil {
-				return err
-			}
-
-			if done {
-				return nil
-			}
-
-			time.Sleep(watchInterval)
+		if err := ctx.Err(); err != nil {
+			return watchers.TimeoutError(err, timeout)
+		}
+
+		done, err := w.apiWatcher.WatchOne(ctx)
+		if err != nil {
+			return watchers.TimeoutError(err, timeout)
+		}
+
+		if done {
+			return nil
+		}
+
+		if err := watchers.Sleep(ctx, watchInterval); err != nil {
+			return watchers.TimeoutError(err, timeout)
 		}
 	}
 }
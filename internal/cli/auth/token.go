@@ -20,22 +20,33 @@ import (
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/jsonwriter"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/plugin"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
 	"github.com/spf13/cobra"
 )
 
 //go:generate go tool go.uber.org/mock/mockgen -typed -destination=token_mock_test.go -package=auth -source=token.go
 
 type TokenConfig interface {
+	plugin.ContextConfig
 	AccessToken() string
-	Name() string
 }
 
 type tokenOpts struct {
 	cli.OutputOpts
-	config TokenConfig
+	cli.RefresherOpts
+	config    TokenConfig
+	forPlugin bool
 }
 
 func (opts *tokenOpts) Run() error {
+	if opts.forPlugin {
+		// The plugin context is always JSON so plugins can parse it regardless of the output settings.
+		return jsonwriter.Print(opts.ConfigWriter(), plugin.NewContext(opts.config))
+	}
+
 	accessToken := opts.config.AccessToken()
 	if accessToken == "" {
 		return fmt.Errorf("no access token found for profile %s", opts.config.Name())
@@ -59,6 +70,9 @@ func TokenBuilder() *cobra.Command {
 
   # Return the token for a specific profile:
   atlas auth token --profile <profile_name>
+
+  # Return the profile, project, organization, output format, base URL and a refreshed token of the current profile as JSON, for plugins:
+  atlas auth token --forPlugin
   `,
 		Args: require.NoArgs,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
@@ -70,13 +84,22 @@ func TokenBuilder() *cobra.Command {
 			} else {
 				opts.config = config.Default()
 			}
+			if opts.forPlugin {
+				return opts.InitFlow(config.Default())()
+			}
 			return nil
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.forPlugin {
+				if err := opts.RefreshAccessToken(cmd.Context()); err != nil {
+					return err
+				}
+			}
 			return opts.Run()
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.forPlugin, flag.ForPlugin, false, usage.ForPlugin)
 	opts.AddOutputOptFlags(cmd)
 
 	return cmd
@@ -12,6 +12,8 @@ package auth
 import (
 	reflect "reflect"
 
+	config "github.com/mongodb/atlas-cli-core/config"
+	auth "go.mongodb.org/atlas/auth"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -77,6 +79,44 @@ func (c *MockTokenConfigAccessTokenCall) DoAndReturn(f func() string) *MockToken
 	return c
 }
 
+// AuthType mocks base method.
+func (m *MockTokenConfig) AuthType() config.AuthMechanism {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthType")
+	ret0, _ := ret[0].(config.AuthMechanism)
+	return ret0
+}
+
+// AuthType indicates an expected call of AuthType.
+func (mr *MockTokenConfigMockRecorder) AuthType() *MockTokenConfigAuthTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthType", reflect.TypeOf((*MockTokenConfig)(nil).AuthType))
+	return &MockTokenConfigAuthTypeCall{Call: call}
+}
+
+// MockTokenConfigAuthTypeCall wrap *gomock.Call
+type MockTokenConfigAuthTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigAuthTypeCall) Return(arg0 config.AuthMechanism) *MockTokenConfigAuthTypeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigAuthTypeCall) Do(f func() config.AuthMechanism) *MockTokenConfigAuthTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigAuthTypeCall) DoAndReturn(f func() config.AuthMechanism) *MockTokenConfigAuthTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Name mocks base method.
 func (m *MockTokenConfig) Name() string {
 	m.ctrl.T.Helper()
@@ -114,3 +154,232 @@ func (c *MockTokenConfigNameCall) DoAndReturn(f func() string) *MockTokenConfigN
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// OpsManagerURL mocks base method.
+func (m *MockTokenConfig) OpsManagerURL() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpsManagerURL")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OpsManagerURL indicates an expected call of OpsManagerURL.
+func (mr *MockTokenConfigMockRecorder) OpsManagerURL() *MockTokenConfigOpsManagerURLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpsManagerURL", reflect.TypeOf((*MockTokenConfig)(nil).OpsManagerURL))
+	return &MockTokenConfigOpsManagerURLCall{Call: call}
+}
+
+// MockTokenConfigOpsManagerURLCall wrap *gomock.Call
+type MockTokenConfigOpsManagerURLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigOpsManagerURLCall) Return(arg0 string) *MockTokenConfigOpsManagerURLCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigOpsManagerURLCall) Do(f func() string) *MockTokenConfigOpsManagerURLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigOpsManagerURLCall) DoAndReturn(f func() string) *MockTokenConfigOpsManagerURLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrgID mocks base method.
+func (m *MockTokenConfig) OrgID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OrgID indicates an expected call of OrgID.
+func (mr *MockTokenConfigMockRecorder) OrgID() *MockTokenConfigOrgIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgID", reflect.TypeOf((*MockTokenConfig)(nil).OrgID))
+	return &MockTokenConfigOrgIDCall{Call: call}
+}
+
+// MockTokenConfigOrgIDCall wrap *gomock.Call
+type MockTokenConfigOrgIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigOrgIDCall) Return(arg0 string) *MockTokenConfigOrgIDCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigOrgIDCall) Do(f func() string) *MockTokenConfigOrgIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigOrgIDCall) DoAndReturn(f func() string) *MockTokenConfigOrgIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Output mocks base method.
+func (m *MockTokenConfig) Output() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Output")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Output indicates an expected call of Output.
+func (mr *MockTokenConfigMockRecorder) Output() *MockTokenConfigOutputCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Output", reflect.TypeOf((*MockTokenConfig)(nil).Output))
+	return &MockTokenConfigOutputCall{Call: call}
+}
+
+// MockTokenConfigOutputCall wrap *gomock.Call
+type MockTokenConfigOutputCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigOutputCall) Return(arg0 string) *MockTokenConfigOutputCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigOutputCall) Do(f func() string) *MockTokenConfigOutputCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigOutputCall) DoAndReturn(f func() string) *MockTokenConfigOutputCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectID mocks base method.
+func (m *MockTokenConfig) ProjectID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProjectID indicates an expected call of ProjectID.
+func (mr *MockTokenConfigMockRecorder) ProjectID() *MockTokenConfigProjectIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectID", reflect.TypeOf((*MockTokenConfig)(nil).ProjectID))
+	return &MockTokenConfigProjectIDCall{Call: call}
+}
+
+// MockTokenConfigProjectIDCall wrap *gomock.Call
+type MockTokenConfigProjectIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigProjectIDCall) Return(arg0 string) *MockTokenConfigProjectIDCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigProjectIDCall) Do(f func() string) *MockTokenConfigProjectIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigProjectIDCall) DoAndReturn(f func() string) *MockTokenConfigProjectIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Service mocks base method.
+func (m *MockTokenConfig) Service() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockTokenConfigMockRecorder) Service() *MockTokenConfigServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockTokenConfig)(nil).Service))
+	return &MockTokenConfigServiceCall{Call: call}
+}
+
+// MockTokenConfigServiceCall wrap *gomock.Call
+type MockTokenConfigServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigServiceCall) Return(arg0 string) *MockTokenConfigServiceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigServiceCall) Do(f func() string) *MockTokenConfigServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigServiceCall) DoAndReturn(f func() string) *MockTokenConfigServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Token mocks base method.
+func (m *MockTokenConfig) Token() (*auth.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(*auth.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockTokenConfigMockRecorder) Token() *MockTokenConfigTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockTokenConfig)(nil).Token))
+	return &MockTokenConfigTokenCall{Call: call}
+}
+
+// MockTokenConfigTokenCall wrap *gomock.Call
+type MockTokenConfigTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTokenConfigTokenCall) Return(arg0 *auth.Token, arg1 error) *MockTokenConfigTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTokenConfigTokenCall) Do(f func() (*auth.Token, error)) *MockTokenConfigTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTokenConfigTokenCall) DoAndReturn(f func() (*auth.Token, error)) *MockTokenConfigTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/mongodb/atlas-cli-core/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	atlasauth "go.mongodb.org/atlas/auth"
 	"go.uber.org/mock/gomock"
 )
 
@@ -65,6 +66,39 @@ func Test_tokenOpts_Run_NoToken(t *testing.T) {
 	assert.Contains(t, err.Error(), "no access token found for profile test-profile")
 }
 
+func Test_tokenOpts_Run_ForPlugin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConfig := NewMockTokenConfig(ctrl)
+	buf := new(bytes.Buffer)
+
+	opts := &tokenOpts{
+		config:    mockConfig,
+		forPlugin: true,
+	}
+	opts.OutWriter = buf
+
+	mockConfig.EXPECT().Name().Return("test-profile").Times(1)
+	mockConfig.EXPECT().ProjectID().Return("5e2211c17a3e5a48f5497de3").Times(1)
+	mockConfig.EXPECT().OrgID().Return("").Times(1)
+	mockConfig.EXPECT().Output().Return("").Times(1)
+	mockConfig.EXPECT().OpsManagerURL().Return("").Times(1)
+	mockConfig.EXPECT().Service().Return(config.CloudService).Times(1)
+	mockConfig.EXPECT().AuthType().Return(config.ServiceAccount).AnyTimes()
+	mockConfig.EXPECT().Token().Return(&atlasauth.Token{AccessToken: "test-access-token"}, nil).Times(1)
+
+	err := opts.Run()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "profile": "test-profile",
+  "projectId": "5e2211c17a3e5a48f5497de3",
+  "baseUrl": "https://cloud.mongodb.com/",
+  "authType": "service_account",
+  "accessToken": "test-access-token"
+}`, buf.String())
+}
+
 func TestTokenBuilder_PreRunE_DefaultConfig(t *testing.T) {
 	cmd := TokenBuilder()
 
@@ -64,7 +64,7 @@ func TestDisableOpts_Run(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 	assert.False(t, opts.policy.GetCopyProtectionEnabled())
 }
 
@@ -94,5 +94,5 @@ func TestDisableOpts_WatchRun(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 }
@@ -69,14 +69,14 @@ func (opts *EnableOpts) watcher() (any, bool, error) {
 	return nil, res.GetState() == active, nil
 }
 
-func (opts *EnableOpts) Run() error {
+func (opts *EnableOpts) Run(ctx context.Context) error {
 	res, err := opts.store.EnableCopyProtection(opts.ConfigProjectID())
 	if err != nil {
 		return fmt.Errorf("couldn't enable copy protection: %w", err)
 	}
 	opts.policy = res
 	if opts.EnableWatch {
-		if _, err := opts.Watch(opts.watcher); err != nil {
+		if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 			return err
 		}
 		opts.Template = enableWatchTemplate
@@ -98,8 +98,8 @@ func EnableBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), enableTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -64,7 +64,7 @@ func TestEnableOpts_Run(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	if err := opts.Run(); err != nil {
+	if err := opts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 	assert.True(t, opts.policy.GetCopyProtectionEnabled())
@@ -96,7 +96,7 @@ func TestEnableOpts_WatchRun(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	if err := opts.Run(); err != nil {
+	if err := opts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 
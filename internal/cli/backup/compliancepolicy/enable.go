@@ -85,7 +85,7 @@ func newEnableConfirmationQuestion() survey.Prompt {
 	}
 }
 
-func (opts *EnableOpts) Run() error {
+func (opts *EnableOpts) Run(ctx context.Context) error {
 	if _, err := mail.ParseAddress(opts.authorizedEmail); err != nil {
 		return fmt.Errorf("unable to enable compliance policy due to invalid email: %w", err)
 	}
@@ -105,7 +105,7 @@ func (opts *EnableOpts) Run() error {
 		return fmt.Errorf("couldn't enable compliance policy: %w", err)
 	}
 	if opts.EnableWatch {
-		if _, err := opts.Watch(opts.enableWatcher); err != nil {
+		if _, err := opts.WatchContext(ctx, opts.enableWatcher); err != nil {
 			return err
 		}
 		opts.Template = enableWatchTemplate
@@ -126,8 +126,8 @@ func EnableBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), enableTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -79,7 +79,7 @@ func TestEnableOpts_Run(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	if err := opts.Run(); err != nil {
+	if err := opts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 	test.VerifyOutputTemplate(t, enableTemplate, expected)
@@ -92,5 +92,5 @@ func TestEnableOpts_Run_invalidEmail(t *testing.T) {
 		authorizedEmail: invalidEmail,
 	}
 
-	require.Error(t, opts.Run())
+	require.Error(t, opts.Run(t.Context()))
 }
@@ -69,14 +69,14 @@ func (opts *DisableOpts) watcher() (any, bool, error) {
 	return nil, res.GetState() == active, nil
 }
 
-func (opts *DisableOpts) Run() error {
+func (opts *DisableOpts) Run(ctx context.Context) error {
 	res, err := opts.store.DisableEncryptionAtRest(opts.ConfigProjectID())
 	if err != nil {
 		return fmt.Errorf("couldn't disable encryption at rest: %w", err)
 	}
 	opts.policy = res
 	if opts.EnableWatch {
-		if _, err := opts.Watch(opts.watcher); err != nil {
+		if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 			return err
 		}
 		opts.Template = disableWatchTemplate
@@ -98,8 +98,8 @@ func DisableBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), disableTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -66,7 +66,7 @@ func TestDisableOpts_Run(t *testing.T) {
 		DisableEncryptionAtRest(opts.ProjectID).
 		Return(expected, nil).
 		Times(1)
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 	assert.False(t, *opts.policy.EncryptionAtRestEnabled)
 	test.VerifyOutputTemplate(t, disableTemplate, expected)
 }
@@ -96,6 +96,6 @@ func TestDisableOpts_WatchRun(t *testing.T) {
 		DescribeCompliancePolicy(opts.ProjectID).
 		Return(expected, nil).
 		Times(1)
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 	test.VerifyOutputTemplate(t, disableWatchTemplate, expected)
 }
@@ -76,7 +76,7 @@ func TestEnableOpts_Run(t *testing.T) {
 
 	assert.False(t, *opts.policy.EncryptionAtRestEnabled)
 
-	if err := opts.Run(); err != nil {
+	if err := opts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 	assert.True(t, *opts.policy.EncryptionAtRestEnabled)
@@ -109,7 +109,7 @@ func TestEnableOpts_WatchRun(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	if err := opts.Run(); err != nil {
+	if err := opts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 
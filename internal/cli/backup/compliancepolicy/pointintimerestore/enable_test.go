@@ -77,7 +77,7 @@ func TestEnableOpts_Run(t *testing.T) {
 
 	assert.False(t, *opts.policy.PitEnabled)
 
-	if err := opts.Run(); err != nil {
+	if err := opts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 	assert.True(t, *opts.policy.PitEnabled)
@@ -111,7 +111,7 @@ func TestEnableOpts_WatchRun(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 	test.VerifyOutputTemplate(t, enableWatchTemplate, expected)
 }
 
@@ -69,7 +69,7 @@ func (opts *CreateOpts) watcher() (any, bool, error) {
 	return nil, res.GetState() == active, nil
 }
 
-func (opts *CreateOpts) Run() (err error) {
+func (opts *CreateOpts) Run(ctx context.Context) (err error) {
 	policyItem := &atlasv2.BackupComplianceOnDemandPolicyItem{
 		FrequencyType:  onDemandFrequencyType,
 		RetentionUnit:  opts.retentionUnit,
@@ -81,7 +81,7 @@ func (opts *CreateOpts) Run() (err error) {
 	}
 
 	if opts.EnableWatch {
-		if _, errW := opts.Watch(opts.watcher); errW != nil {
+		if _, errW := opts.WatchContext(ctx, opts.watcher); errW != nil {
 			return fmt.Errorf("received an error while watching for completion: %w", errW)
 		}
 		opts.Template = updateWatchTemplate
@@ -103,8 +103,8 @@ func CreateBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), updateTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -45,7 +45,7 @@ func TestCreateOpts_Run(t *testing.T) {
 		EXPECT().
 		CreateOnDemandPolicy("", policyItem).Return(expected, nil).
 		Times(1)
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 }
 
 func TestCreateTemplate(t *testing.T) {
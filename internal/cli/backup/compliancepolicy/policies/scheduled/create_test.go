@@ -48,7 +48,7 @@ func TestCreateOpts_Run(t *testing.T) {
 		CreateScheduledPolicy("", policyItem).Return(expected, nil).
 		Times(1)
 
-	if err := createOpts.Run(); err != nil {
+	if err := createOpts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
@@ -103,7 +103,7 @@ func newSetupConfirmationQuestion() survey.Prompt {
 	}
 }
 
-func (opts *SetupOpts) Run() error {
+func (opts *SetupOpts) Run(ctx context.Context) error {
 	if !opts.confirm {
 		question := newSetupConfirmationQuestion()
 		var confirmation bool
@@ -119,7 +119,7 @@ func (opts *SetupOpts) Run() error {
 		return err
 	}
 	if opts.EnableWatch {
-		if _, err := opts.Watch(opts.setupWatcher); err != nil {
+		if _, err := opts.WatchContext(ctx, opts.setupWatcher); err != nil {
 			return err
 		}
 		opts.Template = setupWatchTemplate
@@ -145,11 +145,11 @@ func SetupBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), setupTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := file.Load(opts.fs, opts.path, opts.policy); err != nil {
 				return err
 			}
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 
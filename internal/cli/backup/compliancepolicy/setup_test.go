@@ -86,7 +86,7 @@ func TestSetupOpts_Run(t *testing.T) {
 				Return(expected, nil).
 				Times(1)
 
-			require.NoError(t, opts.Run())
+			require.NoError(t, opts.Run(t.Context()))
 			test.VerifyOutputTemplate(t, setupTemplate, expected)
 		})
 	}
@@ -122,6 +122,6 @@ func TestSetupOpts_WatchRun(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, opts.Run())
+	require.NoError(t, opts.Run(t.Context()))
 	test.VerifyOutputTemplate(t, setupWatchTemplate, expected)
 }
@@ -62,8 +62,8 @@ func (opts *WatchOpts) watcher() (any, bool, error) {
 	return result, strings.EqualFold(result.GetState(), successfulState) || strings.EqualFold(result.GetState(), failedState) || strings.EqualFold(result.GetState(), cancelledState), nil
 }
 
-func (opts *WatchOpts) Run() error {
-	result, err := opts.Watch(opts.watcher)
+func (opts *WatchOpts) Run(ctx context.Context) error {
+	result, err := opts.WatchContext(ctx, opts.watcher)
 	if err != nil {
 		return err
 	}
@@ -109,9 +109,9 @@ You can interrupt the command's polling at any time with CTRL-C.
 				opts.InitOutput(cmd.OutOrStdout(), watchTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 
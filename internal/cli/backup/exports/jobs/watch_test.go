@@ -42,7 +42,7 @@ func TestWatchOpts_Run(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	if err := watchOpts.Run(); err != nil {
+	if err := watchOpts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
@@ -85,16 +85,16 @@ func (opts *WatchOpts) watcherFlexCluster() (any, bool, error) {
 	return result, strings.EqualFold(result.GetStatus(), completedStatus) || strings.EqualFold(result.GetStatus(), failedStatus), nil
 }
 
-func (opts *WatchOpts) Run() error {
+func (opts *WatchOpts) Run(ctx context.Context) error {
 	if opts.isFlexCluster {
-		return opts.RunFlexCluster()
+		return opts.RunFlexCluster(ctx)
 	}
 
-	return opts.RunDedicatedCluster()
+	return opts.RunDedicatedCluster(ctx)
 }
 
-func (opts *WatchOpts) RunFlexCluster() error {
-	result, err := opts.Watch(opts.watcherFlexCluster)
+func (opts *WatchOpts) RunFlexCluster(ctx context.Context) error {
+	result, err := opts.WatchContext(ctx, opts.watcherFlexCluster)
 	if err != nil {
 		return err
 	}
@@ -111,8 +111,8 @@ func (opts *WatchOpts) RunFlexCluster() error {
 	return opts.Print(result)
 }
 
-func (opts *WatchOpts) RunDedicatedCluster() error {
-	result, err := opts.Watch(opts.watcher)
+func (opts *WatchOpts) RunDedicatedCluster(ctx context.Context) error {
+	result, err := opts.WatchContext(ctx, opts.watcher)
 	if err != nil {
 		return err
 	}
@@ -184,12 +184,12 @@ You can interrupt the command's polling at any time with CTRL-C.`,
 				opts.InitOutput(cmd.OutOrStdout(), watchTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
 			if err := opts.newIsFlexCluster(); err != nil {
 				return nil
 			}
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 
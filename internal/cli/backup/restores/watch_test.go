@@ -46,7 +46,7 @@ func TestWatchOpts_Run(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	if err := describeOpts.Run(); err != nil {
+	if err := describeOpts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
@@ -70,5 +70,5 @@ func TestWatch_Run_FlexCluster(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, watchOpts.Run())
+	require.NoError(t, watchOpts.Run(t.Context()))
 }
@@ -42,7 +42,7 @@ func TestWatch_Run(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, watchOpts.Run())
+	require.NoError(t, watchOpts.Run(t.Context()))
 }
 
 func TestWatch_Run_FlexCluster(t *testing.T) {
@@ -64,5 +64,5 @@ func TestWatch_Run_FlexCluster(t *testing.T) {
 		Return(expected, nil).
 		Times(1)
 
-	require.NoError(t, watchOpts.Run())
+	require.NoError(t, watchOpts.Run(t.Context()))
 }
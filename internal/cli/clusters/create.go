@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
@@ -194,19 +193,19 @@ func (opts *CreateOpts) RunDedicatedCluster() error {
 	return err
 }
 
-func (opts *CreateOpts) PostRun() error {
+func (opts *CreateOpts) PostRun(ctx context.Context) error {
 	if isIndependentShardScaling(opts.autoScalingMode) {
-		return opts.PostRunDedicatedClusterLatest()
+		return opts.PostRunDedicatedClusterLatest(ctx)
 	}
 
 	if opts.isFlexCluster {
-		return opts.PostRunFlexCluster()
+		return opts.PostRunFlexCluster(ctx)
 	}
 
-	return opts.PostRunDedicatedCluster()
+	return opts.PostRunDedicatedCluster(ctx)
 }
 
-func (opts *CreateOpts) PostRunFlexCluster() error {
+func (opts *CreateOpts) PostRunFlexCluster(ctx context.Context) error {
 	if !opts.EnableWatch {
 		return opts.Print(flexCluster)
 	}
@@ -222,15 +221,14 @@ func (opts *CreateOpts) PostRunFlexCluster() error {
 		opts.GetDefaultWait(),
 	)
 
-	watcher.Timeout = time.Duration(opts.Timeout)
-	if err := opts.WatchWatcher(watcher); err != nil {
+	if err := opts.WatchWatcherContext(ctx, watcher); err != nil {
 		return err
 	}
 
 	return opts.Print(flexCluster)
 }
 
-func (opts *CreateOpts) PostRunDedicatedClusterLatest() error {
+func (opts *CreateOpts) PostRunDedicatedClusterLatest(ctx context.Context) error {
 	if !opts.EnableWatch {
 		return opts.Print(clusterObjLatest)
 	}
@@ -245,15 +243,14 @@ func (opts *CreateOpts) PostRunDedicatedClusterLatest() error {
 		opts.GetDefaultWait(),
 	)
 
-	watcher.Timeout = time.Duration(opts.Timeout)
-	if err := opts.WatchWatcher(watcher); err != nil {
+	if err := opts.WatchWatcherContext(ctx, watcher); err != nil {
 		return err
 	}
 
 	return opts.Print(clusterObjLatest)
 }
 
-func (opts *CreateOpts) PostRunDedicatedCluster() error {
+func (opts *CreateOpts) PostRunDedicatedCluster(ctx context.Context) error {
 	if !opts.EnableWatch {
 		return opts.Print(clusterObj)
 	}
@@ -270,8 +267,7 @@ func (opts *CreateOpts) PostRunDedicatedCluster() error {
 		opts.GetDefaultWait(),
 	)
 
-	watcher.Timeout = time.Duration(opts.Timeout)
-	if err := opts.WatchWatcher(watcher); err != nil {
+	if err := opts.WatchWatcherContext(ctx, watcher); err != nil {
 		return err
 	}
 
@@ -583,8 +579,8 @@ Deprecation note: the M2 and M5 tiers are now deprecated; when selecting M2 or M
 		RunE: func(_ *cobra.Command, _ []string) error {
 			return opts.Run()
 		},
-		PostRunE: func(_ *cobra.Command, _ []string) error {
-			return opts.PostRun()
+		PostRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PostRun(cmd.Context())
 		},
 		Annotations: map[string]string{
 			"nameDesc": "Name of the cluster. The cluster name cannot be changed after the cluster is created. Cluster name can contain ASCII letters, numbers, and hyphens. You must specify the cluster name argument if you don't use the --file option.",
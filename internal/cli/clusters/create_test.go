@@ -189,7 +189,7 @@ func TestCreateOpts_PostRun(t *testing.T) {
 		Times(1)
 
 	require.NoError(t, createOpts.Run())
-	require.NoError(t, createOpts.PostRun())
+	require.NoError(t, createOpts.PostRun(t.Context()))
 	assert.Contains(t, `Cluster 'ProjectBar' is being created.
 `, buf.String())
 	t.Log(buf.String())
@@ -261,7 +261,7 @@ func TestCreateOpts_PostRun_EnableWatch(t *testing.T) {
 	)
 
 	require.NoError(t, createOpts.Run())
-	require.NoError(t, createOpts.PostRun())
+	require.NoError(t, createOpts.PostRun(t.Context()))
 	assert.Contains(t, `Cluster 'ProjectBar' created successfully.
 `, buf.String())
 	t.Log(buf.String())
@@ -399,7 +399,7 @@ func TestCreateOpts_PostRunFlexCluster_EnableWatch(t *testing.T) {
 
 	require.NoError(t, createOpts.newIsFlexCluster())
 	require.NoError(t, createOpts.Run())
-	require.NoError(t, createOpts.PostRun())
+	require.NoError(t, createOpts.PostRun(t.Context()))
 	assert.Contains(t, `Cluster 'ProjectBar' created successfully.
 `, buf.String())
 	t.Log(buf.String())
@@ -485,7 +485,7 @@ func TestCreateOpts_PostRunDedicatedClusterLatest(t *testing.T) {
 		Times(1)
 
 	require.NoError(t, createOpts.Run())
-	require.NoError(t, createOpts.PostRun())
+	require.NoError(t, createOpts.PostRun(t.Context()))
 }
 
 func TestCreateOpts_PostRunDedicatedClusterLatest_EnableWatch(t *testing.T) {
@@ -552,5 +552,5 @@ func TestCreateOpts_PostRunDedicatedClusterLatest_EnableWatch(t *testing.T) {
 	)
 
 	require.NoError(t, createOpts.Run())
-	require.NoError(t, createOpts.PostRun())
+	require.NoError(t, createOpts.PostRun(t.Context()))
 }
@@ -17,7 +17,6 @@ package clusters
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
@@ -76,13 +75,13 @@ func (opts *DeleteOpts) RunFlexCluster(err error) error {
 	return opts.Delete(opts.store.DeleteFlexCluster, opts.ConfigProjectID())
 }
 
-func (opts *DeleteOpts) PostRun() error {
+func (opts *DeleteOpts) PostRun(ctx context.Context) error {
 	if !opts.EnableWatch {
 		return nil
 	}
 
 	if opts.isFlexCluster {
-		return opts.PostRunFlexCluster()
+		return opts.PostRunFlexCluster(ctx)
 	}
 
 	watcher := watchers.NewWatcher(
@@ -94,15 +93,14 @@ func (opts *DeleteOpts) PostRun() error {
 		),
 	)
 
-	watcher.Timeout = time.Duration(opts.Timeout)
-	if err := opts.WatchWatcher(watcher); err != nil {
+	if err := opts.WatchWatcherContext(ctx, watcher); err != nil {
 		return err
 	}
 
 	return opts.Print(nil)
 }
 
-func (opts *DeleteOpts) PostRunFlexCluster() error {
+func (opts *DeleteOpts) PostRunFlexCluster(ctx context.Context) error {
 	watcher := watchers.NewWatcherWithDefaultWait(
 		*watchers.ClusterDeleted,
 		watchers.NewAtlasFlexClusterStateDescriber(
@@ -113,8 +111,7 @@ func (opts *DeleteOpts) PostRunFlexCluster() error {
 		opts.GetDefaultWait(),
 	)
 
-	watcher.Timeout = time.Duration(opts.Timeout)
-	if err := opts.WatchWatcher(watcher); err != nil {
+	if err := opts.WatchWatcherContext(ctx, watcher); err != nil {
 		return err
 	}
 
@@ -160,8 +157,8 @@ Deleting a cluster also deletes any backup snapshots for that cluster.
 		RunE: func(_ *cobra.Command, _ []string) error {
 			return opts.Run()
 		},
-		PostRunE: func(_ *cobra.Command, _ []string) error {
-			return opts.PostRun()
+		PostRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PostRun(cmd.Context())
 		},
 	}
 
@@ -53,8 +53,8 @@ func (opts *WatchOpts) watcher() (any, bool, error) {
 	return nil, result.GetState() != "PENDING" && result.GetState() != "PAUSING", nil
 }
 
-func (opts *WatchOpts) Run() error {
-	if _, err := opts.Watch(opts.watcher); err != nil {
+func (opts *WatchOpts) Run(ctx context.Context) error {
+	if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 		return err
 	}
 
@@ -88,9 +88,9 @@ You can interrupt the command's polling at any time with CTRL-C.
 				opts.InitOutput(cmd.OutOrStdout(), watchTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 	cmd.Flags().StringVar(&opts.clusterName, flag.ClusterName, "", usage.ClusterName)
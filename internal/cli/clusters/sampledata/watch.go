@@ -51,8 +51,8 @@ func (opts *WatchOpts) watcher() (any, bool, error) {
 	return nil, result.State != nil && *result.State == "COMPLETED", nil
 }
 
-func (opts *WatchOpts) Run() error {
-	if _, err := opts.Watch(opts.watcher); err != nil {
+func (opts *WatchOpts) Run(ctx context.Context) error {
+	if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 		return err
 	}
 
@@ -84,9 +84,9 @@ You can interrupt the command's polling at any time with CTRL-C.
 				opts.InitOutput(cmd.OutOrStdout(), watchTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -108,13 +108,13 @@ func (opts *WatchOpts) watcher(ctx context.Context) func() (any, bool, error) {
 
 func (opts *WatchOpts) Run(ctx context.Context) error {
 	if opts.isFlexCluster {
-		if _, err := opts.Watch(opts.flexClusterWatcher(ctx)); err != nil {
+		if _, err := opts.WatchContext(ctx, opts.flexClusterWatcher(ctx)); err != nil {
 			return err
 		}
 		return opts.Print(nil)
 	}
 
-	if _, err := opts.Watch(opts.watcher(ctx)); err != nil {
+	if _, err := opts.WatchContext(ctx, opts.watcher(ctx)); err != nil {
 		return err
 	}
 
@@ -0,0 +1,153 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/prerun"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=audit_log_mock_test.go -package=config -source=audit_log.go
+
+type AuditLogReader interface {
+	Read() ([]audit.Entry, error)
+}
+
+const auditLogTemplate = `TIME	PROFILE	COMMAND	METHOD	URL	STATUS	DURATION (MS){{range .}}
+{{.Time.Format "2006-01-02T15:04:05Z07:00"}}	{{.Profile}}	{{.Path}}	{{.Method}}	{{.URL}}	{{if .Error}}{{.Error}}{{else}}{{.Status}}{{end}}	{{.DurationMs}}{{end}}
+`
+
+type AuditLogOpts struct {
+	cli.OutputOpts
+	since   string
+	command string
+	limit   int
+	now     func() time.Time
+	store   AuditLogReader
+}
+
+func (opts *AuditLogOpts) initStore() error {
+	path, err := audit.Path(config.Name())
+	if err != nil {
+		return err
+	}
+	opts.store = audit.NewJournal(afero.NewOsFs(), path)
+	return nil
+}
+
+// sinceTime parses --since as an ISO 8601 time or as a duration before now.
+func (opts *AuditLogOpts) sinceTime() (time.Time, error) {
+	if opts.since == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, opts.since); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(opts.since)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid --%s: %q, expected an ISO 8601-formatted time or a duration like 24h", flag.Since, opts.since)
+	}
+	return opts.now().Add(-d), nil
+}
+
+func (opts *AuditLogOpts) validate() error {
+	if opts.limit < 0 {
+		return fmt.Errorf("invalid --%s: %d, expected a positive number", flag.Limit, opts.limit)
+	}
+	_, err := opts.sinceTime()
+	return err
+}
+
+func (opts *AuditLogOpts) Run() error {
+	entries, err := opts.store.Read()
+	if err != nil {
+		return err
+	}
+
+	since, err := opts.sinceTime()
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]audit.Entry, 0, len(entries))
+	for _, e := range entries {
+		if e.Time.Before(since) || !strings.HasPrefix(e.Path, opts.command) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+
+	if opts.limit > 0 && len(filtered) > opts.limit {
+		filtered = filtered[len(filtered)-opts.limit:]
+	}
+
+	return opts.Print(filtered)
+}
+
+// AuditLogBuilder builds a cobra.Command that can run as:
+// atlas config audit-log [--since since] [--command command] [--limit limit].
+func AuditLogBuilder() *cobra.Command {
+	opts := &AuditLogOpts{now: time.Now}
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Return the mutating requests that Atlas CLI sent with the current profile.",
+		Long: `When the audit_log property of a profile is true, Atlas CLI appends every POST, PUT, PATCH and DELETE request that it sends with the profile to a local JSONL file in the audit directory of the Atlas CLI configuration directory.
+Each entry records the command path, the flags set, with the values of flags that can hold credentials redacted, the profile, project and organization, the operating system user and host name, the HTTP method, URL and status, and the duration of the request.
+The file is rotated when it reaches 10 MiB and the five most recent rotated files are kept.
+
+This command returns the entries of the current profile, oldest first. Use --profile to return the entries of another profile.`,
+		Example: `  # Record the mutating requests sent with the default profile:
+  atlas config set audit_log true
+
+  # Return the requests sent in the last 24 hours by cluster commands:
+  atlas config audit-log --since 24h --command "atlas clusters"
+
+  # Return the 10 most recent requests as JSON:
+  atlas config audit-log --limit 10 --output json`,
+		Args: require.NoArgs,
+		Annotations: map[string]string{
+			"output": auditLogTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return prerun.ExecuteE(
+				opts.validate,
+				opts.initStore,
+				opts.InitOutput(cmd.OutOrStdout(), auditLogTemplate),
+			)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.since, flag.Since, "", usage.AuditLogSince)
+	cmd.Flags().StringVar(&opts.command, flag.AuditLogCommand, "", usage.AuditLogCommand)
+	cmd.Flags().IntVar(&opts.limit, flag.Limit, 0, usage.AuditLogLimit)
+
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
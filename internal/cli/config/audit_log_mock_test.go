@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_log.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=audit_log_mock_test.go -package=config -source=audit_log.go
+//
+
+// Package config is a generated GoMock package.
+package config
+
+import (
+	reflect "reflect"
+
+	audit "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogReader is a mock of AuditLogReader interface.
+type MockAuditLogReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogReaderMockRecorder is the mock recorder for MockAuditLogReader.
+type MockAuditLogReaderMockRecorder struct {
+	mock *MockAuditLogReader
+}
+
+// NewMockAuditLogReader creates a new mock instance.
+func NewMockAuditLogReader(ctrl *gomock.Controller) *MockAuditLogReader {
+	mock := &MockAuditLogReader{ctrl: ctrl}
+	mock.recorder = &MockAuditLogReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogReader) EXPECT() *MockAuditLogReaderMockRecorder {
+	return m.recorder
+}
+
+// Read mocks base method.
+func (m *MockAuditLogReader) Read() ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read")
+	ret0, _ := ret[0].([]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockAuditLogReaderMockRecorder) Read() *MockAuditLogReaderReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockAuditLogReader)(nil).Read))
+	return &MockAuditLogReaderReadCall{Call: call}
+}
+
+// MockAuditLogReaderReadCall wrap *gomock.Call
+type MockAuditLogReaderReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAuditLogReaderReadCall) Return(arg0 []audit.Entry, arg1 error) *MockAuditLogReaderReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAuditLogReaderReadCall) Do(f func() ([]audit.Entry, error)) *MockAuditLogReaderReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAuditLogReaderReadCall) DoAndReturn(f func() ([]audit.Entry, error)) *MockAuditLogReaderReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
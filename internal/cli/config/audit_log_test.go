@@ -0,0 +1,87 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditLogOpts_Run(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	entries := []audit.Entry{
+		{Time: now.Add(-48 * time.Hour), Command: audit.Command{Path: "atlas clusters create"}, Method: "POST"},
+		{Time: now.Add(-2 * time.Hour), Command: audit.Command{Path: "atlas projects create"}, Method: "POST"},
+		{Time: now.Add(-time.Hour), Command: audit.Command{Path: "atlas clusters delete"}, Method: "DELETE"},
+		{Time: now.Add(-time.Minute), Command: audit.Command{Path: "atlas clusters update"}, Method: "PATCH"},
+	}
+
+	tests := []struct {
+		name    string
+		since   string
+		command string
+		limit   int
+		want    []string
+	}{
+		{name: "all", want: []string{"POST", "POST", "DELETE", "PATCH"}},
+		{name: "since duration", since: "24h", want: []string{"POST", "DELETE", "PATCH"}},
+		{name: "since time", since: "2026-01-10T11:30:00Z", want: []string{"PATCH"}},
+		{name: "command", command: "atlas clusters", want: []string{"POST", "DELETE", "PATCH"}},
+		{name: "limit", command: "atlas clusters", limit: 2, want: []string{"DELETE", "PATCH"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := NewMockAuditLogReader(ctrl)
+			mockStore.EXPECT().Read().Return(entries, nil).Times(1)
+
+			buf := new(bytes.Buffer)
+			opts := &AuditLogOpts{
+				OutputOpts: cli.OutputOpts{OutWriter: buf, Output: "json"},
+				since:      tt.since,
+				command:    tt.command,
+				limit:      tt.limit,
+				now:        func() time.Time { return now },
+				store:      mockStore,
+			}
+			require.NoError(t, opts.validate())
+			require.NoError(t, opts.Run())
+
+			var got []audit.Entry
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+			methods := make([]string, 0, len(got))
+			for _, e := range got {
+				methods = append(methods, e.Method)
+			}
+			assert.Equal(t, tt.want, methods)
+		})
+	}
+}
+
+func TestAuditLogOpts_Validate(t *testing.T) {
+	opts := &AuditLogOpts{since: "yesterday", now: time.Now}
+	require.Error(t, opts.validate())
+
+	opts = &AuditLogOpts{limit: -1, now: time.Now}
+	require.Error(t, opts.validate())
+}
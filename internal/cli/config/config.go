@@ -43,6 +43,8 @@ To find out more, see the documentation: https://dochub.mongodb.org/core/atlas-c
 		RenameBuilder(),
 		DeleteBuilder(),
 		EditBuilder(),
+		AuditLogBuilder(),
+		DiffBuilder(),
 	)
 
 	return cmd
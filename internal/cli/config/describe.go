@@ -16,16 +16,22 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"slices"
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dirconfig"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
 	"github.com/spf13/cobra"
 )
 
 type describeOpts struct {
-	name string
+	name            string
+	effective       bool
+	profileFromFlag bool
 	cli.OutputOpts
 }
 
@@ -33,6 +39,27 @@ var descTemplate = `SETTING	VALUE{{ range $key, $value := . }}
 {{$key}}	{{$value}}{{end}}
 `
 
+var effectiveTemplate = `PROPERTY	VALUE	SOURCE{{ range valueOrEmptySlice . }}
+{{.Property}}	{{.Value}}	{{.Source}}{{end}}
+`
+
+const (
+	sourceFlag    = "flag"
+	sourceEnv     = "env"
+	sourceDirFile = "dir file"
+	sourceProfile = "profile"
+	sourceDefault = "default"
+
+	clusterNameProperty = "cluster_name"
+)
+
+// effectiveValue is the value a command uses for a property and where it comes from.
+type effectiveValue struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+	Source   string `json:"source"`
+}
+
 const (
 	redactedSecureText = "[redacted - source: secure storage]"
 	redactedConfigText = "[redacted - source: config file]"
@@ -55,9 +82,92 @@ func (*describeOpts) GetConfig(configStore config.Store, profileName string) (ma
 		}
 	}
 
+	// Add the properties inherited from the profiles it extends
+	inherited, err := cli.InheritedValues(configStore, profileName)
+	if err != nil {
+		return nil, err
+	}
+	for key, v := range inherited {
+		if slices.Contains(config.SecureProperties, key) {
+			profileMap[key] = redactedText
+			continue
+		}
+		profileMap[key] = fmt.Sprint(v.Value)
+	}
+
 	return profileMap, nil
 }
 
+func dirFileSource(dir *dirconfig.Config) string {
+	return fmt.Sprintf("%s (%s)", sourceDirFile, dir.Path)
+}
+
+func (opts *describeOpts) profileSource(dir *dirconfig.Config) string {
+	switch {
+	case opts.profileFromFlag:
+		return sourceFlag
+	case cli.IsSetInEnv(cli.ProfileProperty):
+		return sourceEnv
+	case dir.Profile != "":
+		return dirFileSource(dir)
+	default:
+		return sourceDefault
+	}
+}
+
+// EffectiveConfig returns the values commands use for the profile, project, organization, output and cluster name,
+// with the flag, environment variable, directory context file or profile they come from.
+func (opts *describeOpts) EffectiveConfig(configStore config.Store, dir *dirconfig.Config) ([]effectiveValue, error) {
+	inherited, err := cli.InheritedValues(configStore, opts.name)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []effectiveValue{{Property: cli.ProfileProperty, Value: opts.name, Source: opts.profileSource(dir)}}
+	for _, p := range []struct {
+		property string
+		dirValue string
+	}{
+		{cli.ProjectIDProperty, dir.ProjectID},
+		{cli.OrgIDProperty, dir.OrgID},
+		{cli.OutputProperty, dir.Output},
+	} {
+		v := effectiveProfileValue(configStore, opts.name, p.property, p.dirValue, inherited)
+		if v.Source == sourceDirFile {
+			v.Source = dirFileSource(dir)
+		}
+		values = append(values, v)
+	}
+
+	clusterName := effectiveValue{Property: clusterNameProperty}
+	if dir.ClusterName != "" {
+		clusterName.Value = dir.ClusterName
+		clusterName.Source = dirFileSource(dir)
+	}
+
+	return append(values, clusterName), nil
+}
+
+func effectiveProfileValue(configStore config.Store, profileName, property, dirValue string, inherited map[string]cli.InheritedValue) effectiveValue {
+	v := effectiveValue{Property: property}
+	if env := os.Getenv(cli.EnvName(property)); env != "" {
+		v.Value, v.Source = env, sourceEnv
+		return v
+	}
+	if dirValue != "" {
+		v.Value, v.Source = dirValue, sourceDirFile
+		return v
+	}
+	if value := configStore.GetProfileValue(profileName, property); value != nil && value != "" {
+		v.Value, v.Source = fmt.Sprint(value), sourceProfile
+		return v
+	}
+	if i, ok := inherited[property]; ok {
+		v.Value, v.Source = fmt.Sprint(i.Value), fmt.Sprintf("%s (extends %s)", sourceProfile, i.From)
+	}
+	return v
+}
+
 func (opts *describeOpts) Run() error {
 	// Create a new config proxy store
 	configStore, err := config.NewStoreWithEnvOption(false)
@@ -70,6 +180,14 @@ func (opts *describeOpts) Run() error {
 		return fmt.Errorf("you don't have a profile named '%s'", opts.name)
 	}
 
+	if opts.effective {
+		values, err := opts.EffectiveConfig(configStore, dirconfig.Current())
+		if err != nil {
+			return err
+		}
+		return opts.Print(values)
+	}
+
 	mapConfig, err := opts.GetConfig(configStore, opts.name)
 	if err != nil {
 		return err
@@ -85,19 +203,39 @@ func DescribeBuilder() *cobra.Command {
 		Use:     "describe <name>",
 		Aliases: []string{"get"},
 		Short:   "Return the profile you specify.",
-		Args:    require.ExactArgs(1),
+		Long: `Use --effective to return the values that commands run in the current directory use, and whether they come from a flag, an environment variable, the directory context file (.atlascli.toml or .atlas/config) or the profile.
+The profile name is optional with --effective and defaults to the profile in use.`,
+		Example: `  # Return the profile named myProfile:
+  atlas config describe myProfile
+
+  # Return the values that commands use in the current directory and where they come from:
+  atlas config describe --effective`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.effective {
+				return require.MaximumNArgs(1)(cmd, args)
+			}
+			return require.ExactArgs(1)(cmd, args)
+		},
 		Annotations: map[string]string{
 			"nameDesc": "Label that identifies the profile.",
 		},
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			opts.OutWriter = cmd.OutOrStdout()
+			if opts.effective {
+				opts.Template = effectiveTemplate
+			}
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
-			opts.name = args[0]
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = config.Name()
+			if len(args) > 0 {
+				opts.name = args[0]
+			}
+			opts.profileFromFlag = cmd.Flags().Changed(flag.Profile)
 			return opts.Run()
 		},
 	}
 
+	cmd.Flags().BoolVar(&opts.effective, flag.Effective, false, usage.Effective)
 	opts.AddOutputOptFlags(cmd)
 
 	return cmd
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/mongodb/atlas-cli-core/mocks"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dirconfig"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -57,6 +58,7 @@ func TestGetConfig(t *testing.T) {
 			mockStore.EXPECT().GetProfileValue(profileName, "refresh_token").Return("").Times(1)
 			mockStore.EXPECT().GetProfileValue(profileName, "client_id").Return("").Times(1)
 			mockStore.EXPECT().GetProfileValue(profileName, "client_secret").Return("").Times(1)
+			mockStore.EXPECT().GetProfileValue(profileName, "extends").Return(nil).Times(1)
 
 			mockStore.EXPECT().IsSecure().Return(tt.isSecure).Times(1)
 
@@ -74,3 +76,71 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConfig_Extends(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	values := map[string]map[string]any{
+		"prod": {"project_id": "proj123", "extends": "base"},
+		"base": {"output": "json", "service": "cloud", "client_secret": "secret"},
+	}
+	mockStore.EXPECT().GetProfileStringMap("prod").Return(map[string]string{
+		"project_id": "proj123",
+		"extends":    "base",
+	}).Times(1)
+	mockStore.EXPECT().GetProfileNames().Return([]string{"base", "prod"}).AnyTimes()
+	mockStore.EXPECT().GetProfileValue(gomock.Any(), gomock.Any()).DoAndReturn(func(name, property string) any {
+		return values[name][property]
+	}).AnyTimes()
+	mockStore.EXPECT().IsSecure().Return(false).Times(1)
+
+	opts := &describeOpts{}
+	result, err := opts.GetConfig(mockStore, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"project_id":    "proj123",
+		"extends":       "base",
+		"output":        "json",
+		"service":       "cloud",
+		"client_secret": redactedConfigText,
+	}, result)
+}
+
+func TestEffectiveConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	values := map[string]map[string]any{
+		"prod": {"project_id": "proj123", "extends": "base"},
+		"base": {"output": "json", "org_id": "org123"},
+	}
+	mockStore.EXPECT().GetProfileNames().Return([]string{"base", "prod"}).AnyTimes()
+	mockStore.EXPECT().GetProfileValue(gomock.Any(), gomock.Any()).DoAndReturn(func(name, property string) any {
+		return values[name][property]
+	}).AnyTimes()
+
+	t.Setenv("MONGODB_ATLAS_PROFILE", "")
+	t.Setenv("MONGODB_ATLAS_ORG_ID", "envorg")
+	t.Setenv("MONGODB_ATLAS_PROJECT_ID", "")
+	t.Setenv("MONGODB_ATLAS_OUTPUT", "")
+	dir := &dirconfig.Config{Profile: "prod", ClusterName: "Cluster0", Path: "/repo/.atlascli.toml"}
+
+	opts := &describeOpts{name: "prod"}
+	result, err := opts.EffectiveConfig(mockStore, dir)
+	require.NoError(t, err)
+	assert.Equal(t, []effectiveValue{
+		{Property: "profile", Value: "prod", Source: "dir file (/repo/.atlascli.toml)"},
+		{Property: "project_id", Value: "proj123", Source: "profile"},
+		{Property: "org_id", Value: "envorg", Source: "env"},
+		{Property: "output", Value: "json", Source: "profile (extends base)"},
+		{Property: "cluster_name", Value: "Cluster0", Source: "dir file (/repo/.atlascli.toml)"},
+	}, result)
+
+	opts.profileFromFlag = true
+	dir.Output = "plaintext"
+	result, err = opts.EffectiveConfig(mockStore, dir)
+	require.NoError(t, err)
+	assert.Equal(t, effectiveValue{Property: "profile", Value: "prod", Source: "flag"}, result[0])
+	assert.Equal(t, effectiveValue{Property: "output", Value: "plaintext", Source: "dir file (/repo/.atlascli.toml)"}, result[3])
+}
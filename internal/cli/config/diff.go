@@ -0,0 +1,159 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/spf13/cobra"
+)
+
+const (
+	diffTemplate = `PROPERTY	{{index .Profiles 0}}	{{index .Profiles 1}}{{range valueOrEmptySlice .Differences}}
+{{.Property}}	{{index .Values 0}}	{{index .Values 1}}{{end}}
+`
+	redactedDiffText = "[redacted]"
+)
+
+type diffEntry struct {
+	Property string    `json:"property"`
+	Values   [2]string `json:"values"`
+}
+
+type diffResult struct {
+	Profiles    [2]string   `json:"profiles"`
+	Differences []diffEntry `json:"differences"`
+}
+
+type diffOpts struct {
+	cli.OutputOpts
+	profiles [2]string
+	store    config.Store
+}
+
+func (opts *diffOpts) initStore() error {
+	var err error
+	opts.store, err = config.NewStoreWithEnvOption(false)
+	if err != nil {
+		return fmt.Errorf("could not create config store: %w", err)
+	}
+	return nil
+}
+
+// resolve returns the properties of a profile including the ones it inherits.
+func (opts *diffOpts) resolve(name string) (map[string]string, error) {
+	if !slices.Contains(opts.store.GetProfileNames(), name) {
+		return nil, fmt.Errorf("you don't have a profile named '%s'", name)
+	}
+
+	properties := opts.store.GetProfileStringMap(name)
+	if properties == nil {
+		properties = map[string]string{}
+	}
+	for _, key := range config.SecureProperties {
+		if v, ok := opts.store.GetProfileValue(name, key).(string); ok && v != "" {
+			properties[key] = v
+		}
+	}
+
+	inherited, err := cli.InheritedValues(opts.store, name)
+	if err != nil {
+		return nil, err
+	}
+	for key, v := range inherited {
+		properties[key] = fmt.Sprint(v.Value)
+	}
+
+	return properties, nil
+}
+
+// displayValue hides the values of secure properties, only whether they are set is shown.
+func displayValue(key, value string) string {
+	if value != "" && slices.Contains(config.SecureProperties, key) {
+		return redactedDiffText
+	}
+	return value
+}
+
+func (opts *diffOpts) Run() error {
+	first, err := opts.resolve(opts.profiles[0])
+	if err != nil {
+		return err
+	}
+	second, err := opts.resolve(opts.profiles[1])
+	if err != nil {
+		return err
+	}
+
+	keys := slices.Collect(maps.Keys(first))
+	for key := range maps.Keys(second) {
+		if _, ok := first[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	result := diffResult{Profiles: opts.profiles, Differences: []diffEntry{}}
+	for _, key := range keys {
+		if first[key] == second[key] {
+			continue
+		}
+		result.Differences = append(result.Differences, diffEntry{
+			Property: key,
+			Values:   [2]string{displayValue(key, first[key]), displayValue(key, second[key])},
+		})
+	}
+
+	return opts.Print(result)
+}
+
+// DiffBuilder builds a cobra.Command that can run as:
+// atlas config diff <profile1> <profile2>.
+func DiffBuilder() *cobra.Command {
+	const argsN = 2
+	opts := &diffOpts{}
+	opts.Template = diffTemplate
+	cmd := &cobra.Command{
+		Use:   "diff <profile1> <profile2>",
+		Short: "Return the properties that differ between two profiles.",
+		Long: `Properties that a profile inherits from the profile it extends are compared with their inherited values.
+The values of credentials are redacted, the command only shows that they differ.`,
+		Example: `  # Compare the stage and prod profiles:
+  atlas config diff stage prod`,
+		Args: require.ExactArgs(argsN),
+		Annotations: map[string]string{
+			"profile1Desc": "Name of the first profile to compare.",
+			"profile2Desc": "Name of the second profile to compare.",
+			"output":       diffTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			opts.OutWriter = cmd.OutOrStdout()
+			return opts.initStore()
+		},
+		RunE: func(_ *cobra.Command, args []string) error {
+			opts.profiles = [2]string{args[0], args[1]}
+			return opts.Run()
+		},
+	}
+
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-core/mocks"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffOpts_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	profiles := map[string]map[string]any{
+		"base":  {"service": "cloud", "output": "json", "client_id": "id", "client_secret": "secret"},
+		"stage": {"extends": "base", "project_id": "proj1", "org_id": "org1"},
+		"prod":  {"extends": "base", "project_id": "proj2", "org_id": "org1", "client_secret": "other"},
+	}
+	mockStore.EXPECT().GetProfileNames().Return([]string{"base", "prod", "stage"}).AnyTimes()
+	mockStore.EXPECT().GetProfileStringMap(gomock.Any()).DoAndReturn(func(name string) map[string]string {
+		m := map[string]string{}
+		for k, v := range profiles[name] {
+			if k != "client_id" && k != "client_secret" {
+				m[k] = v.(string)
+			}
+		}
+		return m
+	}).AnyTimes()
+	mockStore.EXPECT().GetProfileValue(gomock.Any(), gomock.Any()).DoAndReturn(func(name, property string) any {
+		return profiles[name][property]
+	}).AnyTimes()
+
+	buf := new(bytes.Buffer)
+	opts := &diffOpts{
+		OutputOpts: cli.OutputOpts{OutWriter: buf, Output: "json"},
+		profiles:   [2]string{"stage", "prod"},
+		store:      mockStore,
+	}
+	require.NoError(t, opts.Run())
+
+	var got diffResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, diffResult{
+		Profiles: [2]string{"stage", "prod"},
+		Differences: []diffEntry{
+			{Property: "client_secret", Values: [2]string{redactedDiffText, redactedDiffText}},
+			{Property: "project_id", Values: [2]string{"proj1", "proj2"}},
+		},
+	}, got)
+
+	opts.profiles = [2]string{"stage", "dev"}
+	require.Error(t, opts.Run())
+}
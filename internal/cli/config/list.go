@@ -15,8 +15,13 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
 	"github.com/spf13/cobra"
 )
 
@@ -24,12 +29,50 @@ var listTemplate = `PROFILE NAME{{range valueOrEmptySlice .}}
 {{.}}{{end}}
 `
 
+var listExtendsTemplate = `PROFILE NAME	EXTENDS{{range valueOrEmptySlice .}}
+{{.Name}}	{{.Extends}}{{end}}
+`
+
+type listEntry struct {
+	Name    string `json:"name"`
+	Extends string `json:"extends,omitempty"`
+}
+
 type listOpts struct {
 	cli.OutputOpts
+	extends bool
+	store   cli.ProfileReader
+}
+
+func (opts *listOpts) initStore() error {
+	var err error
+	opts.store, err = config.NewStoreWithEnvOption(false)
+	if err != nil {
+		return fmt.Errorf("could not create config store: %w", err)
+	}
+	return nil
+}
+
+// extendsChain returns the profiles that name extends, the closest first.
+func (opts *listOpts) extendsChain(name string) string {
+	chain, err := cli.ExtendsChain(opts.store, name)
+	if err != nil {
+		return fmt.Sprintf("invalid: %v", err)
+	}
+	return strings.Join(chain, " -> ")
 }
 
 func (opts *listOpts) Run() error {
-	return opts.Print(config.List())
+	names := config.List()
+	if !opts.extends {
+		return opts.Print(names)
+	}
+
+	entries := make([]listEntry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, listEntry{Name: name, Extends: opts.extendsChain(name)})
+	}
+	return opts.Print(entries)
 }
 
 func ListBuilder() *cobra.Command {
@@ -39,16 +82,30 @@ func ListBuilder() *cobra.Command {
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Short:   "Return a list of available profiles by name.",
-		Long:    `If you did not specify a name for your profile, it displays as the default profile.`,
-		Example: "  atlas config ls",
+		Long: `If you did not specify a name for your profile, it displays as the default profile.
+Use --extends to also return the profiles that each profile extends, the closest first.`,
+		Example: `  # Return the available profiles:
+  atlas config ls
+
+  # Return the profiles and the profiles they extend:
+  atlas config ls --extends`,
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			o.OutWriter = cmd.OutOrStdout()
+			if o.extends {
+				o.Template = listExtendsTemplate
+			}
 		},
 		RunE: func(_ *cobra.Command, _ []string) error {
+			if o.extends {
+				if err := o.initStore(); err != nil {
+					return err
+				}
+			}
 			return o.Run()
 		},
 	}
 
+	cmd.Flags().BoolVar(&o.extends, flag.Extends, false, usage.ListExtends)
 	o.AddOutputOptFlags(cmd)
 
 	return cmd
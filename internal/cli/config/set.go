@@ -20,9 +20,12 @@ import (
 	"strings"
 
 	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mongosh"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/retry"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/validate"
 	"github.com/spf13/cobra"
 )
@@ -51,6 +54,11 @@ func (opts *SetOpts) Run() error {
 		if err := validate.ObjectIDByType(opts.prop, opts.val); err != nil {
 			return err
 		}
+	} else if slices.Contains(retry.Properties(), opts.prop) {
+		// invalid retry properties fail every later command, reject them before saving
+		if err := retry.ValidateProperty(opts.prop, opts.val); err != nil {
+			return err
+		}
 	}
 	var value any
 	value = opts.val
@@ -96,12 +104,24 @@ func SetBuilder() *cobra.Command {
 		},
 		Example: `
   Set the organization ID in the default profile to 5dd5aaef7a3e5a6c5bd12de4:
-  atlas config set org_id 5dd5aaef7a3e5a6c5bd12de4`,
+  atlas config set org_id 5dd5aaef7a3e5a6c5bd12de4
+
+  Retry requests that fail with HTTP 429, 502, 503 or 504 up to 5 times in the default profile:
+  atlas config set max_retries 5
+
+  Record the mutating requests sent with the default profile in a local audit log:
+  atlas config set audit_log true
+
+  Inherit the output, service, API version and credentials of the base profile in the prod profile:
+  atlas config set extends base -P prod
+
+  Export OpenTelemetry spans of the commands run with the default profile to a local OTLP collector:
+  atlas config set trace_exporter otlp`,
 		Annotations: map[string]string{
-			"propertyNameDesc": "Property to set in the profile. Valid values for Atlas CLI are project_id, org_id, service, public_api_key, private_api_key, output, mongosh_path, skip_update_check, telemetry_enabled, access_token, and refresh_token.",
+			"propertyNameDesc": "Property to set in the profile. Valid values for Atlas CLI are project_id, org_id, service, public_api_key, private_api_key, output, mongosh_path, skip_update_check, telemetry_enabled, access_token, refresh_token, max_retries, retry_max_wait, retry_all_methods, audit_log, trace_exporter, trace_endpoint, and extends.",
 			"valueDesc":        "Value for the property to set in the profile.",
 		},
-		ValidArgs: config.AllProperties(),
+		ValidArgs: slices.Concat(config.AllProperties(), retry.Properties(), audit.Properties(), tracing.Properties(), []string{cli.ExtendsProperty}),
 		RunE: func(_ *cobra.Command, args []string) error {
 			opts := &SetOpts{
 				store: config.Default(),
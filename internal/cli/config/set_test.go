@@ -184,6 +184,52 @@ func TestSet_Run(t *testing.T) {
 		}
 	})
 
+	t.Run("valid max_retries", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "max_retries",
+			val:   "5",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(1)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(1)
+
+		err := setOpts.Run()
+
+		if err != nil {
+			t.Fatalf("Run() unexpected error: %v\n", err)
+		}
+	})
+
+	t.Run("invalid max_retries", func(t *testing.T) {
+		setOpts := &SetOpts{
+			store: mockStore,
+			prop:  "max_retries",
+			val:   "abc",
+		}
+		mockStore.
+			EXPECT().
+			Set(setOpts.prop, setOpts.val).
+			Times(0)
+
+		mockStore.
+			EXPECT().
+			Save().Return(nil).
+			Times(0)
+
+		err := setOpts.Run()
+
+		if err == nil {
+			t.Fatal("Run() expected an error but got none\n")
+		}
+	})
+
 	t.Run("set skip_update_check to true", func(t *testing.T) {
 		setOpts := &SetOpts{
 			store: mockStore,
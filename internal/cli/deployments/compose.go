@@ -0,0 +1,197 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/search"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/file"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mongodbclient"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mongorestore"
+	"github.com/spf13/afero"
+)
+
+const (
+	defaultComposeFile = "atlas-local.yaml"
+	sampleDataURL      = "https://atlas-education.s3.amazonaws.com/sampledata.archive"
+	connectWaitSeconds = 10
+	composeErrFormat   = "deployment %q: %w"
+)
+
+var (
+	errComposeNoDeployments = errors.New("the file doesn't describe any deployment")
+	errComposeDuplicateName = errors.New("the deployment is described more than once")
+	errComposeInvalidIndex  = errors.New("the search index must have a name, a database and a collection")
+	errComposePassword      = errors.New("the password is required when the username is set")
+	errSampleDataDownload   = errors.New("unexpected sample data download status")
+)
+
+// composeFile describes the local deployments of an atlas-local.yaml file.
+type composeFile struct {
+	Deployments []composeDeployment `json:"deployments" yaml:"deployments"`
+}
+
+type composeDeployment struct {
+	Name          string               `json:"name"                    yaml:"name"`
+	MdbVersion    string               `json:"mdbVersion,omitempty"    yaml:"mdbVersion,omitempty"`
+	Port          int                  `json:"port,omitempty"          yaml:"port,omitempty"`
+	Username      string               `json:"username,omitempty"      yaml:"username,omitempty"`
+	Password      string               `json:"password,omitempty"      yaml:"password,omitempty"`
+	InitDB        string               `json:"initdb,omitempty"        yaml:"initdb,omitempty"`
+	SampleData    bool                 `json:"sampleData,omitempty"    yaml:"sampleData,omitempty"`
+	SearchIndexes []composeSearchIndex `json:"searchIndexes,omitempty" yaml:"searchIndexes,omitempty"`
+}
+
+type composeSearchIndex struct {
+	Name       string         `json:"name"           yaml:"name"`
+	Database   string         `json:"database"       yaml:"database"`
+	Collection string         `json:"collection"     yaml:"collection"`
+	Type       string         `json:"type,omitempty" yaml:"type,omitempty"`
+	Definition map[string]any `json:"definition"     yaml:"definition"`
+}
+
+// loadComposeFile loads and validates filename, credentials can reference environment variables like ${DB_PASSWORD}
+// and init scripts directories are relative to the file.
+func loadComposeFile(fs afero.Fs, filename string) (*composeFile, error) {
+	compose := new(composeFile)
+	if err := file.StrictLoad(fs, filename, compose); err != nil {
+		return nil, err
+	}
+
+	if len(compose.Deployments) == 0 {
+		return nil, fmt.Errorf("%w: %s", errComposeNoDeployments, filename)
+	}
+
+	names := map[string]bool{}
+	for i := range compose.Deployments {
+		d := &compose.Deployments[i]
+		if err := options.ValidateDeploymentName(d.Name); err != nil {
+			return nil, err
+		}
+		if names[d.Name] {
+			return nil, fmt.Errorf(composeErrFormat, d.Name, errComposeDuplicateName)
+		}
+		names[d.Name] = true
+
+		d.Username = os.ExpandEnv(d.Username)
+		d.Password = os.ExpandEnv(d.Password)
+		if d.Username != "" && d.Password == "" {
+			return nil, fmt.Errorf(composeErrFormat, d.Name, errComposePassword)
+		}
+		if d.InitDB != "" && !filepath.IsAbs(d.InitDB) {
+			d.InitDB = filepath.Join(filepath.Dir(filename), d.InitDB)
+		}
+
+		for j := range d.SearchIndexes {
+			index := &d.SearchIndexes[j]
+			if index.Name == "" || index.Database == "" || index.Collection == "" {
+				return nil, fmt.Errorf(composeErrFormat, d.Name, errComposeInvalidIndex)
+			}
+			if index.Type == "" {
+				index.Type = search.DefaultType
+			}
+		}
+	}
+
+	return compose, nil
+}
+
+// matchesVersion reports whether version, as labeled on the container, belongs to the major or major.minor version mdbVersion.
+func matchesVersion(version, mdbVersion string) bool {
+	return version == mdbVersion || strings.HasPrefix(version, mdbVersion+".")
+}
+
+// createSearchIndexes creates the search indexes that don't exist yet and returns how many were created.
+func createSearchIndexes(ctx context.Context, client mongodbclient.MongoDBClient, connectionString string, indexes []composeSearchIndex) (int, error) {
+	if err := client.Connect(ctx, connectionString, connectWaitSeconds); err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = client.Disconnect(ctx)
+	}()
+
+	created := 0
+	for _, index := range indexes {
+		coll := client.Database(index.Database).Collection(index.Collection)
+		_, err := coll.SearchIndexByName(ctx, index.Name)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, mongodbclient.ErrSearchIndexNotFound) {
+			return created, err
+		}
+
+		if _, err := coll.CreateSearchIndex(ctx, index.Name, index.Type, index.Definition); err != nil {
+			return created, fmt.Errorf("search index %q: %w", index.Name, err)
+		}
+		created++
+	}
+
+	return created, nil
+}
+
+// loadSampleData downloads the Atlas sample datasets and restores them with mongorestore.
+func loadSampleData(ctx context.Context, connectionString string) error {
+	if !mongorestore.Detect() {
+		return mongorestore.ErrMongorestoreNotInstalled
+	}
+
+	archive, err := os.CreateTemp("", "sampledata-*.archive")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archive.Name())
+
+	err = fetchSampleData(ctx, archive)
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return mongorestore.RestoreArchive(ctx, connectionString, archive.Name())
+}
+
+func fetchSampleData(ctx context.Context, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sampleDataURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s", errSampleDataDownload, resp.Status)
+	}
+
+	_, _ = log.Debugf("Downloading sample data from %s\n", sampleDataURL)
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/search"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadComposeFile(t *testing.T) {
+	t.Setenv("DEV_PASSWORD", "s3cr3t")
+
+	fs := afero.NewMemMapFs()
+	filename := filepath.Join("project", defaultComposeFile)
+	require.NoError(t, afero.WriteFile(fs, filename, []byte(`deployments:
+  - name: dev
+    mdbVersion: "8.0"
+    port: 27018
+    username: admin
+    password: ${DEV_PASSWORD}
+    initdb: ./scripts
+    sampleData: true
+    searchIndexes:
+      - name: default
+        database: sample_mflix
+        collection: movies
+        definition:
+          mappings:
+            dynamic: true
+  - name: test
+`), 0600))
+
+	compose, err := loadComposeFile(fs, filename)
+	require.NoError(t, err)
+	require.Len(t, compose.Deployments, 2)
+
+	dev := compose.Deployments[0]
+	assert.Equal(t, "dev", dev.Name)
+	assert.Equal(t, "8.0", dev.MdbVersion)
+	assert.Equal(t, 27018, dev.Port)
+	assert.Equal(t, "s3cr3t", dev.Password)
+	assert.Equal(t, filepath.Join("project", "scripts"), dev.InitDB)
+	assert.True(t, dev.SampleData)
+	require.Len(t, dev.SearchIndexes, 1)
+	assert.Equal(t, search.DefaultType, dev.SearchIndexes[0].Type)
+	assert.Equal(t, map[string]any{"dynamic": true}, dev.SearchIndexes[0].Definition["mappings"])
+
+	assert.Equal(t, composeDeployment{Name: "test"}, compose.Deployments[1])
+}
+
+func TestLoadComposeFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{
+			name:    "no deployments",
+			content: "deployments: []\n",
+			wantErr: errComposeNoDeployments,
+		},
+		{
+			name:    "duplicate name",
+			content: "deployments:\n  - name: dev\n  - name: dev\n",
+			wantErr: errComposeDuplicateName,
+		},
+		{
+			name:    "missing password",
+			content: "deployments:\n  - name: dev\n    username: admin\n",
+			wantErr: errComposePassword,
+		},
+		{
+			name:    "invalid search index",
+			content: "deployments:\n  - name: dev\n    searchIndexes:\n      - name: default\n        database: db\n",
+			wantErr: errComposeInvalidIndex,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, defaultComposeFile, []byte(tt.content), 0600))
+
+			_, err := loadComposeFile(fs, defaultComposeFile)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLoadComposeFile_UnknownField(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, defaultComposeFile, []byte("deployments:\n  - name: dev\n    mdbversion: \"8.0\"\n"), 0600))
+
+	_, err := loadComposeFile(fs, defaultComposeFile)
+	require.Error(t, err)
+}
+
+func TestMatchesVersion(t *testing.T) {
+	assert.True(t, matchesVersion("8.0.4", "8"))
+	assert.True(t, matchesVersion("8.0.4", "8.0"))
+	assert.True(t, matchesVersion("8.0", "8.0"))
+	assert.False(t, matchesVersion("7.0.9", "8"))
+	assert.False(t, matchesVersion("8.0.4", "8.0.1"))
+	assert.False(t, matchesVersion("80.0.1", "8"))
+}
@@ -17,7 +17,6 @@ package deployments
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
@@ -96,7 +95,7 @@ func (opts *DeleteOpts) runLocal(ctx context.Context) error {
 	})
 }
 
-func (opts *DeleteOpts) PostRun() error {
+func (opts *DeleteOpts) PostRun(ctx context.Context) error {
 	opts.UpdateDeploymentTelemetry()
 	if !opts.EnableWatch || !opts.IsAtlasDeploymentType() {
 		return nil
@@ -111,8 +110,7 @@ func (opts *DeleteOpts) PostRun() error {
 		),
 	)
 
-	watcher.Timeout = time.Duration(opts.Timeout)
-	if err := opts.WatchWatcher(watcher); err != nil {
+	if err := opts.WatchWatcherContext(ctx, watcher); err != nil {
 		return err
 	}
 
@@ -169,8 +167,8 @@ Please switch to the new command structure based on your target environment:
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return opts.Run(cmd.Context())
 		},
-		PostRunE: func(_ *cobra.Command, _ []string) error {
-			return opts.PostRun()
+		PostRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PostRun(cmd.Context())
 		},
 	}
 
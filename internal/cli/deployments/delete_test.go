@@ -16,6 +16,7 @@ package deployments
 
 import (
 	"bytes"
+	"os"
 	"testing"
 
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
@@ -65,6 +66,7 @@ func TestDelete_Run_Local(t *testing.T) {
 	buf := new(bytes.Buffer)
 
 	deploymentsTest := fixture.NewMockLocalDeploymentOpts(ctrl, "testDeployment")
+	snapshotDir := mockSnapshotDataDir(t, "testDeployment")
 
 	opts := &DeleteOpts{
 		DeploymentOpts: *deploymentsTest.Opts,
@@ -79,6 +81,7 @@ func TestDelete_Run_Local(t *testing.T) {
 	opts.Confirm = true
 
 	deploymentsTest.LocalMockFlow(ctx)
+	deploymentsTest.MockLocalContainerNames(ctx, deploymentsTest.MockContainerWithState("running"))
 	deploymentsTest.
 		MockDeploymentTelemetry.
 		EXPECT().
@@ -96,9 +99,57 @@ func TestDelete_Run_Local(t *testing.T) {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 
+	if _, err := os.Stat(snapshotDir); !os.IsNotExist(err) {
+		t.Fatalf("snapshot directory %s wasn't removed: %v", snapshotDir, err)
+	}
+
 	t.Log(buf.String())
 }
 
+func TestDelete_Run_LocalReplicaSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	buf := new(bytes.Buffer)
+
+	deploymentsTest := fixture.NewMockLocalDeploymentOpts(ctrl, "testDeployment")
+
+	opts := &DeleteOpts{
+		DeploymentOpts: *deploymentsTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			OutWriter: buf,
+		},
+		DeleteOpts: cli.NewDeleteOpts(deleteSuccessMessage, deleteFailMessage),
+	}
+	opts.Confirm = true
+
+	containers := deploymentsTest.MockReplicaSetContainers("running", 3)
+	deploymentsTest.LocalMockFlowWithMockContainer(ctx, containers)
+	deploymentsTest.MockLocalContainerNames(ctx, containers)
+	deploymentsTest.
+		MockDeploymentTelemetry.
+		EXPECT().
+		AppendDeploymentUUID().
+		Times(1)
+
+	deploymentsTest.
+		MockContainerEngine.
+		EXPECT().
+		ContainerRm(ctx, "testDeployment", "testDeployment-1", "testDeployment-2").
+		Return(nil).
+		Times(1)
+
+	deploymentsTest.
+		MockContainerEngine.
+		EXPECT().
+		NetworkRm(ctx, "testDeployment").
+		Return(nil).
+		Times(1)
+
+	if err := opts.Run(ctx); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+}
+
 func TestDeleteOpts_PostRun(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	deploymentsTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
@@ -117,7 +168,7 @@ func TestDeleteOpts_PostRun(t *testing.T) {
 
 	deploymentsTest.MockDeploymentTelemetry.EXPECT().AppendDeploymentType().Times(1)
 
-	if err := opts.PostRun(); err != nil {
+	if err := opts.PostRun(t.Context()); err != nil {
 		t.Fatalf("PostRun() unexpected error: %v", err)
 	}
 }
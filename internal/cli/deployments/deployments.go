@@ -17,6 +17,7 @@ package deployments
 import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/search"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/snapshots"
 	"github.com/spf13/cobra"
 )
 
@@ -39,7 +40,10 @@ func Builder() *cobra.Command {
 		LogsBuilder(),
 		StartBuilder(),
 		PauseBuilder(),
+		UpBuilder(),
+		DownBuilder(),
 		search.Builder(),
+		snapshots.Builder(),
 	)
 
 	return cmd
@@ -0,0 +1,148 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	downTemplate = `{{range valueOrEmptySlice .}}Deployment '{{.}}' deleted.
+{{end}}`
+	downFailMessage = "Deployments not deleted"
+	downPrompt      = "This operation will delete the deployments %s, and all of their data. This action cannot be undone\nAre you sure you want to delete them?"
+)
+
+type DownOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+	*cli.DeleteOpts
+	options.DeploymentOpts
+	fs       afero.Fs
+	filename string
+}
+
+func (opts *DownOpts) Run(ctx context.Context) error {
+	compose, err := loadComposeFile(opts.fs, opts.filename)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.LocalDeploymentPreRun(ctx); err != nil {
+		return err
+	}
+
+	deployments, err := opts.GetLocalDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(deployments))
+	for _, d := range deployments {
+		existing[d.Name] = true
+	}
+
+	names := make([]string, 0, len(compose.Deployments))
+	for _, d := range compose.Deployments {
+		if !existing[d.Name] {
+			_, _ = log.Debugf("Deployment %s not found, skipping\n", d.Name)
+			continue
+		}
+		names = append(names, d.Name)
+	}
+
+	if len(names) > 0 {
+		opts.Entry = strings.Join(names, ", ")
+		if err := opts.PromptWithMessage(downPrompt); err != nil {
+			return err
+		}
+		if !opts.Confirm {
+			_, err := fmt.Fprintln(opts.ConfigWriter(), opts.FailMessage())
+			return err
+		}
+	}
+
+	deleted := make([]string, 0, len(names))
+	for _, name := range names {
+		deploymentOpts := options.DeploymentOpts{
+			DeploymentName:  name,
+			ContainerEngine: opts.ContainerEngine,
+		}
+		if err := deploymentOpts.RemoveLocal(ctx); err != nil {
+			return err
+		}
+		deleted = append(deleted, name)
+	}
+
+	return opts.Print(deleted)
+}
+
+func (opts *DownOpts) PostRun() {
+	opts.UpdateDeploymentTelemetry()
+}
+
+// DownBuilder builds a cobra.Command that can run as:
+// atlas deployments down.
+func DownBuilder() *cobra.Command {
+	opts := &DownOpts{
+		DeleteOpts: cli.NewDeleteOpts("", downFailMessage),
+		fs:         afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Delete the local deployments described in an atlas-local.yaml file.",
+		Long:  "Deployments of the file that don't exist are skipped. Local deployments that aren't described in the file are left untouched. The command prompts for a confirmation unless you use --force.",
+		Example: `  # Delete the deployments described in the atlas-local.yaml file of the current directory after prompting for a confirmation:
+  atlas deployments down
+
+  # Delete the deployments described in the atlas-local.yaml file of the current directory without requiring confirmation:
+  atlas deployments down --force`,
+		Args:    require.NoArgs,
+		GroupID: "all",
+		Annotations: map[string]string{
+			"output": downTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			opts.DeploymentType = options.LocalCluster
+			return opts.PreRunE(
+				opts.InitOutput(cmd.OutOrStdout(), downTemplate),
+				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+		PostRun: func(_ *cobra.Command, _ []string) {
+			opts.PostRun()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, defaultComposeFile, usage.ComposeFile)
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
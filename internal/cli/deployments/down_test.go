@@ -0,0 +1,80 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDown_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	buf := new(bytes.Buffer)
+
+	deploymentsTest := fixture.NewMockLocalDeploymentOpts(ctrl, "dev")
+	snapshotDir := mockSnapshotDataDir(t, "dev")
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, defaultComposeFile, []byte("deployments:\n  - name: dev\n  - name: missing\n"), 0600))
+
+	opts := &DownOpts{
+		DeploymentOpts: *deploymentsTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  downTemplate,
+			OutWriter: buf,
+		},
+		DeleteOpts: cli.NewDeleteOpts("", downFailMessage),
+		fs:         fs,
+		filename:   defaultComposeFile,
+	}
+	opts.Confirm = true
+
+	engine := deploymentsTest.MockContainerEngine
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.
+		EXPECT().
+		ContainerList(ctx, options.ContainerFilter).
+		Return(deploymentsTest.MockContainerWithState("running"), nil).
+		Times(2)
+	engine.EXPECT().ContainerRm(ctx, "dev").Return(nil).Times(1)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Equal(t, "Deployment 'dev' deleted.\n", buf.String())
+	assert.NoDirExists(t, snapshotDir)
+}
+
+// mockSnapshotDataDir creates the snapshot data directory of the deployment in a temporary config directory.
+func mockSnapshotDataDir(t *testing.T, deploymentName string) string {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	dir, err := options.SnapshotDataDir(deploymentName)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "db"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db", "WiredTiger"), nil, 0o600))
+	return dir
+}
@@ -15,6 +15,7 @@
 package deployments
 
 import (
+	"bufio"
 	"compress/gzip"
 	"context"
 	"errors"
@@ -22,6 +23,7 @@ import (
 	"io"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/mongodb/atlas-cli-core/config"
@@ -29,6 +31,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/loganalysis"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/logfollow"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/search"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
@@ -47,6 +51,7 @@ type DownloadOpts struct {
 	cli.OutputOpts
 	cli.ProjectOpts
 	cli.DownloaderOpts
+	cli.LogFollowOpts
 	options.DeploymentOpts
 	downloadStore LogsDownloader
 	Host          string
@@ -73,6 +78,9 @@ func (opts *DownloadOpts) Run(ctx context.Context) error {
 	}
 
 	if opts.IsLocalDeploymentType() {
+		if opts.Follow {
+			return opts.RunLocalFollow(ctx)
+		}
 		return opts.RunLocal(ctx)
 	}
 
@@ -83,6 +91,9 @@ func (opts *DownloadOpts) Run(ctx context.Context) error {
 		if err := opts.validateAtlasFlags(); err != nil {
 			return err
 		}
+		if opts.Follow {
+			return opts.RunAtlasFollow(ctx)
+		}
 		return opts.RunAtlas()
 	}
 
@@ -103,6 +114,8 @@ func (opts *DownloadOpts) RunAtlas() error {
 // maxBytes  1k each write to avoid compression bomb.
 const maxBytes = 1024
 
+const maxLogLineSize = 16 * 1024 * 1024
+
 func (*DownloadOpts) write(w io.Writer, r io.Reader) error {
 	gr, errGz := gzip.NewReader(r)
 	if errGz != nil {
@@ -170,10 +183,26 @@ func (opts *DownloadOpts) newHostLogsParams() *atlasv2.DownloadClusterLogApiPara
 }
 
 func (opts *DownloadOpts) RunLocal(ctx context.Context) error {
-	logs, err := opts.ContainerEngine.ContainerLogs(ctx, opts.LocalMongodHostname())
+	names, err := opts.LocalContainerNames(ctx)
 	if err != nil {
 		return err
 	}
+
+	var logs []string
+	for _, name := range names {
+		containerLogs, err := opts.ContainerEngine.ContainerLogs(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		// with several containers every line starts with the name of the container it comes from
+		if len(names) > 1 {
+			for i, line := range containerLogs {
+				containerLogs[i] = name + " | " + line
+			}
+		}
+		logs = append(logs, containerLogs...)
+	}
 	// format log entries into lines
 	if opts.IsJSONOutput() {
 		return opts.Print(logs)
@@ -181,6 +210,88 @@ func (opts *DownloadOpts) RunLocal(ctx context.Context) error {
 	return opts.Print(strings.Join(logs, "\n"))
 }
 
+// RunAtlasFollow prints the new lines of the log of the Atlas host until the context is done.
+func (opts *DownloadOpts) RunAtlasFollow(ctx context.Context) error {
+	filter, err := opts.LineFilter()
+	if err != nil {
+		return err
+	}
+
+	fetch := func(_ context.Context, start int64) (io.ReadCloser, error) {
+		params := opts.newHostLogsParams()
+		params.StartDate = &start
+		params.EndDate = nil
+		return opts.downloadStore.DownloadLog(params)
+	}
+	return logfollow.NewFollower(fetch, opts.ConfigWriter(), logfollow.WithStart(opts.start), logfollow.WithFilter(filter)).Run(ctx)
+}
+
+// RunLocalFollow streams the logs of the containers of the local deployment until the context is done
+// or the containers stop.
+func (opts *DownloadOpts) RunLocalFollow(ctx context.Context) error {
+	filter, err := opts.LineFilter()
+	if err != nil {
+		return err
+	}
+
+	names, err := opts.LocalContainerNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	w := opts.ConfigWriter()
+	errs := make([]error, len(names))
+	for i, name := range names {
+		wg.Go(func() {
+			if errs[i] = opts.followContainerLogs(ctx, w, &mu, name, len(names) > 1, filter); errs[i] != nil {
+				cancel()
+			}
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// followContainerLogs writes the lines of the logs of a container that match the filter,
+// prefixed with the container name when the deployment has several containers.
+func (opts *DownloadOpts) followContainerLogs(ctx context.Context, w io.Writer, mu *sync.Mutex, name string, prefix bool, filter *loganalysis.LineFilter) error {
+	r, err := opts.ContainerEngine.ContainerLogsFollow(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if !filter.Match(line) {
+			continue
+		}
+
+		mu.Lock()
+		if prefix {
+			_, err = fmt.Fprintf(w, "%s | %s\n", name, line)
+		} else {
+			_, err = fmt.Fprintf(w, "%s\n", line)
+		}
+		mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	// the logs command is stopped when the context is done
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
 func (opts *DownloadOpts) promptMissingAtlasFlags() error {
 	questions := make([]*survey.Question, 0)
 
@@ -262,7 +373,8 @@ Please switch to the new command structure based on your target environment:
 			return opts.PreRunE(
 				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
 				opts.initStore(cmd.Context()),
-				opts.InitOutput(cmd.OutOrStdout(), ""))
+				opts.InitOutput(cmd.OutOrStdout(), ""),
+				opts.ValidateLogFollow)
 		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return opts.Run(cmd.Context())
@@ -284,5 +396,8 @@ Please switch to the new command structure based on your target environment:
 	cmd.Flags().StringVar(&opts.Host, flag.Hostname, "", usage.LogHostName)
 	cmd.Flags().StringVar(&opts.Name, flag.Name, "", usage.LogName)
 
+	opts.AddLogFollowFlags(cmd)
+	cmd.MarkFlagsMutuallyExclusive(flag.Follow, flag.End)
+
 	return cmd
 }
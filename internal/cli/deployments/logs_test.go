@@ -16,6 +16,7 @@ package deployments
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"io"
 	"strings"
@@ -24,6 +25,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
 	"github.com/spf13/afero"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
 	"go.uber.org/mock/gomock"
 )
 
@@ -42,6 +44,7 @@ func TestLogs_RunLocal(t *testing.T) {
 	}
 
 	deploymentTest.LocalMockFlow(ctx)
+	deploymentTest.MockLocalContainerNames(ctx, deploymentTest.MockContainerWithState("running"))
 
 	deploymentTest.MockContainerEngine.
 		EXPECT().
@@ -93,6 +96,91 @@ func TestLogs_RunAtlas(t *testing.T) {
 	}
 }
 
+func TestLogs_RunLocalFollow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	buf := new(bytes.Buffer)
+	want := "localDeployment"
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, want)
+
+	downloadOpts := &DownloadOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			OutWriter: buf,
+		},
+		LogFollowOpts: cli.LogFollowOpts{
+			Follow:     true,
+			Components: []string{"NETWORK"},
+		},
+	}
+
+	deploymentTest.LocalMockFlow(ctx)
+	deploymentTest.MockLocalContainerNames(ctx, deploymentTest.MockContainerWithState("running"))
+
+	logs := `{"t":{"$date":"2026-01-10T10:00:00.000+00:00"},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"Connection accepted"}
+{"t":{"$date":"2026-01-10T10:00:01.000+00:00"},"s":"I","c":"REPL","id":21358,"ctx":"ReplCoord-0","msg":"Replica set state transition"}
+`
+	deploymentTest.MockContainerEngine.
+		EXPECT().
+		ContainerLogsFollow(gomock.Any(), want).
+		Return(io.NopCloser(strings.NewReader(logs)), nil).
+		Times(1)
+
+	if err := downloadOpts.Run(ctx); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	if got := buf.String(); !strings.Contains(got, "Connection accepted") || strings.Contains(got, "Replica set state transition") {
+		t.Fatalf("Run() unexpected output: %s", got)
+	}
+}
+
+func TestLogs_RunAtlasFollow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	buf := new(bytes.Buffer)
+	atlasDeployment := "localDeployment1"
+	mockStore := NewMockLogsDownloader(ctrl)
+	deploymentTest := fixture.NewMockAtlasDeploymentOpts(ctrl, atlasDeployment)
+
+	downloadOpts := &DownloadOpts{
+		ProjectOpts: cli.ProjectOpts{ProjectID: "ProjectID"},
+		OutputOpts: cli.OutputOpts{
+			OutWriter: buf,
+		},
+		LogFollowOpts:  cli.LogFollowOpts{Follow: true},
+		DeploymentOpts: *deploymentTest.Opts,
+		downloadStore:  mockStore,
+		Host:           "test",
+		Name:           "mongodb.gz",
+		start:          1,
+	}
+
+	deploymentTest.CommonAtlasMocks(downloadOpts.ProjectID)
+
+	b, _ := base64.RawStdEncoding.DecodeString("H4sIAAAAAAAA/8pIzcnJVyjPL8pJAQQAAP//hRFKDQsAAAA") // "hello world" gzipped
+	mockStore.
+		EXPECT().
+		DownloadLog(gomock.Any()).
+		DoAndReturn(func(params *atlasv2.DownloadClusterLogApiParams) (io.ReadCloser, error) {
+			if params.GetStartDate() != 1 {
+				t.Errorf("DownloadLog() unexpected start date: %d", params.GetStartDate())
+			}
+			cancel()
+			return io.NopCloser(bytes.NewReader(b)), nil
+		}).
+		Times(1)
+
+	if err := downloadOpts.Run(ctx); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	if got := buf.String(); got != "hello world\n" {
+		t.Fatalf("Run() unexpected output: %q", got)
+	}
+}
+
 func TestDownloadOpts_PostRun(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
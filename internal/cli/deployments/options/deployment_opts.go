@@ -257,20 +257,26 @@ func (opts *DeploymentOpts) GetLocalDeployments(ctx context.Context) ([]Deployme
 		return mdbContainers[i].Names[0] < mdbContainers[j].Names[0]
 	})
 
-	deployments := make([]Deployment, len(mdbContainers))
-	for i, c := range mdbContainers {
+	deployments := make([]Deployment, 0, len(mdbContainers))
+	for _, c := range mdbContainers {
+		// the containers of a replica set or sharded deployment are listed once,
+		// using the state of the container named after the deployment
+		name := deploymentName(c)
+		if c.Names[0] != name {
+			continue
+		}
+
 		stateName, found := localStateMap[c.State]
 		if !found {
 			stateName = strings.ToUpper(c.State)
 		}
 
-		name := c.Names[0]
-		deployments[i] = Deployment{
+		deployments = append(deployments, Deployment{
 			Type:           "LOCAL",
 			Name:           name,
 			MongoDBVersion: c.Labels["version"],
 			StateName:      stateName,
-		}
+		})
 	}
 
 	return deployments, nil
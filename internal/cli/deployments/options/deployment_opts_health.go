@@ -0,0 +1,57 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+)
+
+var (
+	errDeploymentUnhealthy     = errors.New("the deployment is unhealthy")
+	errDeploymentNoHealthCheck = errors.New("the deployment does not have a healthcheck")
+	errHealthCheckTimeout      = errors.New("timed out waiting for the deployment to be healthy")
+	errQueryHealthCheckStatus  = errors.New("query healthcheck status failed")
+)
+
+func (opts *DeploymentOpts) WaitForHealthyDeployment(ctx context.Context, duration time.Duration) error {
+	start := time.Now()
+
+	for {
+		if time.Since(start) > duration {
+			return errHealthCheckTimeout
+		}
+
+		status, err := opts.ContainerEngine.ContainerHealthStatus(ctx, opts.LocalMongodHostname())
+		if err != nil {
+			return fmt.Errorf("%w: %w", errQueryHealthCheckStatus, err)
+		}
+
+		switch status {
+		case container.DockerHealthcheckStatusHealthy:
+			return nil
+		case container.DockerHealthcheckStatusUnhealthy:
+			return errDeploymentUnhealthy
+		case container.DockerHealthcheckStatusNone:
+			return errDeploymentNoHealthCheck
+		case container.DockerHealthcheckStatusStarting:
+			time.Sleep(1 * time.Second)
+		}
+	}
+}
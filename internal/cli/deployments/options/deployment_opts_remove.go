@@ -13,8 +13,45 @@
 // limitations under the License.
 package options
 
-import "context"
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/mongodb/atlas-cli-core/config"
+)
+
+// SnapshotDataDir returns the directory the snapshot of a deployment is extracted to, it outlives the command as the data directory of the deployment.
+func SnapshotDataDir(deploymentName string) (string, error) {
+	configHome, err := config.CLIConfigHome()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configHome, "deployments", deploymentName), nil
+}
 
 func (opts *DeploymentOpts) RemoveLocal(ctx context.Context) error {
-	return opts.ContainerEngine.ContainerRm(ctx, opts.LocalMongodHostname())
+	names, err := opts.LocalContainerNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.ContainerEngine.ContainerRm(ctx, names...); err != nil {
+		return err
+	}
+
+	// replica sets and sharded deployments have their own network, named after the deployment
+	if len(names) > 1 {
+		if err := opts.ContainerEngine.NetworkRm(ctx, opts.DeploymentName); err != nil {
+			return err
+		}
+	}
+
+	// deployments set up from a snapshot keep their data files in the config directory
+	dir, err := SnapshotDataDir(opts.DeploymentName)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
 }
@@ -28,12 +28,17 @@ func (opts *DeploymentOpts) StartLocal(ctx context.Context, deployment Deploymen
 		return nil
 	}
 
+	names, err := opts.LocalContainerNames(ctx)
+	if err != nil {
+		return err
+	}
+
 	if deployment.StateName == connect.StoppedState {
-		return opts.ContainerEngine.ContainerStart(ctx, opts.LocalMongodHostname())
+		return opts.ContainerEngine.ContainerStart(ctx, names...)
 	}
 
 	if deployment.StateName == connect.PausedState {
-		return opts.ContainerEngine.ContainerUnpause(ctx, opts.LocalMongodHostname())
+		return opts.ContainerEngine.ContainerUnpause(ctx, names...)
 	}
 
 	return ErrDeploymentIsDeleting
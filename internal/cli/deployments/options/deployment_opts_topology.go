@@ -0,0 +1,181 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+)
+
+const (
+	// DeploymentLabel groups the containers of a replica set or sharded local deployment.
+	DeploymentLabel = "mongodb-atlas-local.deployment"
+	// RoleLabel is the role of a container in the deployment, one of MongodRole, ConfigServerRole, ShardRole or MongosRole.
+	RoleLabel       = "mongodb-atlas-local.role"
+	ReplicaSetLabel = "mongodb-atlas-local.replicaSet"
+
+	MongodRole       = "mongod"
+	ConfigServerRole = "configsvr"
+	ShardRole        = "shardsvr"
+	MongosRole       = "mongos"
+)
+
+// ReplicaSet is a replica set of a local deployment, every member runs in its own container.
+type ReplicaSet struct {
+	Name    string
+	Role    string
+	Members []string
+}
+
+// Topology lists the containers of a local deployment with several members.
+// The container named after the deployment is the entry point: the first member of a replica set or the mongos of a sharded cluster,
+// its port is published on the host so the existing commands can connect to it.
+type Topology struct {
+	Name        string
+	ReplicaSets []ReplicaSet
+	Mongos      string
+}
+
+// NewTopology returns a replica set with the given number of members,
+// or a sharded cluster when shards is greater than 0, every shard and the config servers then use that number of members.
+func NewTopology(name string, members, shards int) Topology {
+	members = max(members, 1)
+	t := Topology{Name: name}
+
+	if shards == 0 {
+		t.ReplicaSets = []ReplicaSet{newReplicaSet(name, MongodRole, members)}
+		return t
+	}
+
+	t.Mongos = name
+	t.ReplicaSets = append(t.ReplicaSets, newReplicaSet(name+"-config", ConfigServerRole, members))
+	for i := range shards {
+		t.ReplicaSets = append(t.ReplicaSets, newReplicaSet(fmt.Sprintf("%s-shard%d", name, i), ShardRole, members))
+	}
+
+	return t
+}
+
+func newReplicaSet(name, role string, members int) ReplicaSet {
+	rs := ReplicaSet{
+		Name:    name,
+		Role:    role,
+		Members: make([]string, members),
+	}
+
+	for i := range members {
+		rs.Members[i] = name
+		if i > 0 {
+			rs.Members[i] = fmt.Sprintf("%s-%d", name, i)
+		}
+	}
+
+	return rs
+}
+
+// Containers returns the name of every container of the deployment, the entry point comes first.
+func (t Topology) Containers() []string {
+	var names []string
+	if t.Mongos != "" {
+		names = append(names, t.Mongos)
+	}
+
+	for _, rs := range t.ReplicaSets {
+		names = append(names, rs.Members...)
+	}
+
+	return names
+}
+
+// Shards returns the replica sets holding data.
+func (t Topology) Shards() []ReplicaSet {
+	var shards []ReplicaSet
+	for _, rs := range t.ReplicaSets {
+		if rs.Role == ShardRole {
+			shards = append(shards, rs)
+		}
+	}
+
+	return shards
+}
+
+// ConfigServers returns the config server replica set of a sharded cluster.
+func (t Topology) ConfigServers() (ReplicaSet, bool) {
+	i := slices.IndexFunc(t.ReplicaSets, func(rs ReplicaSet) bool {
+		return rs.Role == ConfigServerRole
+	})
+	if i < 0 {
+		return ReplicaSet{}, false
+	}
+
+	return t.ReplicaSets[i], true
+}
+
+// Labels returns the labels of a container of the topology.
+func (t Topology) Labels(role, replicaSet string) map[string]string {
+	labels := map[string]string{
+		DeploymentLabel: t.Name,
+		RoleLabel:       role,
+	}
+	if replicaSet != "" {
+		labels[ReplicaSetLabel] = replicaSet
+	}
+
+	return labels
+}
+
+// deploymentName returns the name of the deployment a container belongs to.
+func deploymentName(c container.Container) string {
+	if name, ok := c.Labels[DeploymentLabel]; ok && name != "" {
+		return name
+	}
+
+	return c.Names[0]
+}
+
+// LocalContainerNames returns the containers of the deployment, only the entry point for single node deployments.
+func (opts *DeploymentOpts) LocalContainerNames(ctx context.Context) ([]string, error) {
+	containers, err := opts.GetLocalContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{opts.LocalMongodHostname()}
+	for _, c := range containers {
+		if c.Labels[DeploymentLabel] != opts.DeploymentName {
+			continue
+		}
+		for _, name := range c.Names {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// IsLocalTopology returns true when the deployment runs in more than one container, with its own network.
+func (opts *DeploymentOpts) IsLocalTopology(ctx context.Context) (bool, error) {
+	names, err := opts.LocalContainerNames(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return len(names) > 1, nil
+}
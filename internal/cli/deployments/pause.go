@@ -101,7 +101,12 @@ func (opts *PauseOpts) stopContainer(ctx context.Context, deployment options.Dep
 	opts.StartSpinner()
 	defer opts.StopSpinner()
 
-	return opts.ContainerEngine.ContainerStop(ctx, opts.LocalMongodHostname())
+	names, err := opts.LocalContainerNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	return opts.ContainerEngine.ContainerStop(ctx, names...)
 }
 
 func (opts *PauseOpts) RunAtlas() error {
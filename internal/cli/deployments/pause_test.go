@@ -49,6 +49,7 @@ func TestPause_RunLocal(t *testing.T) {
 	}
 
 	deploymentTest.LocalMockFlow(ctx)
+	deploymentTest.MockLocalContainerNames(ctx, deploymentTest.MockContainerWithState("running"))
 
 	deploymentTest.MockContainerEngine.
 		EXPECT().
@@ -327,7 +327,7 @@ func (opts *CreateOpts) PostRun(ctx context.Context) error {
 		watch = opts.watchAtlas
 	}
 
-	watchResult, err := opts.Watch(func() (any, bool, error) {
+	watchResult, err := opts.WatchContext(ctx, func() (any, bool, error) {
 		return watch(ctx)
 	})
 
@@ -66,6 +66,9 @@ const (
 	steps                      = 3
 	atlasM2                    = "M2"
 	atlasM5                    = "M5"
+	maxReplicaSetMembers       = 7
+	topologyWaitSeconds        = 60
+	primaryTimeout             = 2 * time.Minute
 	deprecateMessageSharedTier = "The '%s' tier is deprecated. For the migration guide and timeline, visit: https://dochub.mongodb.org/core/flex-migration.\n"
 )
 
@@ -86,19 +89,24 @@ var (
 	errUnsupportedConnectWith     = fmt.Errorf("the --%s flag is unsupported", flag.ConnectWith)
 	errFlagUsernameRequired       = fmt.Errorf("the --%s is required to enable authentication when --%s flag is set",
 		flag.Username, flag.BindIPAll)
-	errFailedToDownloadImage   = errors.New("failed to download the MongoDB image")
-	errDeploymentUnhealthy     = errors.New("the deployment is unhealthy")
-	errDeploymentNoHealthCheck = errors.New("the deployment does not have a healthcheck")
-	errHealthCheckTimeout      = errors.New("timed out waiting for the deployment to be healthy")
-	errDownloadImage           = errors.New("image download failed")
-	errInspectHealthCheck      = errors.New("inspect healthcheck failed")
-	errQueryHealthCheckStatus  = errors.New("query healthcheck status failed")
-	errConfigContainer         = errors.New("container configuration failed")
-	errRunContainer            = errors.New("container run failed")
-	errListContainer           = errors.New("listing containers failed")
-	errInsufficientDiskSpace   = errors.New("insufficient disk space on docker")
-	settingOptions             = []string{defaultSettings, customSettings, cancelSettings}
-	settingsDescription        = map[string]string{
+	errFailedToDownloadImage = errors.New("failed to download the MongoDB image")
+	errDownloadImage         = errors.New("image download failed")
+	errInspectHealthCheck    = errors.New("inspect healthcheck failed")
+	errConfigContainer       = errors.New("container configuration failed")
+	errRunContainer          = errors.New("container run failed")
+	errListContainer         = errors.New("listing containers failed")
+	errInsufficientDiskSpace = errors.New("insufficient disk space on docker")
+	errInvalidMembers        = fmt.Errorf("the --%s flag must be between 1 and %d", flag.Members, maxReplicaSetMembers)
+	errInvalidShards         = fmt.Errorf("the --%s flag can't be negative", flag.Shards)
+	errIncompatibleTopology  = fmt.Errorf("the --%s and --%s flags apply only to LOCAL deployments", flag.Members, flag.Shards)
+	errTopologyUnsupported   = fmt.Errorf("the --%s, --%s and --%s flags can't be used with --%s or --%s", flag.Username, flag.InitDB, flag.BindIPAll, flag.Members, flag.Shards)
+	errCreateNetwork         = errors.New("network creation failed")
+	errInitReplicaSet        = errors.New("replica set initialization failed")
+	errPrimaryTimeout        = errors.New("timed out waiting for the replica set to elect a primary")
+	errAddShard              = errors.New("adding the shard failed")
+	errPortNotPublished      = errors.New("the container port is not published")
+	settingOptions           = []string{defaultSettings, customSettings, cancelSettings}
+	settingsDescription      = map[string]string{
 		defaultSettings: "With default settings",
 		customSettings:  "With custom settings",
 		cancelSettings:  "Cancel setup",
@@ -126,6 +134,12 @@ type SetupOpts struct {
 	bindIPAll     bool
 	mongodIP      string
 	initdb        string
+	members       int
+	shards        int
+	fromSnapshot  string
+	snapshotStore SnapshotDownloader
+	snapshotDir   string
+	dataPath      string
 	s             *spinner.Spinner
 	atlasSetup    *setup.Opts
 }
@@ -142,7 +156,7 @@ func (opts *SetupOpts) logStepStarted(msg string, currentStep int, totalSteps in
 }
 
 func (opts *SetupOpts) downloadImage(ctx context.Context, currentStep int) error {
-	opts.logStepStarted("Downloading the latest MongoDB image to your local environment...", currentStep, steps)
+	opts.logStepStarted("Downloading the latest MongoDB image to your local environment...", currentStep, opts.totalSteps())
 	defer opts.stop()
 
 	candidates := []string{
@@ -210,6 +224,14 @@ func (opts *SetupOpts) startEnvironment(ctx context.Context, currentStep int, st
 	return opts.validateLocalDeploymentsSettings(containers)
 }
 
+func (opts *SetupOpts) totalSteps() int {
+	if opts.fromSnapshot != "" {
+		return steps + 1
+	}
+
+	return steps
+}
+
 func (opts *SetupOpts) createLocalDeployment(ctx context.Context) error {
 	currentStep := 1
 
@@ -221,7 +243,7 @@ func (opts *SetupOpts) createLocalDeployment(ctx context.Context) error {
 	}
 
 	// containers check
-	if err := opts.startEnvironment(ctx, currentStep, steps); err != nil {
+	if err := opts.startEnvironment(ctx, currentStep, opts.totalSteps()); err != nil {
 		return err
 	}
 	currentStep++
@@ -232,11 +254,27 @@ func (opts *SetupOpts) createLocalDeployment(ctx context.Context) error {
 	}
 	currentStep++
 
+	if opts.fromSnapshot != "" {
+		opts.logStepStarted(fmt.Sprintf("Downloading the snapshot %s...", opts.fromSnapshot), currentStep, opts.totalSteps())
+		opts.start()
+		err := opts.downloadSnapshot(ctx)
+		opts.stop()
+		if err != nil {
+			return fmt.Errorf("%w: %w", errDownloadSnapshot, err)
+		}
+		currentStep++
+	}
+
 	// create local deployment
-	opts.logStepStarted(fmt.Sprintf("Creating your deployment %s...", opts.DeploymentName), currentStep, steps)
+	opts.logStepStarted(fmt.Sprintf("Creating your deployment %s...", opts.DeploymentName), currentStep, opts.totalSteps())
 	defer opts.stop()
 
-	if err := opts.configureContainer(ctx); err != nil {
+	configure := opts.configureContainer
+	if opts.isTopology() {
+		configure = opts.configureTopology
+	}
+
+	if err := configure(ctx); err != nil {
 		return fmt.Errorf("%w: %w", errConfigContainer, err)
 	}
 
@@ -277,6 +315,12 @@ func (opts *SetupOpts) configureContainer(ctx context.Context) error {
 			},
 		}
 	}
+	if opts.dataPath != "" {
+		flags.Volumes = append(flags.Volumes, container.VolumeMapping{
+			HostPath:      opts.dataPath,
+			ContainerPath: containerDBPath,
+		})
+	}
 	healthCheck, err := opts.ContainerEngine.ImageHealthCheck(ctx, opts.MongodDockerImageName())
 	if err != nil {
 		return fmt.Errorf("%w: %w", errInspectHealthCheck, err)
@@ -324,37 +368,15 @@ func (opts *SetupOpts) configureContainer(ctx context.Context) error {
 	return err
 }
 
-func (opts *SetupOpts) WaitForHealthyDeployment(ctx context.Context, duration time.Duration) error {
-	start := time.Now()
-
-	for {
-		if time.Since(start) > duration {
-			return errHealthCheckTimeout
-		}
-
-		status, err := opts.ContainerEngine.ContainerHealthStatus(ctx, opts.LocalMongodHostname())
-		if err != nil {
-			return fmt.Errorf("%w: %w", errQueryHealthCheckStatus, err)
-		}
-
-		switch status {
-		case container.DockerHealthcheckStatusHealthy:
-			return nil
-		case container.DockerHealthcheckStatusUnhealthy:
-			return errDeploymentUnhealthy
-		case container.DockerHealthcheckStatusNone:
-			return errDeploymentNoHealthCheck
-		case container.DockerHealthcheckStatusStarting:
-			time.Sleep(1 * time.Second)
-		}
+func (opts *SetupOpts) validateLocalDeploymentsSettings(containers []container.Container) error {
+	names := []string{opts.LocalMongodHostname()}
+	if opts.isTopology() {
+		names = opts.topology().Containers()
 	}
-}
 
-func (opts *SetupOpts) validateLocalDeploymentsSettings(containers []container.Container) error {
-	mongodContainerName := opts.LocalMongodHostname()
 	for _, c := range containers {
 		for _, n := range c.Names {
-			if n == mongodContainerName {
+			if slices.Contains(names, n) || c.Labels[options.DeploymentLabel] == opts.DeploymentName {
 				return fmt.Errorf("%w: \"%s\", state:\"%s\"", ErrDeploymentExists, opts.DeploymentName, c.State)
 			}
 		}
@@ -414,6 +436,30 @@ func checkPort(p int) error {
 	return nil
 }
 
+// availablePorts returns n distinct ports of the host that no process listens on.
+func availablePorts(n int) ([]int, error) {
+	lc := net.ListenConfig{}
+	// the listeners stay open until every port is picked so the same port isn't returned twice
+	listeners := make([]net.Listener, 0, n)
+	defer func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+
+	ports := make([]int, n)
+	for i := range ports {
+		l, err := lc.Listen(context.Background(), "tcp", "localhost:0")
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, l)
+		ports[i] = l.Addr().(*net.TCPAddr).Port
+	}
+
+	return ports, nil
+}
+
 func validatePort(p int) error {
 	if p <= 0 || p > 65535 {
 		return errPortOutOfRange
@@ -511,6 +557,14 @@ func (opts *SetupOpts) validateFlags() error {
 		}
 	}
 
+	if err := opts.validateFromSnapshotFlag(); err != nil {
+		return err
+	}
+
+	if err := opts.validateTopologyFlags(); err != nil {
+		return err
+	}
+
 	if opts.connectWith != "" && !search.StringInSliceFold(connectWithOptions, opts.connectWith) {
 		return fmt.Errorf("%w: %s", errUnsupportedConnectWith, opts.connectWith)
 	}
@@ -532,6 +586,40 @@ func (opts *SetupOpts) validateFlags() error {
 	return opts.validateBindIPAllFlag()
 }
 
+func (opts *SetupOpts) validateTopologyFlags() error {
+	if opts.members < 1 || opts.members > maxReplicaSetMembers {
+		return errInvalidMembers
+	}
+
+	if opts.shards < 0 {
+		return errInvalidShards
+	}
+
+	if !opts.isTopology() {
+		return nil
+	}
+
+	if opts.IsAtlasDeploymentType() {
+		return errIncompatibleTopology
+	}
+
+	// members authenticate to each other with a keyfile and the image entrypoint doesn't support several nodes
+	if opts.DBUsername != "" || opts.initdb != "" || opts.bindIPAll {
+		return errTopologyUnsupported
+	}
+
+	// the members of a replica set use the ports following the port of the deployment
+	if opts.shards == 0 && opts.Port != 0 {
+		for i := 1; i < opts.members; i++ {
+			if err := validatePort(opts.Port + i); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (opts *SetupOpts) promptLocalAdminPassword() error {
 	if !opts.IsTerminalInput() {
 		_, err := fmt.Fscanln(opts.InReader, &opts.DBUserPassword)
@@ -685,7 +773,7 @@ func (opts *SetupOpts) runLocal(ctx context.Context) error {
 	if err := opts.createLocalDeployment(ctx); err != nil {
 		// in case the deployment already exists we shouldn't delete it
 		if !errors.Is(err, ErrDeploymentExists) {
-			_ = opts.RemoveLocal(ctx)
+			opts.removeLocal(ctx)
 		}
 		return err
 	}
@@ -799,6 +887,7 @@ Please switch to the new command structure based on your target environment:
 				opts.InitOutput(cmd.OutOrStdout(), ""),
 				opts.InitInput(cmd.InOrStdin()),
 				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
+				opts.initSnapshotStore(cmd.Context()),
 				opts.initMongoDBClient,
 			)
 		},
@@ -819,6 +908,9 @@ Please switch to the new command structure based on your target environment:
 	cmd.Flags().IntVar(&opts.Port, flag.Port, 0, usage.MongodPort)
 	cmd.Flags().BoolVar(&opts.bindIPAll, flag.BindIPAll, false, usage.BindIPAll)
 	cmd.Flags().StringVar(&opts.initdb, flag.InitDB, "", usage.InitDB)
+	cmd.Flags().IntVar(&opts.members, flag.Members, 1, usage.DeploymentMembers)
+	cmd.Flags().IntVar(&opts.shards, flag.Shards, 0, usage.DeploymentShards)
+	cmd.Flags().StringVar(&opts.fromSnapshot, flag.FromSnapshot, "", usage.FromSnapshot)
 
 	// Atlas only
 	opts.atlasSetup.SetupAtlasFlags(cmd)
@@ -0,0 +1,285 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=setup_snapshot_mock_test.go -package=deployments -source=setup_snapshot.go
+
+const (
+	containerDBPath = "/data/db"
+	// every WiredTiger data directory holds this file
+	wiredTigerFile = "WiredTiger"
+	// the local database holds the replica set configuration of the snapshot cluster
+	localDB = "local"
+	// suffix of the short-lived container that resets the replica set configuration of the snapshot
+	resetContainerSuffix = "-snapshot-reset"
+)
+
+var (
+	errInvalidFromSnapshot      = fmt.Errorf("the --%s flag must be formatted as <clusterName>/<snapshotId>", flag.FromSnapshot)
+	errFromSnapshotLocalOnly    = fmt.Errorf("the --%s flag applies only to LOCAL deployments", flag.FromSnapshot)
+	errFromSnapshotUnsupported  = fmt.Errorf("the --%s flag can't be used with --%s, --%s or --%s", flag.FromSnapshot, flag.InitDB, flag.Members, flag.Shards)
+	errDownloadSnapshot         = errors.New("snapshot download failed")
+	errEmptySnapshotURL         = errors.New("the snapshot download URL is empty")
+	errSnapshotDownloadStatus   = errors.New("unexpected snapshot download status")
+	errSnapshotWithoutDataFiles = errors.New("the snapshot doesn't contain WiredTiger data files")
+	errUnsafeSnapshotPath       = errors.New("the snapshot contains a file outside of its directory")
+	errResetReplicaSet          = errors.New("resetting the replica set configuration of the snapshot failed")
+)
+
+type SnapshotDownloader interface {
+	DownloadFlexClusterSnapshot(string, string, *atlasv2.FlexBackupSnapshotDownloadCreate20241113) (*atlasv2.FlexBackupRestoreJob20241113, error)
+}
+
+func (opts *SetupOpts) initSnapshotStore(ctx context.Context) func() error {
+	return func() error {
+		if opts.fromSnapshot == "" {
+			return nil
+		}
+
+		var err error
+		opts.snapshotStore, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *SetupOpts) validateFromSnapshotFlag() error {
+	if opts.fromSnapshot == "" {
+		return nil
+	}
+
+	clusterName, snapshotID, ok := strings.Cut(opts.fromSnapshot, "/")
+	if !ok || clusterName == "" || snapshotID == "" || strings.Contains(snapshotID, "/") {
+		return errInvalidFromSnapshot
+	}
+
+	if opts.IsAtlasDeploymentType() {
+		return errFromSnapshotLocalOnly
+	}
+
+	if opts.initdb != "" || opts.isTopology() {
+		return errFromSnapshotUnsupported
+	}
+
+	return nil
+}
+
+// downloadSnapshot downloads the Atlas snapshot, extracts it and keeps the path of its data files to mount them in the deployment container.
+func (opts *SetupOpts) downloadSnapshot(ctx context.Context) error {
+	if err := opts.ValidateProjectID(); err != nil {
+		return err
+	}
+
+	clusterName, snapshotID, _ := strings.Cut(opts.fromSnapshot, "/")
+	job, err := opts.snapshotStore.DownloadFlexClusterSnapshot(opts.ConfigProjectID(), clusterName, &atlasv2.FlexBackupSnapshotDownloadCreate20241113{
+		SnapshotId: snapshotID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if job.SnapshotUrl == nil || *job.SnapshotUrl == "" {
+		return errEmptySnapshotURL
+	}
+
+	dir, err := options.SnapshotDataDir(opts.DeploymentName)
+	if err != nil {
+		return err
+	}
+	opts.snapshotDir = dir
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	if err := fetchSnapshot(ctx, *job.SnapshotUrl, dir); err != nil {
+		return err
+	}
+
+	opts.dataPath, err = findDBPath(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.resetReplicaSetConfig(ctx); err != nil {
+		return fmt.Errorf("%w: %w", errResetReplicaSet, err)
+	}
+
+	return nil
+}
+
+// resetReplicaSetConfig starts mongod standalone on the snapshot data and drops its local database.
+// The local database holds the replica set configuration of the Atlas cluster, the deployment can't find itself
+// in that configuration and would never become primary.
+func (opts *SetupOpts) resetReplicaSetConfig(ctx context.Context) error {
+	name := opts.LocalMongodHostname() + resetContainerSuffix
+	flags := container.RunFlags{
+		Detach:     pointer.Get(true),
+		Name:       pointer.Get(name),
+		Entrypoint: pointer.Get(mongodEntrypoint),
+		Args:       []string{"--dbpath", containerDBPath, "--bind_ip_all", "--port", strconv.Itoa(internalMongodPort)},
+		Volumes:    []container.VolumeMapping{{HostPath: opts.dataPath, ContainerPath: containerDBPath}},
+		Ports:      []container.PortMapping{{HostPort: 0, ContainerPort: internalMongodPort}},
+	}
+
+	if _, err := opts.ContainerEngine.ContainerRun(ctx, opts.MongodDockerImageName(), &flags); err != nil {
+		return err
+	}
+	defer func() {
+		_ = opts.ContainerEngine.ContainerRm(ctx, name)
+	}()
+
+	if err := opts.dropLocalDB(ctx, name); err != nil {
+		return err
+	}
+
+	// stopping the container shuts mongod down cleanly, so the data files are consistent
+	return opts.ContainerEngine.ContainerStop(ctx, name)
+}
+
+func (opts *SetupOpts) dropLocalDB(ctx context.Context, name string) error {
+	if err := opts.connectToMember(ctx, name); err != nil {
+		return err
+	}
+	defer func() {
+		_ = opts.mongodbClient.Disconnect(ctx)
+	}()
+
+	_, _ = log.Debugf("Dropping the %s database of the snapshot\n", localDB)
+	_, err := opts.mongodbClient.Database(localDB).RunCommand(ctx, bson.D{{Key: "dropDatabase", Value: 1}})
+	return err
+}
+
+func fetchSnapshot(ctx context.Context, url, dir string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req) //nolint:gosec // G704: URL comes from the Atlas API response (pre-signed snapshot download URL), not from user input
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s", errSnapshotDownloadStatus, resp.Status)
+	}
+
+	_, _ = log.Debugf("Extracting snapshot to %s\n", dir)
+	return extractTarGz(resp.Body, dir)
+}
+
+// extractTarGz extracts the directories and regular files of a .tgz archive, entries escaping dir are rejected.
+func extractTarGz(r io.Reader, dir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.FromSlash(header.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("%w: %s", errUnsafeSnapshotPath, header.Name)
+		}
+		target := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := extractFile(tr, target, header.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		default:
+			_, _ = log.Debugf("Skipping snapshot entry %s of type %c\n", header.Name, header.Typeflag)
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string, perm fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r) //nolint:gosec // G110: the archive is a snapshot of a database the user has access to
+	return err
+}
+
+// findDBPath returns the directory holding the WiredTiger data files, archives may wrap them in a directory.
+func findDBPath(dir string) (string, error) {
+	dbPath := ""
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == wiredTigerFile {
+			dbPath = filepath.Dir(path)
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if dbPath == "" {
+		return "", errSnapshotWithoutDataFiles
+	}
+
+	return dbPath, nil
+}
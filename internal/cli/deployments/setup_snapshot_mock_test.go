@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: setup_snapshot.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=setup_snapshot_mock_test.go -package=deployments -source=setup_snapshot.go
+//
+
+// Package deployments is a generated GoMock package.
+package deployments
+
+import (
+	reflect "reflect"
+
+	admin "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSnapshotDownloader is a mock of SnapshotDownloader interface.
+type MockSnapshotDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotDownloaderMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotDownloaderMockRecorder is the mock recorder for MockSnapshotDownloader.
+type MockSnapshotDownloaderMockRecorder struct {
+	mock *MockSnapshotDownloader
+}
+
+// NewMockSnapshotDownloader creates a new mock instance.
+func NewMockSnapshotDownloader(ctrl *gomock.Controller) *MockSnapshotDownloader {
+	mock := &MockSnapshotDownloader{ctrl: ctrl}
+	mock.recorder = &MockSnapshotDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotDownloader) EXPECT() *MockSnapshotDownloaderMockRecorder {
+	return m.recorder
+}
+
+// DownloadFlexClusterSnapshot mocks base method.
+func (m *MockSnapshotDownloader) DownloadFlexClusterSnapshot(arg0, arg1 string, arg2 *admin.FlexBackupSnapshotDownloadCreate20241113) (*admin.FlexBackupRestoreJob20241113, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFlexClusterSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.FlexBackupRestoreJob20241113)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadFlexClusterSnapshot indicates an expected call of DownloadFlexClusterSnapshot.
+func (mr *MockSnapshotDownloaderMockRecorder) DownloadFlexClusterSnapshot(arg0, arg1, arg2 any) *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFlexClusterSnapshot", reflect.TypeOf((*MockSnapshotDownloader)(nil).DownloadFlexClusterSnapshot), arg0, arg1, arg2)
+	return &MockSnapshotDownloaderDownloadFlexClusterSnapshotCall{Call: call}
+}
+
+// MockSnapshotDownloaderDownloadFlexClusterSnapshotCall wrap *gomock.Call
+type MockSnapshotDownloaderDownloadFlexClusterSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall) Return(arg0 *admin.FlexBackupRestoreJob20241113, arg1 error) *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall) Do(f func(string, string, *admin.FlexBackupSnapshotDownloadCreate20241113) (*admin.FlexBackupRestoreJob20241113, error)) *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall) DoAndReturn(f func(string, string, *admin.FlexBackupSnapshotDownloadCreate20241113) (*admin.FlexBackupRestoreJob20241113, error)) *MockSnapshotDownloaderDownloadFlexClusterSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
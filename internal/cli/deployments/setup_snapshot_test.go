@@ -0,0 +1,173 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/mock/gomock"
+)
+
+func newTarGz(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return buf
+}
+
+func TestExtractTarGz(t *testing.T) {
+	dir := t.TempDir()
+	archive := newTarGz(t, map[string]string{
+		"snapshot/WiredTiger":          "WiredTiger 11.2.0",
+		"snapshot/collection-0.wt":     "data",
+		"snapshot/journal/WiredTigerL": "log",
+	})
+
+	require.NoError(t, extractTarGz(archive, dir))
+
+	content, err := os.ReadFile(filepath.Join(dir, "snapshot", "collection-0.wt"))
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(content))
+
+	dbPath, err := findDBPath(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "snapshot"), dbPath)
+}
+
+func TestExtractTarGz_UnsafePath(t *testing.T) {
+	archive := newTarGz(t, map[string]string{
+		"../WiredTiger": "WiredTiger 11.2.0",
+	})
+
+	require.ErrorIs(t, extractTarGz(archive, t.TempDir()), errUnsafeSnapshotPath)
+}
+
+func TestFindDBPath_NoDataFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("empty"), 0o600))
+
+	_, err := findDBPath(dir)
+	require.ErrorIs(t, err, errSnapshotWithoutDataFiles)
+}
+
+func TestValidateFlags_fromSnapshot(t *testing.T) {
+	testCases := []struct {
+		name          string
+		opts          SetupOpts
+		expectedError error
+	}{
+		{name: "not set", opts: SetupOpts{}, expectedError: nil},
+		{name: "valid", opts: SetupOpts{fromSnapshot: "myCluster/5f4007f327a3bd7b6f4103c5"}, expectedError: nil},
+		{name: "missing snapshot", opts: SetupOpts{fromSnapshot: "myCluster"}, expectedError: errInvalidFromSnapshot},
+		{name: "empty cluster", opts: SetupOpts{fromSnapshot: "/5f4007f327a3bd7b6f4103c5"}, expectedError: errInvalidFromSnapshot},
+		{name: "initdb", opts: SetupOpts{fromSnapshot: "myCluster/5f4007f327a3bd7b6f4103c5", initdb: "./init"}, expectedError: errFromSnapshotUnsupported},
+		{name: "replica set", opts: SetupOpts{fromSnapshot: "myCluster/5f4007f327a3bd7b6f4103c5", members: 3}, expectedError: errFromSnapshotUnsupported},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.opts.validateFromSnapshotFlag()
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetupOpts_resetReplicaSetConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "snap")
+	mockMongodbClient := mocks.NewMockMongoDBClient(ctrl)
+	mockDB := mocks.NewMockDatabase(ctrl)
+
+	opts := &SetupOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		mongodbClient:  mockMongodbClient,
+		dataPath:       "/tmp/snapshot/db",
+	}
+
+	engine := deploymentTest.MockContainerEngine
+	gomock.InOrder(
+		engine.
+			EXPECT().
+			ContainerRun(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, flags *container.RunFlags) (string, error) {
+				// mongod runs standalone, without --replSet, on the snapshot data
+				assert.Equal(t, "snap-snapshot-reset", *flags.Name)
+				assert.Equal(t, "mongod", *flags.Entrypoint)
+				assert.Equal(t, []string{"--dbpath", "/data/db", "--bind_ip_all", "--port", "27017"}, flags.Args)
+				assert.Equal(t, []container.VolumeMapping{{HostPath: "/tmp/snapshot/db", ContainerPath: "/data/db"}}, flags.Volumes)
+				return "", nil
+			}).
+			Times(1),
+		engine.
+			EXPECT().
+			ContainerInspect(ctx, "snap-snapshot-reset").
+			Return([]*container.InspectData{
+				{
+					NetworkSettings: &container.NetworkSettings{
+						Ports: map[string][]container.InspectDataHostPort{
+							"27017/tcp": {{HostIP: "0.0.0.0", HostPort: "32768"}},
+						},
+					},
+				},
+			}, nil).
+			Times(1),
+		mockMongodbClient.
+			EXPECT().
+			Connect(ctx, "mongodb://localhost:32768/?directConnection=true", int64(topologyWaitSeconds)).
+			Return(nil).
+			Times(1),
+		mockMongodbClient.EXPECT().Database("local").Return(mockDB).Times(1),
+		mockDB.
+			EXPECT().
+			RunCommand(ctx, bson.D{{Key: "dropDatabase", Value: 1}}).
+			Return(bson.M{"ok": 1}, nil).
+			Times(1),
+		mockMongodbClient.EXPECT().Disconnect(ctx).Return(nil).Times(1),
+		engine.EXPECT().ContainerStop(ctx, "snap-snapshot-reset").Return(nil).Times(1),
+		engine.EXPECT().ContainerRm(ctx, "snap-snapshot-reset").Return(nil).Times(1),
+	)
+
+	require.NoError(t, opts.resetReplicaSetConfig(ctx))
+}
@@ -216,7 +216,7 @@ func TestSetupOpts_LocalDev_UnhappyPathOfflinePull(t *testing.T) {
 	deploymentTest.MockContainerEngine.EXPECT().ImagePull(ctx, dockerImageNameFallback).Return(errors.New("image pull failed")).Times(1)
 
 	// No local dev container exists yet
-	deploymentTest.MockContainerEngine.EXPECT().ContainerList(ctx, "mongodb-atlas-local=container").Return([]container.Container{}, nil).Times(1)
+	deploymentTest.MockContainerEngine.EXPECT().ContainerList(ctx, "mongodb-atlas-local=container").Return([]container.Container{}, nil).Times(2)
 
 	// Neither image exists locally
 	deploymentTest.MockContainerEngine.EXPECT().ImageList(ctx, dockerImageName).Return([]container.Image{}, nil).Times(1)
@@ -255,7 +255,7 @@ func TestSetupOpts_LocalDev_UnhappyPath_DiskSpaceError(t *testing.T) {
 	deploymentTest.MockContainerEngine.EXPECT().ImagePull(ctx, dockerImageName).Return(nil).Times(1)
 
 	// No local dev container exists yet
-	deploymentTest.MockContainerEngine.EXPECT().ContainerList(ctx, "mongodb-atlas-local=container").Return([]container.Container{}, nil).Times(1)
+	deploymentTest.MockContainerEngine.EXPECT().ContainerList(ctx, "mongodb-atlas-local=container").Return([]container.Container{}, nil).Times(2)
 
 	// Image health check succeeds
 	deploymentTest.MockContainerEngine.EXPECT().ImageHealthCheck(ctx, dockerImageName).Return(&container.ImageHealthCheck{
@@ -335,7 +335,7 @@ func TestSetupOpts_LocalDev_RemoveUnhealthyDeployment(t *testing.T) {
 	deploymentTest.MockContainerEngine.EXPECT().ImagePull(ctx, dockerImageName).Return(nil).Times(1)
 
 	// No local dev container exists yet
-	deploymentTest.MockContainerEngine.EXPECT().ContainerList(ctx, "mongodb-atlas-local=container").Return([]container.Container{}, nil).Times(1)
+	deploymentTest.MockContainerEngine.EXPECT().ContainerList(ctx, "mongodb-atlas-local=container").Return([]container.Container{}, nil).Times(2)
 
 	// Container run succeeds
 	deploymentTest.MockContainerEngine.EXPECT().ContainerRun(ctx, gomock.Any(), gomock.Any()).Return(deploymentName, nil).Times(1)
@@ -385,6 +385,103 @@ func TestValidateFlags_mdbVersions(t *testing.T) {
 	}
 }
 
+func TestValidateFlags_topology(t *testing.T) {
+	testCases := []struct {
+		name          string
+		opts          SetupOpts
+		expectedError error
+	}{
+		{name: "single node", opts: SetupOpts{members: 1}, expectedError: nil},
+		{name: "replica set", opts: SetupOpts{members: 3}, expectedError: nil},
+		{name: "sharded", opts: SetupOpts{members: 3, shards: 2}, expectedError: nil},
+		{name: "seven members", opts: SetupOpts{members: 7}, expectedError: nil},
+		{name: "no members", opts: SetupOpts{members: 0}, expectedError: errInvalidMembers},
+		{name: "negative members", opts: SetupOpts{members: -1}, expectedError: errInvalidMembers},
+		{name: "too many members", opts: SetupOpts{members: 8}, expectedError: errInvalidMembers},
+		{name: "negative shards", opts: SetupOpts{members: 1, shards: -1}, expectedError: errInvalidShards},
+		{name: "bindIpAll", opts: SetupOpts{members: 3, bindIPAll: true}, expectedError: errTopologyUnsupported},
+		{name: "initdb", opts: SetupOpts{members: 1, shards: 1, initdb: "./init"}, expectedError: errTopologyUnsupported},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.opts.validateTopologyFlags()
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSetupOpts_configureTopology_RunsMembersOnDeploymentNetwork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "rs")
+	errRun := errors.New("run failed")
+
+	opts := &SetupOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		members:        3,
+	}
+	opts.Port = 27017
+
+	deploymentTest.MockContainerEngine.EXPECT().NetworkCreate(ctx, "rs").Return(nil).Times(1)
+	deploymentTest.MockContainerEngine.EXPECT().
+		ContainerRun(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, _ string, flags *container.RunFlags) (string, error) {
+			assert.Equal(t, "rs", *flags.Name)
+			assert.Equal(t, "rs", *flags.Network)
+			assert.Equal(t, "mongod", *flags.Entrypoint)
+			assert.Equal(t, []string{"--replSet", "rs", "--bind_ip_all", "--port", "27017"}, flags.Args)
+			assert.Equal(t, "rs", flags.Labels[options.DeploymentLabel])
+			assert.Equal(t, 27017, flags.Ports[0].HostPort)
+			return "", nil
+		}).Times(1)
+	deploymentTest.MockContainerEngine.EXPECT().
+		ContainerRun(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, _ string, flags *container.RunFlags) (string, error) {
+			assert.Equal(t, "rs-1", *flags.Name)
+			assert.Equal(t, 27018, flags.Ports[0].HostPort)
+			return "", errRun
+		}).Times(1)
+
+	require.ErrorIs(t, opts.configureTopology(ctx), errRunContainer)
+}
+
+func TestSetupOpts_memberPorts(t *testing.T) {
+	opts := &SetupOpts{members: 3}
+
+	t.Run("replica set with port", func(t *testing.T) {
+		opts.Port = 27017
+		topology := opts.topology()
+		ports, err := opts.memberPorts(topology, topology.ReplicaSets[0])
+		require.NoError(t, err)
+		assert.Equal(t, []int{27017, 27018, 27019}, ports)
+	})
+
+	t.Run("replica set without port", func(t *testing.T) {
+		opts.Port = 0
+		topology := opts.topology()
+		ports, err := opts.memberPorts(topology, topology.ReplicaSets[0])
+		require.NoError(t, err)
+		require.Len(t, ports, 3)
+		assert.NotContains(t, ports, 0)
+		assert.NotEqual(t, ports[0], ports[1])
+		assert.NotEqual(t, ports[1], ports[2])
+		assert.NotEqual(t, ports[0], ports[2])
+	})
+
+	t.Run("sharded cluster", func(t *testing.T) {
+		opts.Port = 27017
+		opts.shards = 2
+		topology := opts.topology()
+		ports, err := opts.memberPorts(topology, topology.Shards()[0])
+		require.NoError(t, err)
+		assert.Equal(t, []int{0, 0, 0}, ports)
+	})
+}
+
 func TestSetupOpts_MongodDockerImageName(t *testing.T) {
 	testCases := []struct {
 		name          string
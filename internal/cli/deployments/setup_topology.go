@@ -0,0 +1,273 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	mongodEntrypoint = "mongod"
+	mongosEntrypoint = "mongos"
+	// the first member is preferred as primary so the connection string of the deployment targets the primary after the setup
+	primaryPriority = 2
+)
+
+func (opts *SetupOpts) isTopology() bool {
+	return opts.members > 1 || opts.shards > 0
+}
+
+func (opts *SetupOpts) topology() options.Topology {
+	return options.NewTopology(opts.DeploymentName, opts.members, opts.shards)
+}
+
+// configureTopology starts every member of the deployment on a network named after it, so members reach each other by container name.
+// The members of a replica set are published on known host ports, see memberPorts, the containers of a sharded cluster
+// are reached through the mongos, which is the only one using the port of the deployment.
+// The replica set configuration holds the container names, so only clients inside the deployment network discover the members,
+// clients on the host connect to a single member with directConnection=true.
+func (opts *SetupOpts) configureTopology(ctx context.Context) error {
+	t := opts.topology()
+
+	if err := opts.ContainerEngine.NetworkCreate(ctx, opts.DeploymentName); err != nil {
+		return fmt.Errorf("%w: %w", errCreateNetwork, err)
+	}
+
+	for _, rs := range t.ReplicaSets {
+		args := []string{"--replSet", rs.Name, "--bind_ip_all", "--port", strconv.Itoa(internalMongodPort)}
+		if rs.Role != options.MongodRole {
+			args = append(args, "--"+rs.Role)
+		}
+
+		ports, err := opts.memberPorts(t, rs)
+		if err != nil {
+			return err
+		}
+
+		for i, member := range rs.Members {
+			if err := opts.runMember(ctx, member, ports[i], mongodEntrypoint, args, t.Labels(rs.Role, rs.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rs := range t.ReplicaSets {
+		if err := opts.initiateReplicaSet(ctx, rs); err != nil {
+			return fmt.Errorf("%w: %s: %w", errInitReplicaSet, rs.Name, err)
+		}
+	}
+
+	if t.Mongos == "" {
+		return nil
+	}
+
+	configServers, _ := t.ConfigServers()
+	args := []string{"--configdb", replicaSetHosts(configServers), "--bind_ip_all", "--port", strconv.Itoa(internalMongodPort)}
+	if err := opts.runMember(ctx, t.Mongos, opts.Port, mongosEntrypoint, args, t.Labels(options.MongosRole, "")); err != nil {
+		return err
+	}
+
+	return opts.addShards(ctx, t)
+}
+
+// memberPorts returns the host port of every member of a replica set.
+// The members of a replica set deployment use consecutive ports starting at the port of the deployment,
+// or ports that are available when none is set, so they keep the same port when the deployment restarts.
+// The shards and config servers of a sharded cluster get a random port.
+func (opts *SetupOpts) memberPorts(t options.Topology, rs options.ReplicaSet) ([]int, error) {
+	if t.Mongos != "" {
+		return make([]int, len(rs.Members)), nil
+	}
+
+	if opts.Port == 0 {
+		return availablePorts(len(rs.Members))
+	}
+
+	ports := make([]int, len(rs.Members))
+	for i := range ports {
+		ports[i] = opts.Port + i
+	}
+
+	return ports, nil
+}
+
+func (opts *SetupOpts) runMember(ctx context.Context, name string, hostPort int, entrypoint string, args []string, labels map[string]string) error {
+	envVars := map[string]string{
+		"TOOL": "ATLASCLI",
+	}
+
+	if !config.TelemetryEnabled() {
+		envVars["DO_NOT_TRACK"] = "1"
+	}
+
+	flags := container.RunFlags{
+		Detach:     pointer.Get(true),
+		Name:       pointer.Get(name),
+		Hostname:   pointer.Get(name),
+		Network:    pointer.Get(opts.DeploymentName),
+		Entrypoint: pointer.Get(entrypoint),
+		Args:       args,
+		Env:        envVars,
+		Labels:     labels,
+		Ports:      []container.PortMapping{{HostPort: hostPort, ContainerPort: internalMongodPort}},
+	}
+
+	if _, err := opts.ContainerEngine.ContainerRun(ctx, opts.MongodDockerImageName(), &flags); err != nil {
+		_, _ = log.Debugf("Error encountered while trying to run container '%s': %s\n", name, err.Error())
+		if opts.isDiskSpaceError(err) {
+			return fmt.Errorf("%w: %w", errRunContainer, errInsufficientDiskSpace)
+		}
+		return fmt.Errorf("%w: %w", errRunContainer, err)
+	}
+
+	return nil
+}
+
+// initiateReplicaSet connects to the first member through its published port and waits for it to become primary.
+func (opts *SetupOpts) initiateReplicaSet(ctx context.Context, rs options.ReplicaSet) error {
+	if err := opts.connectToMember(ctx, rs.Members[0]); err != nil {
+		return err
+	}
+	defer func() {
+		_ = opts.mongodbClient.Disconnect(ctx)
+	}()
+
+	members := bson.A{}
+	for i, member := range rs.Members {
+		priority := 1
+		if i == 0 {
+			priority = primaryPriority
+		}
+		members = append(members, bson.D{
+			{Key: "_id", Value: i},
+			{Key: "host", Value: memberHost(member)},
+			{Key: "priority", Value: priority},
+		})
+	}
+
+	config := bson.D{
+		{Key: "_id", Value: rs.Name},
+		{Key: "members", Value: members},
+	}
+	if rs.Role == options.ConfigServerRole {
+		config = append(config, bson.E{Key: "configsvr", Value: true})
+	}
+
+	admin := opts.mongodbClient.Database("admin")
+	if _, err := admin.RunCommand(ctx, bson.D{{Key: "replSetInitiate", Value: config}}); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	for time.Since(start) < primaryTimeout {
+		result, err := admin.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}})
+		if err != nil {
+			return err
+		}
+		if hello, ok := result.(bson.M); ok && hello["isWritablePrimary"] == true {
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+
+	return errPrimaryTimeout
+}
+
+func (opts *SetupOpts) addShards(ctx context.Context, t options.Topology) error {
+	if err := opts.connectToMember(ctx, t.Mongos); err != nil {
+		return err
+	}
+	defer func() {
+		_ = opts.mongodbClient.Disconnect(ctx)
+	}()
+
+	admin := opts.mongodbClient.Database("admin")
+	for _, shard := range t.Shards() {
+		if _, err := admin.RunCommand(ctx, bson.D{{Key: "addShard", Value: replicaSetHosts(shard)}}); err != nil {
+			return fmt.Errorf("%w: %s: %w", errAddShard, shard.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (opts *SetupOpts) connectToMember(ctx context.Context, name string) error {
+	port, err := opts.publishedPort(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return opts.mongodbClient.Connect(ctx, fmt.Sprintf("mongodb://localhost:%d/?directConnection=true", port), topologyWaitSeconds)
+}
+
+// publishedPort returns the host port of a container, the containers of a sharded cluster other than the mongos get a random one.
+func (opts *SetupOpts) publishedPort(ctx context.Context, name string) (int, error) {
+	containers, err := opts.ContainerEngine.ContainerInspect(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, c := range containers {
+		if c.NetworkSettings == nil {
+			continue
+		}
+		if ports := c.NetworkSettings.Ports[strconv.Itoa(internalMongodPort)+"/tcp"]; len(ports) > 0 {
+			return strconv.Atoi(ports[0].HostPort)
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", errPortNotPublished, name)
+}
+
+// removeLocal deletes what was created by a failed setup.
+func (opts *SetupOpts) removeLocal(ctx context.Context) {
+	if opts.snapshotDir != "" {
+		_ = os.RemoveAll(opts.snapshotDir)
+	}
+
+	if !opts.isTopology() {
+		_ = opts.RemoveLocal(ctx)
+		return
+	}
+
+	_ = opts.ContainerEngine.ContainerRm(ctx, opts.topology().Containers()...)
+	_ = opts.ContainerEngine.NetworkRm(ctx, opts.DeploymentName)
+}
+
+func memberHost(name string) string {
+	return name + ":" + strconv.Itoa(internalMongodPort)
+}
+
+// replicaSetHosts returns the seed list of a replica set, like rs/host1:27017,host2:27017.
+func replicaSetHosts(rs options.ReplicaSet) string {
+	hosts := make([]string, len(rs.Members))
+	for i, member := range rs.Members {
+		hosts[i] = memberHost(member)
+	}
+
+	return rs.Name + "/" + strings.Join(hosts, ",")
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/spf13/cobra"
+)
+
+const (
+	createTemplate = "Snapshot '{{.ID}}' of deployment '{{.DeploymentName}}' created.\n"
+	// copies every data volume of the deployment, like /data/db and /data/configdb
+	createScript = "cp -a " + dataPath + "/. " + snapshotPath + "/"
+)
+
+type CreateOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+	options.DeploymentOpts
+	now func() time.Time
+}
+
+func (opts *CreateOpts) Run(ctx context.Context) error {
+	if err := opts.LocalDeploymentPreRun(ctx); err != nil {
+		return err
+	}
+
+	deployment, err := findDeployment(ctx, &opts.DeploymentOpts)
+	if err != nil {
+		return err
+	}
+
+	hostname := opts.LocalMongodHostname()
+	if data, err := opts.ContainerEngine.ContainerInspect(ctx, deployment.Names[0]); err == nil && len(data) > 0 && data[0].Config != nil && data[0].Config.Hostname != "" {
+		hostname = data[0].Config.Hostname
+	}
+
+	createdAt := opts.now()
+	snapshot := Snapshot{
+		ID:             newSnapshotID(opts.DeploymentName, createdAt),
+		DeploymentName: opts.DeploymentName,
+		Hostname:       hostname,
+		MongoDBVersion: deployment.Labels["version"],
+		Image:          deployment.Image,
+		CreatedAt:      createdAt.UTC().Format(time.RFC3339),
+	}
+
+	opts.StartSpinner()
+	err = opts.snapshot(ctx, deployment, snapshot)
+	opts.StopSpinner()
+	if err != nil {
+		return err
+	}
+
+	return opts.Print(snapshot)
+}
+
+// snapshot stops the deployment while its data is copied, a running deployment is started again afterwards.
+func (opts *CreateOpts) snapshot(ctx context.Context, deployment container.Container, snapshot Snapshot) error {
+	exists, err := volumeExists(ctx, opts.ContainerEngine, snapshot.volume())
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("%w: %s", errSnapshotExists, snapshot.ID)
+	}
+
+	if err := opts.ContainerEngine.VolumeCreate(ctx, snapshot.volume(), snapshot.labels()); err != nil {
+		return err
+	}
+
+	running := deployment.State == "running"
+	if running {
+		if err := opts.ContainerEngine.ContainerStop(ctx, deployment.Names[0]); err != nil {
+			_ = opts.ContainerEngine.VolumeRm(ctx, snapshot.volume())
+			return err
+		}
+	}
+
+	err = copyData(ctx, opts.ContainerEngine, snapshot.Image, deployment.Names[0], snapshot.volume(), createScript)
+	if err != nil {
+		_ = opts.ContainerEngine.VolumeRm(ctx, snapshot.volume())
+	}
+
+	if running {
+		if startErr := opts.ContainerEngine.ContainerStart(ctx, deployment.Names[0]); err == nil {
+			err = startErr
+		}
+	}
+
+	return err
+}
+
+// CreateBuilder builds a cobra.Command that can run as:
+// atlas deployments snapshots create <deploymentName>.
+func CreateBuilder() *cobra.Command {
+	opts := &CreateOpts{
+		now: time.Now,
+	}
+	cmd := &cobra.Command{
+		Use:   "create <deploymentName>",
+		Short: "Create a snapshot of the data of a local deployment.",
+		Long: `The deployment is stopped while its data is copied to a container volume, a running deployment is started again afterwards.
+
+Snapshots are only supported for single node local deployments.`,
+		Args: require.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"deploymentNameDesc": "Name of the local deployment.",
+			"output":             createTemplate,
+		},
+		Example: `  # Create a snapshot of the local deployment named myDeployment:
+  atlas deployments snapshots create myDeployment`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
+				opts.InitOutput(cmd.OutOrStdout(), createTemplate))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.DeploymentName = args[0]
+			}
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
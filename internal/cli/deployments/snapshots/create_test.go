@@ -0,0 +1,162 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	snapshotID   = "localDeployment-20260101120000.000"
+	snapshotName = volumePrefix + snapshotID
+	image        = "mongodb/mongodb-atlas-local:8.0"
+)
+
+func localContainers(state string) []container.Container {
+	return []container.Container{
+		{
+			Names:  []string{"localDeployment"},
+			State:  state,
+			Image:  image,
+			Labels: map[string]string{"version": "8.0.4"},
+		},
+	}
+}
+
+func TestCreate_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
+	engine := deploymentTest.MockContainerEngine
+	buf := new(bytes.Buffer)
+
+	opts := &CreateOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  createTemplate,
+			OutWriter: buf,
+		},
+		now: func() time.Time {
+			return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		},
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("running"), nil).Times(1)
+	engine.EXPECT().ContainerInspect(ctx, "localDeployment").Return([]*container.InspectData{{Config: &container.InspectDataConfig{Hostname: "localDeployment"}}}, nil).Times(1)
+	engine.EXPECT().VolumeList(ctx).Return([]container.Volume{{Name: volumePrefix + "localDeployment-20260101110000.000"}}, nil).Times(1)
+	engine.EXPECT().
+		VolumeCreate(ctx, snapshotName, gomock.Any()).
+		DoAndReturn(func(_ any, _ string, labels map[string]string) error {
+			assert.Equal(t, snapshotID, labels[snapshotIDLabel])
+			assert.Equal(t, "localDeployment", labels[deploymentLabel])
+			assert.Equal(t, "8.0.4", labels[mongoDBVersionLabel])
+			assert.Equal(t, "2026-01-01T12:00:00Z", labels[createdAtLabel])
+			return nil
+		}).Times(1)
+	gomock.InOrder(
+		engine.EXPECT().ContainerStop(ctx, "localDeployment").Return(nil).Times(1),
+		engine.EXPECT().
+			ContainerRun(ctx, image, gomock.Any()).
+			DoAndReturn(func(_ any, _ string, flags *container.RunFlags) (string, error) {
+				assert.Equal(t, "localDeployment", *flags.VolumesFrom)
+				assert.Equal(t, []container.VolumeMapping{{HostPath: snapshotName, ContainerPath: snapshotPath}}, flags.Volumes)
+				assert.Equal(t, []string{"-c", createScript}, flags.Args)
+				return "", nil
+			}).Times(1),
+		engine.EXPECT().ContainerStart(ctx, "localDeployment").Return(nil).Times(1),
+	)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Equal(t, "Snapshot 'localDeployment-20260101120000.000' of deployment 'localDeployment' created.\n", buf.String())
+}
+
+func TestCreate_Run_CopyFailureRemovesVolume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
+	engine := deploymentTest.MockContainerEngine
+	errRun := errors.New("no space left on device")
+
+	opts := &CreateOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		now:            time.Now,
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("exited"), nil).Times(1)
+	engine.EXPECT().ContainerInspect(ctx, "localDeployment").Return(nil, errors.New("inspect failed")).Times(1)
+	engine.EXPECT().VolumeList(ctx).Return(nil, nil).Times(1)
+	engine.EXPECT().VolumeCreate(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	engine.EXPECT().ContainerRun(ctx, image, gomock.Any()).Return("", errRun).Times(1)
+	engine.EXPECT().VolumeRm(ctx, gomock.Any()).Return(nil).Times(1)
+
+	require.ErrorIs(t, opts.Run(ctx), errCopyData)
+}
+
+func TestCreate_Run_SnapshotExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
+	engine := deploymentTest.MockContainerEngine
+
+	opts := &CreateOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		now: func() time.Time {
+			return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		},
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("running"), nil).Times(1)
+	engine.EXPECT().ContainerInspect(ctx, "localDeployment").Return(nil, errors.New("inspect failed")).Times(1)
+	engine.EXPECT().VolumeList(ctx).Return([]container.Volume{{Name: snapshotName}}, nil).Times(1)
+	engine.EXPECT().VolumeCreate(ctx, gomock.Any(), gomock.Any()).Times(0)
+	engine.EXPECT().ContainerStop(ctx, gomock.Any()).Times(0)
+
+	require.ErrorIs(t, opts.Run(ctx), errSnapshotExists)
+}
+
+func TestCreate_Run_Topology(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
+	engine := deploymentTest.MockContainerEngine
+
+	opts := &CreateOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		now:            time.Now,
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(deploymentTest.MockReplicaSetContainers("running", 3), nil).Times(1)
+
+	require.ErrorIs(t, opts.Run(ctx), errTopologyUnsupported)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/cobra"
+)
+
+const (
+	deleteSuccessMessage = "Snapshot '%s' deleted\n"
+	deleteFailMessage    = "Snapshot not deleted"
+)
+
+type DeleteOpts struct {
+	cli.PreRunOpts
+	*cli.DeleteOpts
+	options.DeploymentOpts
+}
+
+func (opts *DeleteOpts) Run(ctx context.Context) error {
+	if err := opts.LocalDeploymentPreRun(ctx); err != nil {
+		return err
+	}
+
+	snapshot, err := findSnapshot(ctx, opts.ContainerEngine, opts.Entry)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.Prompt(); err != nil {
+		return err
+	}
+
+	return opts.Delete(func() error {
+		return opts.ContainerEngine.VolumeRm(ctx, snapshot.volume())
+	})
+}
+
+// DeleteBuilder builds a cobra.Command that can run as:
+// atlas deployments snapshots delete <snapshotId> [--force].
+func DeleteBuilder() *cobra.Command {
+	opts := &DeleteOpts{
+		DeleteOpts: cli.NewDeleteOpts(deleteSuccessMessage, deleteFailMessage),
+	}
+	cmd := &cobra.Command{
+		Use:     "delete <snapshotId>",
+		Aliases: []string{"rm"},
+		Short:   "Delete a snapshot of a local deployment.",
+		Args:    require.ExactArgs(1),
+		Annotations: map[string]string{
+			"snapshotIdDesc": "Unique identifier of the snapshot.",
+			"output":         deleteSuccessMessage,
+		},
+		Example: `  # Delete the snapshot myDeployment-20260101120000.000 without a confirmation prompt:
+  atlas deployments snapshots delete myDeployment-20260101120000.000 --force`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.Entry = args[0]
+			return opts.PreRunE(opts.InitStore(cmd.Context(), cmd.OutOrStdout()))
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirm, flag.Force, false, usage.Force)
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDelete_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "")
+	engine := deploymentTest.MockContainerEngine
+
+	opts := &DeleteOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		DeleteOpts:     cli.NewDeleteOpts(deleteSuccessMessage, deleteFailMessage),
+	}
+	opts.Entry = snapshotID
+	opts.Confirm = true
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"="+snapshotID).Return(snapshotVolumes(), nil).Times(1)
+	engine.EXPECT().VolumeRm(ctx, snapshotName).Return(nil).Times(1)
+
+	require.NoError(t, opts.Run(ctx))
+}
+
+func TestDelete_Run_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "")
+	engine := deploymentTest.MockContainerEngine
+
+	opts := &DeleteOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		DeleteOpts:     cli.NewDeleteOpts(deleteSuccessMessage, deleteFailMessage),
+	}
+	opts.Entry = "unknown"
+	opts.Confirm = true
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"=unknown").Return(nil, nil).Times(1)
+
+	require.ErrorIs(t, opts.Run(ctx), errSnapshotNotFound)
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/cobra"
+)
+
+const listTemplate = `ID	DEPLOYMENT	MONGODB VERSION	CREATED AT{{range valueOrEmptySlice .}}
+{{.ID}}	{{.DeploymentName}}	{{.MongoDBVersion}}	{{.CreatedAt}}{{end}}
+`
+
+type ListOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+	options.DeploymentOpts
+}
+
+func (opts *ListOpts) Run(ctx context.Context) error {
+	if err := opts.LocalDeploymentPreRun(ctx); err != nil {
+		return err
+	}
+
+	snapshots, err := listSnapshots(ctx, opts.ContainerEngine, opts.DeploymentName)
+	if err != nil {
+		return err
+	}
+
+	return opts.Print(snapshots)
+}
+
+// ListBuilder builds a cobra.Command that can run as:
+// atlas deployments snapshots list [--deploymentName deploymentName].
+func ListBuilder() *cobra.Command {
+	opts := &ListOpts{}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the snapshots of local deployments.",
+		Args:    require.NoArgs,
+		Annotations: map[string]string{
+			"output": listTemplate,
+		},
+		Example: `  # List the snapshots of the local deployment named myDeployment:
+  atlas deployments snapshots list --deploymentName myDeployment`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
+				opts.InitOutput(cmd.OutOrStdout(), listTemplate))
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.DeploymentName, flag.DeploymentName, "", usage.SnapshotsDeploymentName)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
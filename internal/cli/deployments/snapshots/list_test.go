@@ -0,0 +1,62 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestList_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
+	engine := deploymentTest.MockContainerEngine
+	buf := new(bytes.Buffer)
+
+	opts := &ListOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  listTemplate,
+			OutWriter: buf,
+		},
+	}
+
+	newer := Snapshot{ID: "localDeployment-2", DeploymentName: "localDeployment", MongoDBVersion: "8.0.4", CreatedAt: "2026-01-02T12:00:00Z"}
+	older := Snapshot{ID: "localDeployment-1", DeploymentName: "localDeployment", MongoDBVersion: "8.0.4", CreatedAt: "2026-01-01T12:00:00Z"}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().
+		VolumeList(ctx, SnapshotFilter, deploymentLabel+"=localDeployment").
+		Return([]container.Volume{
+			{Name: newer.volume(), Labels: newer.labels()},
+			{Name: older.volume(), Labels: older.labels()},
+		}, nil).
+		Times(1)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Equal(t, `ID                  DEPLOYMENT        MONGODB VERSION   CREATED AT
+localDeployment-1   localDeployment   8.0.4             2026-01-01T12:00:00Z
+localDeployment-2   localDeployment   8.0.4             2026-01-02T12:00:00Z
+`, buf.String())
+}
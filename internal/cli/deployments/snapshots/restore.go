@@ -0,0 +1,186 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/cobra"
+)
+
+const (
+	restoreTemplate = `Snapshot '{{.SnapshotID}}' restored to deployment '{{.DeploymentName}}'.
+Connection string: "{{.ConnectionString}}"
+`
+	// the volumes mounted under /data can't be removed, only their content
+	restoreScript      = "find " + dataPath + " -mindepth 2 -delete && cp -a " + snapshotPath + "/. " + dataPath + "/"
+	internalMongodPort = 27017
+	healthyTimeout     = 10 * time.Minute
+)
+
+var errHostnameMismatch = errors.New("the snapshot was created from a deployment with a different hostname, restore it to a new deployment instead")
+
+type RestoreOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+	options.DeploymentOpts
+	snapshotID string
+}
+
+type restoreResult struct {
+	SnapshotID       string `json:"snapshotId"`
+	DeploymentName   string `json:"deploymentName"`
+	ConnectionString string `json:"connectionString"`
+}
+
+func (opts *RestoreOpts) Run(ctx context.Context) error {
+	if err := opts.LocalDeploymentPreRun(ctx); err != nil {
+		return err
+	}
+
+	snapshot, err := findSnapshot(ctx, opts.ContainerEngine, opts.snapshotID)
+	if err != nil {
+		return err
+	}
+
+	if opts.DeploymentName == "" {
+		opts.DeploymentName = snapshot.DeploymentName
+	}
+
+	deployment, err := findDeployment(ctx, &opts.DeploymentOpts)
+	if errors.Is(err, options.ErrDeploymentNotFound) {
+		deployment, err = opts.createDeployment(ctx, snapshot)
+	}
+	if err != nil {
+		return err
+	}
+
+	opts.StartSpinner()
+	err = opts.restore(ctx, deployment, snapshot)
+	opts.StopSpinner()
+	if err != nil {
+		return err
+	}
+
+	// the port is assigned by the container engine when --port is not set, read it back from the deployment
+	opts.Port = 0
+	connectionString, err := opts.ConnectionString(ctx)
+	if err != nil {
+		return err
+	}
+
+	return opts.Print(restoreResult{
+		SnapshotID:       snapshot.ID,
+		DeploymentName:   opts.DeploymentName,
+		ConnectionString: connectionString,
+	})
+}
+
+// createDeployment runs a new deployment with the hostname of the snapshot, the replica set configuration stored with the data refers to it.
+func (opts *RestoreOpts) createDeployment(ctx context.Context, snapshot Snapshot) (container.Container, error) {
+	flags := container.RunFlags{
+		Detach:   pointer.Get(true),
+		Name:     pointer.Get(opts.LocalMongodHostname()),
+		Hostname: pointer.Get(snapshot.Hostname),
+		Env:      map[string]string{"TOOL": "ATLASCLI"},
+		Ports:    []container.PortMapping{{HostPort: opts.Port, ContainerPort: internalMongodPort}},
+	}
+
+	if _, err := opts.ContainerEngine.ContainerRun(ctx, snapshot.Image, &flags); err != nil {
+		return container.Container{}, err
+	}
+
+	return container.Container{
+		Names: []string{opts.LocalMongodHostname()},
+		State: "running",
+		Image: snapshot.Image,
+	}, nil
+}
+
+// restore replaces the data of the deployment with the content of the snapshot and waits for the deployment to be healthy.
+func (opts *RestoreOpts) restore(ctx context.Context, deployment container.Container, snapshot Snapshot) error {
+	name := deployment.Names[0]
+
+	data, err := opts.ContainerEngine.ContainerInspect(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 && data[0].Config != nil && data[0].Config.Hostname != "" && data[0].Config.Hostname != snapshot.Hostname {
+		return fmt.Errorf("%w: %s", errHostnameMismatch, snapshot.Hostname)
+	}
+
+	if deployment.State == "running" || deployment.State == "paused" {
+		if err := opts.ContainerEngine.ContainerStop(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	if err := copyData(ctx, opts.ContainerEngine, deployment.Image, name, snapshot.volume(), restoreScript); err != nil {
+		return err
+	}
+
+	if err := opts.ContainerEngine.ContainerStart(ctx, name); err != nil {
+		return err
+	}
+
+	return opts.WaitForHealthyDeployment(ctx, healthyTimeout)
+}
+
+// RestoreBuilder builds a cobra.Command that can run as:
+// atlas deployments snapshots restore <snapshotId> [--deploymentName deploymentName] [--port port].
+func RestoreBuilder() *cobra.Command {
+	opts := &RestoreOpts{}
+	cmd := &cobra.Command{
+		Use:   "restore <snapshotId>",
+		Short: "Restore a snapshot to an existing or a new local deployment.",
+		Long: `The data of the deployment is replaced with the data of the snapshot.
+When the deployment doesn't exist, it is created with the image and the hostname of the deployment the snapshot was created from.`,
+		Args: require.ExactArgs(1),
+		Annotations: map[string]string{
+			"snapshotIdDesc": "Unique identifier of the snapshot.",
+			"output":         restoreTemplate,
+		},
+		Example: `  # Restore a snapshot to the deployment it was created from:
+  atlas deployments snapshots restore myDeployment-20260101120000.000
+
+  # Restore a snapshot to a new deployment named myCopy listening on port 27018:
+  atlas deployments snapshots restore myDeployment-20260101120000.000 --deploymentName myCopy --port 27018`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.snapshotID = args[0]
+			return opts.PreRunE(
+				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
+				opts.InitOutput(cmd.OutOrStdout(), restoreTemplate))
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.DeploymentName, flag.DeploymentName, "", usage.SnapshotRestoreTarget)
+	cmd.Flags().IntVar(&opts.Port, flag.Port, 0, usage.SnapshotRestorePort)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func snapshotVolumes() []container.Volume {
+	s := Snapshot{
+		ID:             snapshotID,
+		DeploymentName: "localDeployment",
+		Hostname:       "localDeployment",
+		Image:          image,
+	}
+
+	return []container.Volume{{Name: s.volume(), Labels: s.labels()}}
+}
+
+func boundDeployment(port string) []*container.InspectData {
+	return []*container.InspectData{{
+		Config: &container.InspectDataConfig{Hostname: "localDeployment"},
+		NetworkSettings: &container.NetworkSettings{
+			Ports: map[string][]container.InspectDataHostPort{"27017/tcp": {{HostPort: port}}},
+		},
+	}}
+}
+
+func TestRestore_Run_ExistingDeployment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "")
+	engine := deploymentTest.MockContainerEngine
+	buf := new(bytes.Buffer)
+
+	opts := &RestoreOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  restoreTemplate,
+			OutWriter: buf,
+		},
+		snapshotID: snapshotID,
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"="+snapshotID).Return(snapshotVolumes(), nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("running"), nil).Times(1)
+	engine.EXPECT().ContainerInspect(ctx, "localDeployment").Return(boundDeployment("27017"), nil).Times(2)
+	gomock.InOrder(
+		engine.EXPECT().ContainerStop(ctx, "localDeployment").Return(nil).Times(1),
+		engine.EXPECT().
+			ContainerRun(ctx, image, gomock.Any()).
+			DoAndReturn(func(_ any, _ string, flags *container.RunFlags) (string, error) {
+				assert.Equal(t, "localDeployment", *flags.VolumesFrom)
+				assert.Equal(t, []string{"-c", restoreScript}, flags.Args)
+				return "", nil
+			}).Times(1),
+		engine.EXPECT().ContainerStart(ctx, "localDeployment").Return(nil).Times(1),
+		engine.EXPECT().ContainerHealthStatus(ctx, "localDeployment").Return(container.DockerHealthcheckStatusHealthy, nil).Times(1),
+	)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Equal(t, `Snapshot 'localDeployment-20260101120000.000' restored to deployment 'localDeployment'.
+Connection string: "mongodb://localhost:27017/?directConnection=true"
+`, buf.String())
+}
+
+func TestRestore_Run_NewDeployment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "copy")
+	engine := deploymentTest.MockContainerEngine
+	buf := new(bytes.Buffer)
+
+	opts := &RestoreOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  restoreTemplate,
+			OutWriter: buf,
+		},
+		snapshotID: snapshotID,
+	}
+	opts.Port = 27018
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"="+snapshotID).Return(snapshotVolumes(), nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("running"), nil).Times(1)
+	gomock.InOrder(
+		engine.EXPECT().
+			ContainerRun(ctx, image, gomock.Any()).
+			DoAndReturn(func(_ any, _ string, flags *container.RunFlags) (string, error) {
+				assert.Equal(t, "copy", *flags.Name)
+				assert.Equal(t, "localDeployment", *flags.Hostname)
+				assert.Equal(t, 27018, flags.Ports[0].HostPort)
+				return "", nil
+			}).Times(1),
+		engine.EXPECT().ContainerInspect(ctx, "copy").Return(boundDeployment("27018"), nil).Times(1),
+		engine.EXPECT().ContainerStop(ctx, "copy").Return(nil).Times(1),
+		engine.EXPECT().ContainerRun(ctx, image, gomock.Any()).Return("", nil).Times(1),
+		engine.EXPECT().ContainerStart(ctx, "copy").Return(nil).Times(1),
+		engine.EXPECT().ContainerHealthStatus(ctx, "copy").Return(container.DockerHealthcheckStatusHealthy, nil).Times(1),
+		engine.EXPECT().ContainerInspect(ctx, "copy").Return(boundDeployment("27018"), nil).Times(1),
+	)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Contains(t, buf.String(), "mongodb://localhost:27018/?directConnection=true")
+}
+
+func TestRestore_Run_NewDeploymentAutoassignPort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "copy")
+	engine := deploymentTest.MockContainerEngine
+	buf := new(bytes.Buffer)
+
+	opts := &RestoreOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  restoreTemplate,
+			OutWriter: buf,
+		},
+		snapshotID: snapshotID,
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"="+snapshotID).Return(snapshotVolumes(), nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("running"), nil).Times(1)
+	engine.EXPECT().ContainerRun(ctx, image, gomock.Any()).Return("", nil).Times(2)
+	engine.EXPECT().ContainerInspect(ctx, "copy").Return(boundDeployment("32768"), nil).Times(2)
+	engine.EXPECT().ContainerStop(ctx, "copy").Return(nil).Times(1)
+	engine.EXPECT().ContainerStart(ctx, "copy").Return(nil).Times(1)
+	engine.EXPECT().ContainerHealthStatus(ctx, "copy").Return(container.DockerHealthcheckStatusHealthy, nil).Times(1)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Contains(t, buf.String(), "mongodb://localhost:32768/?directConnection=true")
+}
+
+func TestRestore_Run_HostnameMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := t.Context()
+	deploymentTest := fixture.NewMockLocalDeploymentOpts(ctrl, "localDeployment")
+	engine := deploymentTest.MockContainerEngine
+
+	opts := &RestoreOpts{
+		DeploymentOpts: *deploymentTest.Opts,
+		snapshotID:     snapshotID,
+	}
+
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"="+snapshotID).Return(snapshotVolumes(), nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(localContainers("running"), nil).Times(1)
+	engine.EXPECT().ContainerInspect(ctx, "localDeployment").Return([]*container.InspectData{{Config: &container.InspectDataConfig{Hostname: "other"}}}, nil).Times(1)
+
+	require.ErrorIs(t, opts.Run(ctx), errHostnameMismatch)
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// SnapshotFilter selects the volumes holding the snapshots of local deployments.
+	SnapshotFilter      = "mongodb-atlas-local=snapshot"
+	snapshotIDLabel     = "mongodb-atlas-local.snapshot.id"
+	deploymentLabel     = "mongodb-atlas-local.snapshot.deployment"
+	hostnameLabel       = "mongodb-atlas-local.snapshot.hostname"
+	imageLabel          = "mongodb-atlas-local.snapshot.image"
+	mongoDBVersionLabel = "mongodb-atlas-local.snapshot.mongodbVersion"
+	createdAtLabel      = "mongodb-atlas-local.snapshot.createdAt"
+	volumePrefix        = "mongodb-atlas-local-snapshot-"
+	idTimeFormat        = "20060102150405.000"
+	// every data volume of the deployment image is mounted under dataPath
+	dataPath     = "/data"
+	snapshotPath = "/snapshot"
+	shell        = "/bin/sh"
+)
+
+var (
+	errSnapshotNotFound    = errors.New("snapshot not found")
+	errTopologyUnsupported = errors.New("snapshots are only supported for single node local deployments")
+	errCopyData            = errors.New("copying the deployment data failed")
+	errSnapshotExists      = errors.New("snapshot already exists")
+)
+
+// Snapshot is a copy of the data of a local deployment, stored in a container volume.
+type Snapshot struct {
+	ID             string `json:"id"`
+	DeploymentName string `json:"deploymentName"`
+	Hostname       string `json:"hostname"`
+	MongoDBVersion string `json:"mongoDBVersion"`
+	Image          string `json:"image"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+func newSnapshot(v container.Volume) Snapshot {
+	return Snapshot{
+		ID:             v.Labels[snapshotIDLabel],
+		DeploymentName: v.Labels[deploymentLabel],
+		Hostname:       v.Labels[hostnameLabel],
+		MongoDBVersion: v.Labels[mongoDBVersionLabel],
+		Image:          v.Labels[imageLabel],
+		CreatedAt:      v.Labels[createdAtLabel],
+	}
+}
+
+func (s Snapshot) volume() string {
+	return volumePrefix + s.ID
+}
+
+func (s Snapshot) labels() map[string]string {
+	return map[string]string{
+		"mongodb-atlas-local": "snapshot",
+		snapshotIDLabel:       s.ID,
+		deploymentLabel:       s.DeploymentName,
+		hostnameLabel:         s.Hostname,
+		mongoDBVersionLabel:   s.MongoDBVersion,
+		imageLabel:            s.Image,
+		createdAtLabel:        s.CreatedAt,
+	}
+}
+
+func newSnapshotID(deploymentName string, now time.Time) string {
+	return deploymentName + "-" + now.UTC().Format(idTimeFormat)
+}
+
+// volumeExists returns true when a volume is named name, creating it again would silently reuse its data.
+func volumeExists(ctx context.Context, engine container.Engine, name string) (bool, error) {
+	volumes, err := engine.VolumeList(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(volumes, func(v container.Volume) bool {
+		return v.Name == name
+	}), nil
+}
+
+// listSnapshots returns the snapshots sorted by creation date, only the ones of the deployment when deploymentName is set.
+func listSnapshots(ctx context.Context, engine container.Engine, deploymentName string) ([]Snapshot, error) {
+	filters := []string{SnapshotFilter}
+	if deploymentName != "" {
+		filters = append(filters, deploymentLabel+"="+deploymentName)
+	}
+
+	volumes, err := engine.VolumeList(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(volumes))
+	for _, v := range volumes {
+		snapshots = append(snapshots, newSnapshot(v))
+	}
+
+	slices.SortFunc(snapshots, func(a, b Snapshot) int {
+		return strings.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+func findSnapshot(ctx context.Context, engine container.Engine, id string) (Snapshot, error) {
+	volumes, err := engine.VolumeList(ctx, SnapshotFilter, snapshotIDLabel+"="+id)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if len(volumes) == 0 {
+		return Snapshot{}, fmt.Errorf("%w: %s", errSnapshotNotFound, id)
+	}
+
+	return newSnapshot(volumes[0]), nil
+}
+
+// findDeployment returns the container of a single node local deployment, prompting for the deployment when no name is given.
+func findDeployment(ctx context.Context, opts *options.DeploymentOpts) (container.Container, error) {
+	if opts.DeploymentName == "" {
+		if err := opts.SelectLocal(ctx); err != nil {
+			return container.Container{}, err
+		}
+	}
+
+	containers, err := opts.GetLocalContainers(ctx)
+	if err != nil {
+		return container.Container{}, err
+	}
+
+	var deployment *container.Container
+	for i, c := range containers {
+		if c.Labels[options.DeploymentLabel] == opts.DeploymentName {
+			return container.Container{}, errTopologyUnsupported
+		}
+		if c.Names[0] == opts.LocalMongodHostname() {
+			deployment = &containers[i]
+		}
+	}
+
+	if deployment == nil {
+		return container.Container{}, fmt.Errorf("%w: %s", options.ErrDeploymentNotFound, opts.DeploymentName)
+	}
+
+	return *deployment, nil
+}
+
+// copyData runs a short-lived container sharing the volumes of the deployment to copy its data with a shell script.
+// The deployment must be stopped so the files are consistent.
+func copyData(ctx context.Context, engine container.Engine, image, deploymentName, volume, script string) error {
+	flags := container.RunFlags{
+		Remove:      pointer.Get(true),
+		VolumesFrom: pointer.Get(deploymentName),
+		Volumes:     []container.VolumeMapping{{HostPath: volume, ContainerPath: snapshotPath}},
+		Entrypoint:  pointer.Get(shell),
+		Args:        []string{"-c", script},
+	}
+
+	if _, err := engine.ContainerRun(ctx, image, &flags); err != nil {
+		return fmt.Errorf("%w: %w", errCopyData, err)
+	}
+
+	return nil
+}
+
+func Builder() *cobra.Command {
+	const use = "snapshots"
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Manage snapshots of the data of local deployments.",
+		GroupID: "all",
+	}
+
+	cmd.AddCommand(
+		CreateBuilder(),
+		ListBuilder(),
+		RestoreBuilder(),
+		DeleteBuilder(),
+	)
+
+	return cmd
+}
@@ -50,6 +50,7 @@ func TestStart_RunLocal_PausedContainers(t *testing.T) {
 
 	expected := deploymentsTest.MockContainerWithState("paused")
 	deploymentsTest.LocalMockFlowWithMockContainer(ctx, expected)
+	deploymentsTest.MockLocalContainerNames(ctx, expected)
 
 	deploymentsTest.MockContainerEngine.
 		EXPECT().
@@ -82,6 +83,7 @@ func TestStart_RunLocal_StoppedContainers(t *testing.T) {
 
 	expected := deploymentsTest.MockContainerWithState("exited")
 	deploymentsTest.LocalMockFlowWithMockContainer(ctx, expected)
+	deploymentsTest.MockLocalContainerNames(ctx, expected)
 
 	deploymentsTest.MockContainerEngine.
 		EXPECT().
@@ -63,10 +63,39 @@ func (m *MockDeploymentOpts) LocalMockFlowWithMockContainer(ctx context.Context,
 		Times(1)
 }
 
+// MockLocalContainerNames expects the containers to be listed again to find all the containers of the deployment.
+func (m *MockDeploymentOpts) MockLocalContainerNames(ctx context.Context, mockContainer []container.Container) {
+	m.MockContainerEngine.
+		EXPECT().
+		ContainerList(ctx, options.ContainerFilter).
+		Return(mockContainer, nil).
+		Times(1)
+}
+
 func (m *MockDeploymentOpts) LocalMockFlow(ctx context.Context) {
 	m.LocalMockFlowWithMockContainer(ctx, m.MockContainerWithState("running"))
 }
 
+// MockReplicaSetContainers returns the containers of a replica set deployment, the first member is named after the deployment.
+func (m *MockDeploymentOpts) MockReplicaSetContainers(state string, members int) []container.Container {
+	topology := options.NewTopology(m.Opts.DeploymentName, members, 0)
+	rs := topology.ReplicaSets[0]
+
+	containers := make([]container.Container, len(rs.Members))
+	for i, name := range rs.Members {
+		labels := topology.Labels(rs.Role, rs.Name)
+		labels["version"] = "7.0.9"
+		containers[i] = container.Container{
+			Names:  []string{name},
+			State:  state,
+			Labels: labels,
+			ID:     name,
+		}
+	}
+
+	return containers
+}
+
 func (m *MockDeploymentOpts) MockContainerWithState(state string) []container.Container {
 	return []container.Container{
 		{
@@ -0,0 +1,257 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mongodbclient"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	upCreated = "CREATED"
+	upStarted = "STARTED"
+	upRunning = "RUNNING"
+)
+
+var errLoadSampleData = errors.New("loading the sample data failed")
+
+const upTemplate = `DEPLOYMENT	STATUS	CONNECTION STRING
+{{range valueOrEmptySlice .}}{{.Name}}	{{.Status}}	{{.ConnectionString}}
+{{end}}`
+
+type upResult struct {
+	Name             string `json:"name"`
+	Status           string `json:"status"`
+	ConnectionString string `json:"connectionString"`
+}
+
+type UpOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+	options.DeploymentOpts
+	mongodbClient mongodbclient.MongoDBClient
+	fs            afero.Fs
+	filename      string
+}
+
+func (opts *UpOpts) initMongoDBClient() error {
+	opts.mongodbClient = mongodbclient.NewClient()
+	return nil
+}
+
+func (opts *UpOpts) Run(ctx context.Context) error {
+	compose, err := loadComposeFile(opts.fs, opts.filename)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.LocalDeploymentPreRun(ctx); err != nil {
+		return err
+	}
+
+	deployments, err := opts.GetLocalDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]options.Deployment, len(deployments))
+	for _, d := range deployments {
+		existing[d.Name] = d
+	}
+
+	results := make([]upResult, 0, len(compose.Deployments))
+	for _, d := range compose.Deployments {
+		var r *upResult
+		if deployment, ok := existing[d.Name]; ok {
+			r, err = opts.reconcile(ctx, d, deployment)
+		} else {
+			r, err = opts.create(ctx, d)
+		}
+		if err != nil {
+			return fmt.Errorf(composeErrFormat, d.Name, err)
+		}
+		results = append(results, *r)
+	}
+
+	return opts.Print(results)
+}
+
+// deploymentOpts returns the options of the deployment d, sharing the container engine of the command.
+func (opts *UpOpts) deploymentOpts(d composeDeployment) options.DeploymentOpts {
+	return options.DeploymentOpts{
+		DeploymentName:      d.Name,
+		DeploymentType:      options.LocalCluster,
+		MdbVersion:          d.MdbVersion,
+		Port:                d.Port,
+		DBUsername:          d.Username,
+		DBUserPassword:      d.Password,
+		ContainerEngine:     opts.ContainerEngine,
+		DeploymentTelemetry: opts.DeploymentTelemetry,
+	}
+}
+
+// reconcile starts an existing deployment if needed, the deployment isn't recreated when its settings drift from the file.
+func (opts *UpOpts) reconcile(ctx context.Context, d composeDeployment, deployment options.Deployment) (*upResult, error) {
+	deploymentOpts := opts.deploymentOpts(d)
+
+	status := upRunning
+	if deployment.StateName != options.IdleState && deployment.StateName != options.RestartingState {
+		_, _ = log.Warningf("Starting deployment %s...\n", d.Name)
+		if err := deploymentOpts.StartLocal(ctx, deployment); err != nil {
+			return nil, err
+		}
+		status = upStarted
+	}
+
+	if d.MdbVersion != "" && !matchesVersion(deployment.MongoDBVersion, d.MdbVersion) {
+		_, _ = log.Warningf("Deployment %s runs MongoDB %s instead of %s, run atlas deployments down to recreate it\n",
+			d.Name, deployment.MongoDBVersion, d.MdbVersion)
+	}
+
+	// read the port from the container
+	deploymentOpts.Port = 0
+	cs, err := deploymentOpts.ConnectionString(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Port != 0 && deploymentOpts.Port != d.Port {
+		_, _ = log.Warningf("Deployment %s listens to port %d instead of %d, run atlas deployments down to recreate it\n",
+			d.Name, deploymentOpts.Port, d.Port)
+	}
+
+	if err := opts.ensureSearchIndexes(ctx, d, cs); err != nil {
+		return nil, err
+	}
+
+	return &upResult{Name: d.Name, Status: status, ConnectionString: cs}, nil
+}
+
+func (opts *UpOpts) create(ctx context.Context, d composeDeployment) (*upResult, error) {
+	setupOpts := &SetupOpts{
+		DeploymentOpts: opts.deploymentOpts(d),
+		OutputOpts:     cli.OutputOpts{OutWriter: opts.OutWriter},
+		settings:       defaultSettings,
+		force:          true,
+		initdb:         d.InitDB,
+		members:        1,
+	}
+	if setupOpts.MdbVersion == "" {
+		setupOpts.MdbVersion = mdb8
+	}
+
+	if err := setupOpts.validateFlags(); err != nil {
+		return nil, err
+	}
+
+	if err := setupOpts.createLocalDeployment(ctx); err != nil {
+		if !errors.Is(err, ErrDeploymentExists) {
+			setupOpts.removeLocal(ctx)
+		}
+		return nil, err
+	}
+
+	cs, err := setupOpts.ConnectionString(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// sample data is only loaded once, when the deployment is created
+	if d.SampleData {
+		_, _ = log.Warningf("Loading sample data into %s...\n", d.Name)
+		if err := loadSampleData(ctx, cs); err != nil {
+			return nil, fmt.Errorf("%w: %w", errLoadSampleData, err)
+		}
+	}
+
+	if err := opts.ensureSearchIndexes(ctx, d, cs); err != nil {
+		return nil, err
+	}
+
+	return &upResult{Name: d.Name, Status: upCreated, ConnectionString: cs}, nil
+}
+
+func (opts *UpOpts) ensureSearchIndexes(ctx context.Context, d composeDeployment, cs string) error {
+	if len(d.SearchIndexes) == 0 {
+		return nil
+	}
+
+	created, err := createSearchIndexes(ctx, opts.mongodbClient, cs, d.SearchIndexes)
+	if created > 0 {
+		_, _ = log.Warningf("Created %d search index(es) on %s\n", created, d.Name)
+	}
+
+	return err
+}
+
+func (opts *UpOpts) PostRun() {
+	opts.UpdateDeploymentTelemetry()
+}
+
+// UpBuilder builds a cobra.Command that can run as:
+// atlas deployments up.
+func UpBuilder() *cobra.Command {
+	opts := &UpOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Create or start the local deployments described in an atlas-local.yaml file.",
+		Long: `Missing deployments are created with their init scripts and sample data, stopped or paused deployments are started, and missing search indexes are created.
+Existing deployments are never recreated: when their MongoDB version or port differ from the file, the command prints a warning. Run atlas deployments down to delete them.
+
+Usernames and passwords can reference environment variables, like ${DB_PASSWORD}. Init scripts directories are relative to the file. Loading sample data requires mongorestore, from the MongoDB Database Tools.`,
+		Example: `  # Create or start the deployments described in the atlas-local.yaml file of the current directory:
+  atlas deployments up
+
+  # Create or start the deployments described in another file:
+  atlas deployments up --file dev/atlas-local.yaml`,
+		Args:    require.NoArgs,
+		GroupID: "all",
+		Annotations: map[string]string{
+			"output": upTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			opts.DeploymentType = options.LocalCluster
+			return opts.PreRunE(
+				opts.InitOutput(cmd.OutOrStdout(), upTemplate),
+				opts.InitStore(cmd.Context(), cmd.OutOrStdout()),
+				opts.initMongoDBClient,
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+		PostRun: func(_ *cobra.Command, _ []string) {
+			opts.PostRun()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, defaultComposeFile, usage.ComposeFile)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
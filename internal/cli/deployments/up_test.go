@@ -0,0 +1,182 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployments
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/options"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/deployments/test/fixture"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mocks"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mongodbclient"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUp_Run_StartsExistingDeployment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMongodbClient := mocks.NewMockMongoDBClient(ctrl)
+	mockDB := mocks.NewMockDatabase(ctrl)
+	mockColl := mocks.NewMockCollection(ctrl)
+	ctx := t.Context()
+	buf := new(bytes.Buffer)
+
+	deploymentsTest := fixture.NewMockLocalDeploymentOpts(ctrl, "dev")
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, defaultComposeFile, []byte(`deployments:
+  - name: dev
+    port: 27018
+    searchIndexes:
+      - name: default
+        database: db
+        collection: coll
+        definition:
+          mappings:
+            dynamic: true
+`), 0600))
+
+	opts := &UpOpts{
+		DeploymentOpts: *deploymentsTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  upTemplate,
+			OutWriter: buf,
+		},
+		mongodbClient: mockMongodbClient,
+		fs:            fs,
+		filename:      defaultComposeFile,
+	}
+
+	containers := deploymentsTest.MockContainerWithState("exited")
+	engine := deploymentsTest.MockContainerEngine
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.EXPECT().ContainerList(ctx, options.ContainerFilter).Return(containers, nil).Times(2)
+	engine.EXPECT().ContainerStart(ctx, "dev").Return(nil).Times(1)
+	engine.
+		EXPECT().
+		ContainerInspect(ctx, "dev").
+		Return([]*container.InspectData{
+			{
+				Name: "dev",
+				Config: &container.InspectDataConfig{
+					Labels: map[string]string{"version": "7.0.9"},
+				},
+				NetworkSettings: &container.NetworkSettings{
+					Ports: map[string][]container.InspectDataHostPort{
+						"27017/tcp": {{HostIP: "127.0.0.1", HostPort: "27018"}},
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	mockMongodbClient.
+		EXPECT().
+		Connect(ctx, "mongodb://localhost:27018/?directConnection=true", int64(connectWaitSeconds)).
+		Return(nil).
+		Times(1)
+	mockMongodbClient.EXPECT().Disconnect(ctx).Return(nil).Times(1)
+	mockMongodbClient.EXPECT().Database("db").Return(mockDB).Times(1)
+	mockDB.EXPECT().Collection("coll").Return(mockColl).Times(1)
+	mockColl.
+		EXPECT().
+		SearchIndexByName(ctx, "default").
+		Return(nil, mongodbclient.ErrSearchIndexNotFound).
+		Times(1)
+	mockColl.
+		EXPECT().
+		CreateSearchIndex(ctx, "default", "search", map[string]any{"mappings": map[string]any{"dynamic": true}}).
+		Return(&mongodbclient.SearchIndexDefinition{}, nil).
+		Times(1)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Equal(t, `DEPLOYMENT   STATUS    CONNECTION STRING
+dev          STARTED   mongodb://localhost:27018/?directConnection=true
+`, buf.String())
+}
+
+func TestUp_Run_RunningDeploymentWithExistingIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMongodbClient := mocks.NewMockMongoDBClient(ctrl)
+	mockDB := mocks.NewMockDatabase(ctrl)
+	mockColl := mocks.NewMockCollection(ctrl)
+	ctx := t.Context()
+	buf := new(bytes.Buffer)
+
+	deploymentsTest := fixture.NewMockLocalDeploymentOpts(ctrl, "dev")
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, defaultComposeFile, []byte(`deployments:
+  - name: dev
+    searchIndexes:
+      - name: default
+        database: db
+        collection: coll
+`), 0600))
+
+	opts := &UpOpts{
+		DeploymentOpts: *deploymentsTest.Opts,
+		OutputOpts: cli.OutputOpts{
+			Template:  upTemplate,
+			OutWriter: buf,
+		},
+		mongodbClient: mockMongodbClient,
+		fs:            fs,
+		filename:      defaultComposeFile,
+	}
+
+	engine := deploymentsTest.MockContainerEngine
+	engine.EXPECT().Ready().Return(nil).Times(1)
+	engine.EXPECT().VerifyVersion(ctx).Return(nil).Times(1)
+	engine.
+		EXPECT().
+		ContainerList(ctx, options.ContainerFilter).
+		Return(deploymentsTest.MockContainerWithState("running"), nil).
+		Times(1)
+	engine.
+		EXPECT().
+		ContainerInspect(ctx, "dev").
+		Return([]*container.InspectData{
+			{
+				Name:   "dev",
+				Config: &container.InspectDataConfig{},
+				NetworkSettings: &container.NetworkSettings{
+					Ports: map[string][]container.InspectDataHostPort{
+						"27017/tcp": {{HostIP: "127.0.0.1", HostPort: "27017"}},
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	mockMongodbClient.EXPECT().Connect(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockMongodbClient.EXPECT().Disconnect(ctx).Return(nil).Times(1)
+	mockMongodbClient.EXPECT().Database("db").Return(mockDB).Times(1)
+	mockDB.EXPECT().Collection("coll").Return(mockColl).Times(1)
+	mockColl.
+		EXPECT().
+		SearchIndexByName(ctx, "default").
+		Return(&mongodbclient.SearchIndexDefinition{}, nil).
+		Times(1)
+
+	require.NoError(t, opts.Run(ctx))
+	assert.Contains(t, buf.String(), "dev          RUNNING")
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dirconfig"
+)
+
+const (
+	envPrefix = "MONGODB_ATLAS_"
+
+	ProfileProperty   = "profile"
+	ProjectIDProperty = "project_id"
+	OrgIDProperty     = "org_id"
+	OutputProperty    = "output"
+)
+
+// EnvName returns the environment variable that sets a profile property.
+func EnvName(property string) string {
+	return envPrefix + strings.ToUpper(property)
+}
+
+// IsSetInEnv returns true when an environment variable sets the property.
+func IsSetInEnv(property string) bool {
+	return os.Getenv(EnvName(property)) != ""
+}
+
+// contextValue returns the value of the directory context file unless an environment variable sets the property,
+// the value of the profile otherwise.
+func contextValue(property, dirValue string, profileValue func() string) string {
+	if dirValue != "" && !IsSetInEnv(property) {
+		return dirValue
+	}
+	return profileValue()
+}
+
+// ContextProjectID returns the project ID of the directory context file, profileValue resolves it otherwise.
+func ContextProjectID(profileValue func() string) string {
+	return contextValue(ProjectIDProperty, dirconfig.Current().ProjectID, profileValue)
+}
+
+// ContextOrgID returns the organization ID of the directory context file, profileValue resolves it otherwise.
+func ContextOrgID(profileValue func() string) string {
+	return contextValue(OrgIDProperty, dirconfig.Current().OrgID, profileValue)
+}
+
+// ContextOutput returns the output format of the directory context file, profileValue resolves it otherwise.
+func ContextOutput(profileValue func() string) string {
+	return contextValue(OutputProperty, dirconfig.Current().Output, profileValue)
+}
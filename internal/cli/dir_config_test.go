@@ -0,0 +1,41 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import "testing"
+
+func TestContextValue(t *testing.T) {
+	profileValue := func() string { return "profile" }
+
+	if got := contextValue(ProjectIDProperty, "", profileValue); got != "profile" {
+		t.Errorf("contextValue() = %q, want the profile value", got)
+	}
+	if got := contextValue(ProjectIDProperty, "dir", profileValue); got != "dir" {
+		t.Errorf("contextValue() = %q, want the directory context file value", got)
+	}
+
+	t.Setenv(EnvName(ProjectIDProperty), "env")
+	if got := contextValue(ProjectIDProperty, "dir", profileValue); got != "profile" {
+		t.Errorf("contextValue() = %q, want the value config resolves from the environment", got)
+	}
+}
+
+func TestProjectOpts_ConfigProjectID(t *testing.T) {
+	opts := &ProjectOpts{ProjectID: "5e2211c17a3e5a48f5497de3"}
+	t.Setenv(EnvName(ProjectIDProperty), "5e2211c17a3e5a48f5497de4")
+	if got := opts.ConfigProjectID(); got != "5e2211c17a3e5a48f5497de3" {
+		t.Errorf("ConfigProjectID() = %q, want the flag value", got)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/loganalysis"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/cobra"
+)
+
+// LogFollowOpts options of the commands that can keep printing the new lines of a log,
+// only keeping the lines that match the filters.
+type LogFollowOpts struct {
+	Follow     bool
+	Grep       string
+	Components []string
+	Severities []string
+}
+
+func (opts *LogFollowOpts) hasFilters() bool {
+	return opts.Grep != "" || len(opts.Components) > 0 || len(opts.Severities) > 0
+}
+
+// ValidateLogFollow validates that the filters are only used with --follow.
+func (opts *LogFollowOpts) ValidateLogFollow() error {
+	if !opts.Follow && opts.hasFilters() {
+		return fmt.Errorf("--%s, --%s and --%s require --%s", flag.LogGrep, flag.LogComponent, flag.LogSeverity, flag.Follow)
+	}
+	if _, err := regexp.Compile(opts.Grep); err != nil {
+		return fmt.Errorf("invalid --%s: %w", flag.LogGrep, err)
+	}
+	return nil
+}
+
+// LineFilter returns the filter of the log lines, nil when no filter is set.
+func (opts *LogFollowOpts) LineFilter() (*loganalysis.LineFilter, error) {
+	if !opts.hasFilters() {
+		return nil, nil
+	}
+	f := &loganalysis.LineFilter{
+		Components: opts.Components,
+		Severities: opts.Severities,
+	}
+	if opts.Grep != "" {
+		var err error
+		if f.Grep, err = regexp.Compile(opts.Grep); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flag.LogGrep, err)
+		}
+	}
+	return f, nil
+}
+
+func (opts *LogFollowOpts) AddLogFollowFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&opts.Follow, flag.Follow, false, usage.LogFollow)
+	cmd.Flags().StringVar(&opts.Grep, flag.LogGrep, "", usage.LogGrep)
+	cmd.Flags().StringSliceVar(&opts.Components, flag.LogComponent, nil, usage.LogComponent)
+	cmd.Flags().StringSliceVar(&opts.Severities, flag.LogSeverity, nil, usage.LogSeverity)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import "testing"
+
+func TestLogFollowOpts_ValidateLogFollow(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    LogFollowOpts
+		wantErr bool
+	}{
+		{name: "no follow", opts: LogFollowOpts{}},
+		{name: "follow with filters", opts: LogFollowOpts{Follow: true, Grep: "conn[0-9]+", Components: []string{"NETWORK"}, Severities: []string{"W"}}},
+		{name: "filters without follow", opts: LogFollowOpts{Severities: []string{"E"}}, wantErr: true},
+		{name: "invalid grep", opts: LogFollowOpts{Follow: true, Grep: "conn["}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.ValidateLogFollow(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLogFollow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLogFollowOpts_LineFilter(t *testing.T) {
+	opts := &LogFollowOpts{Follow: true}
+	filter, err := opts.LineFilter()
+	if err != nil || filter != nil {
+		t.Fatalf("LineFilter() = %v, %v, want no filter", filter, err)
+	}
+
+	opts.Grep = "Connection"
+	opts.Components = []string{"NETWORK"}
+	filter, err = opts.LineFilter()
+	if err != nil {
+		t.Fatalf("LineFilter() unexpected error: %v", err)
+	}
+	if !filter.Match([]byte(`{"t":{"$date":"2026-01-10T10:00:00.000+00:00"},"s":"I","c":"NETWORK","msg":"Connection accepted"}`)) {
+		t.Error("LineFilter() doesn't match the connection")
+	}
+}
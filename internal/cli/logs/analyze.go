@@ -0,0 +1,147 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"io/fs"
+	"path/filepath"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/loganalysis"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type AnalyzeOpts struct {
+	cli.PreRunOpts
+	cli.OutputOpts
+	path string
+	fs   afero.Fs
+}
+
+var analyzeTemplate = `FILES	LINES	ENTRIES	SKIPPED
+{{.Files}}	{{.Lines}}	{{.Entries}}	{{.Skipped}}
+
+SLOW QUERIES
+NAMESPACE	SHAPE	COUNT	COLLSCANS	TOTAL MS	MAX MS	AVG MS{{range valueOrEmptySlice .SlowQueries}}
+{{.Namespace}}	{{.Shape}}	{{.Count}}	{{.CollScans}}	{{.TotalMillis}}	{{.MaxMillis}}	{{.AverageMillis}}{{end}}
+
+COLLSCANS
+NAMESPACE	COUNT	TOTAL MS{{range valueOrEmptySlice .CollScans}}
+{{.Namespace}}	{{.Count}}	{{.TotalMillis}}{{end}}
+
+CONNECTIONS
+CLIENT	OPENED	CLOSED{{range valueOrEmptySlice .Connections}}
+{{.Client}}	{{.Opened}}	{{.Closed}}{{end}}
+
+ERRORS
+CODE	NAME	COUNT{{range valueOrEmptySlice .Errors}}
+{{.Code}}	{{.Name}}	{{.Count}}{{end}}
+
+ELECTIONS
+TIME	SOURCE	MESSAGE{{range valueOrEmptySlice .Elections}}
+{{.Time}}	{{.Source}}	{{.Message}}{{end}}
+`
+
+// logFiles returns the files to analyze, the files of a directory are walked in lexical order.
+func (opts *AnalyzeOpts) logFiles() ([]string, error) {
+	info, err := opts.fs.Stat(opts.path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{opts.path}, nil
+	}
+
+	var files []string
+	err = afero.Walk(opts.fs, opts.path, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func (opts *AnalyzeOpts) analyzeFile(a *loganalysis.Analyzer, path string) error {
+	f, err := opts.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	source := filepath.Base(path)
+	if rel, err := filepath.Rel(opts.path, path); err == nil && rel != "." {
+		source = filepath.ToSlash(rel)
+	}
+	return a.Analyze(source, f)
+}
+
+func (opts *AnalyzeOpts) Run() error {
+	files, err := opts.logFiles()
+	if err != nil {
+		return err
+	}
+
+	a := loganalysis.NewAnalyzer()
+	for _, path := range files {
+		if err := opts.analyzeFile(a, path); err != nil {
+			return err
+		}
+	}
+
+	return opts.Print(a.Summary())
+}
+
+// atlas logs analyze <file|dir> [-o/--output].
+func AnalyzeBuilder() *cobra.Command {
+	opts := &AnalyzeOpts{fs: afero.NewOsFs()}
+	cmd := &cobra.Command{
+		Use:   "analyze <file|dir>",
+		Short: "Summarize the slow queries, collection scans, connections, errors, and elections of MongoDB logs.",
+		Long: `Parses the structured JSON logs of mongod and mongos, like the logs downloaded with atlas logs download.
+
+Gzipped logs are decompressed automatically. When you provide a directory, the command analyzes all of its files, like the logs downloaded with atlas logs download --allHosts.
+Slow queries are grouped by namespace and query shape, which is the query with its values replaced.`,
+		Args: require.ExactArgs(1),
+		Annotations: map[string]string{
+			"file|dirDesc": "Log file or directory of log files to analyze.",
+			"output":       analyzeTemplate,
+		},
+		Example: `  # Summarize a log downloaded with atlas logs download:
+  atlas logs analyze mongodb.gz
+
+  # Summarize the logs of all the hosts of a cluster:
+  atlas logs download --allHosts --clusterName myCluster --out logs
+  atlas logs analyze logs --output json`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+			return opts.PreRunE(
+				opts.InitOutput(cmd.OutOrStdout(), analyzeTemplate),
+			)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+const analyzeTestLog = `{"t":{"$date":"2026-01-10T10:00:01.000+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"sales.orders","command":{"find":"orders","filter":{"status":"A"}},"planSummary":"COLLSCAN","durationMillis":300}}
+{"t":{"$date":"2026-01-10T10:00:08.000+00:00"},"s":"I","c":"ELECTION","id":21450,"ctx":"ReplCoord-0","msg":"Election succeeded, assuming primary role","attr":{"term":2}}
+`
+
+func TestAnalyze_Run(t *testing.T) {
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	if _, err := w.Write([]byte(analyzeTestLog)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "logs/host-00/mongodb.log.gz", gz.Bytes(), 0o644)
+	_ = afero.WriteFile(fs, "logs/host-01/mongodb.log", []byte(analyzeTestLog), 0o644)
+
+	testCases := []struct {
+		name  string
+		path  string
+		files string
+	}{
+		{name: "file", path: "logs/host-00/mongodb.log.gz", files: "1"},
+		{name: "directory", path: "logs", files: "2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			opts := &AnalyzeOpts{path: tc.path, fs: fs}
+			opts.Template = analyzeTemplate
+			opts.OutWriter = buf
+
+			if err := opts.Run(); err != nil {
+				t.Fatalf("Run() unexpected error: %v", err)
+			}
+			out := buf.String()
+			for _, want := range []string{`find {"status":1}`, "sales.orders", "Election succeeded", "\n" + tc.files + " "} {
+				if !strings.Contains(out, want) {
+					t.Errorf("output doesn't contain %q: %s", want, out)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyze_RunNotFound(t *testing.T) {
+	opts := &AnalyzeOpts{path: "missing", fs: afero.NewMemMapFs()}
+	if err := opts.Run(); err == nil {
+		t.Fatal("Run() expected an error")
+	}
+}
@@ -16,11 +16,14 @@ package logs
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/convert"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/decryption"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/validate"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
@@ -29,12 +32,40 @@ type DecryptOpts struct {
 	cli.ProjectOpts
 	cli.OutputOpts
 	cli.DownloaderOpts
+	cli.InputOpts
 	inFileName string
+	workers    int
+	verifyOnly bool
+	format     string
+	filterOpts DecryptFilterOpts
+	localOpts  DecryptLocalOpts
+	kmipOpts   DecryptKMIPOpts
 	awsOpts    DecryptAWSOpts
 	gcpOpts    DecryptGCPOpts
 	azureOpts  DecryptAzureOpts
 }
 
+type DecryptFilterOpts struct {
+	aTypes     []string
+	users      []string
+	namespaces []string
+	since      string
+	until      string
+	filter     *decryption.AuditLogFilter
+}
+
+type DecryptLocalOpts struct {
+	localKeyFile string
+}
+
+type DecryptKMIPOpts struct {
+	kmipServerCAFile              string
+	kmipClientCertificateFile     string
+	kmipClientCertificatePassword string
+	kmipUsername                  string
+	kmipPassword                  string
+}
+
 type DecryptAWSOpts struct {
 	awsAccessKey       string
 	awsSecretAccessKey string
@@ -53,8 +84,31 @@ type DecryptAzureOpts struct {
 
 var decryptTemplate = "Decrypt of %s to %s completed.\n"
 
+var verifyTemplate = `HEADER LINE	STATUS	RECORDS	ISSUES{{range valueOrEmptySlice .Sections}}
+{{.Line}}	{{if .Error}}INVALID{{else}}VALID{{end}}	{{.Records}}	{{.Issues}}{{end}}
+{{if .Issues}}
+LINE	ISSUE	DETAIL{{range valueOrEmptySlice .Issues}}
+{{.Line}}	{{.Type}}	{{.Detail}}{{end}}
+{{else}}
+No integrity issues found in {{.Records}} records.
+{{end}}`
+
+// stdInMode reads the encrypted audit log from the standard input, so it can be piped from atlas logs download.
+const stdInMode = "-"
+
 func (opts *DecryptOpts) newDecryption() *decryption.Decryption {
 	return decryption.NewDecryption(
+		decryption.WithWorkers(opts.workers),
+		decryption.WithOutputFormat(decryption.AuditLogOutputFormat(opts.format)),
+		decryption.WithFilter(opts.filterOpts.filter),
+		decryption.WithLocalOpts(opts.localOpts.localKeyFile),
+		decryption.WithKMIPOpts(
+			opts.kmipOpts.kmipServerCAFile,
+			opts.kmipOpts.kmipClientCertificateFile,
+			opts.kmipOpts.kmipClientCertificatePassword,
+			opts.kmipOpts.kmipUsername,
+			opts.kmipOpts.kmipPassword,
+		),
 		decryption.WithAWSOpts(opts.awsOpts.awsAccessKey, opts.awsOpts.awsSecretAccessKey, opts.awsOpts.awsSessionToken),
 		decryption.WithGCPOpts(opts.gcpOpts.gcpServiceAccountKey),
 		decryption.WithAzureOpts(opts.azureOpts.azureTenantID, opts.azureOpts.azureClientID, opts.azureOpts.azureSecret),
@@ -67,14 +121,82 @@ func (opts *DecryptOpts) initDefaultOut() error {
 	return nil
 }
 
+func (opts *DecryptOpts) validateFormat() error {
+	return validate.FlagInSlice(opts.format, flag.DecryptFormat, decryption.AuditLogOutputFormats)
+}
+
+func (opts *DecryptOpts) initFilter() error {
+	f := &decryption.AuditLogFilter{
+		ATypes:     opts.filterOpts.aTypes,
+		Users:      opts.filterOpts.users,
+		Namespaces: opts.filterOpts.namespaces,
+	}
+
+	var err error
+	if opts.filterOpts.since != "" {
+		if f.Since, err = convert.ParseTimestamp(opts.filterOpts.since); err != nil {
+			return fmt.Errorf("--%s: %w", flag.Since, err)
+		}
+	}
+	if opts.filterOpts.until != "" {
+		if f.Until, err = convert.ParseTimestamp(opts.filterOpts.until); err != nil {
+			return fmt.Errorf("--%s: %w", flag.Until, err)
+		}
+	}
+
+	opts.filterOpts.filter = f
+	return nil
+}
+
+func (opts *DecryptOpts) initDefaultIn() error {
+	if opts.inFileName == "" {
+		opts.inFileName = stdInMode
+	}
+	return nil
+}
+
+func (opts *DecryptOpts) newReader() (io.ReadCloser, error) {
+	if opts.inFileName == stdInMode {
+		return io.NopCloser(opts.ConfigReader()), nil
+	}
+	return opts.Fs.Open(opts.inFileName)
+}
+
+// verify prints the integrity report of the audit log, and fails when the audit log has integrity issues.
+func (opts *DecryptOpts) verify() error {
+	inReader, err := opts.newReader()
+	if err != nil {
+		return err
+	}
+	defer inReader.Close()
+
+	report, err := opts.newDecryption().Verify(inReader)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.Print(report); err != nil {
+		return err
+	}
+
+	if report.Tampered() {
+		return decryption.ErrAuditLogTampered
+	}
+	return nil
+}
+
 func (opts *DecryptOpts) Run() error {
+	if opts.verifyOnly {
+		return opts.verify()
+	}
+
 	outWriter, err := opts.NewWriteCloser()
 	if err != nil {
 		return err
 	}
 	defer outWriter.Close()
 
-	inReader, err := opts.Fs.Open(opts.inFileName)
+	inReader, err := opts.newReader()
 	if err != nil {
 		return err
 	}
@@ -100,19 +222,36 @@ func DecryptBuilder() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:    "decrypt",
 		Hidden: true,
-		Short:  "Decrypts an audit log file with the provided AWS, GCP or Azure key management services.",
+		Short:  "Decrypts an audit log file with the provided local key file, KMIP server, or AWS, GCP or Azure key management services.",
 		Annotations: map[string]string{
 			"output": decryptTemplate,
 		},
-		Example: `  # Decrypt using AWS credentials:
+		Example: `  # Decrypt an audit log downloaded to the standard output, using a local key file:
+  atlas logs download myHost mongodb-audit-log.gz --out - --decompress | atlas logs decrypt --localKeyFile /path/to/localKey
+  # Decrypt using a local key file:
+  atlas logs decrypt --file /path/to/logFile.json --localKeyFile /path/to/localKey
+  # Verify the integrity of an audit log without writing its records:
+  atlas logs decrypt --file /path/to/logFile.json --localKeyFile /path/to/localKey --verifyOnly
+  # Keep the authentication events of a user since a date, in the Common Event Format:
+  atlas logs decrypt --file /path/to/logFile.json --localKeyFile /path/to/localKey --atype authenticate --user myUser --since 2025-01-01T00:00:00Z --format cef
+  # Decrypt using a KMIP server:
+  atlas logs decrypt --file /path/to/logFile.json --kmipServerCAFile /path/to/ca.pem --kmipClientCertificateFile /path/to/client.pem
+  # Decrypt using AWS credentials:
   atlas logs decrypt --file /path/to/logFile.json --awsAccessKey <accessKey> --awsSecretAccessKey <secretKey> --awsSessionToken <sessionToken>
   # Decrypt using GCP credentials:
   atlas logs decrypt --file /path/to/logFile.json --gcpServiceAccountKey <serviceAccountKey>
   # Decrypt using Azure credentials:
   atlas logs decrypt --file /path/to/logFile.json --azureClientId <clientId> --azureTenantId <tenantId> --azureSecret <secret>
 `,
-		PreRunE: func(_ *cobra.Command, _ []string) error {
-			return opts.PreRunE(opts.initDefaultOut)
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.initDefaultOut,
+				opts.initDefaultIn,
+				opts.validateFormat,
+				opts.initFilter,
+				opts.InitInput(cmd.InOrStdin()),
+				opts.InitOutput(cmd.OutOrStdout(), verifyTemplate),
+			)
 		},
 		RunE: func(_ *cobra.Command, _ []string) error {
 			return opts.Run()
@@ -121,6 +260,35 @@ func DecryptBuilder() *cobra.Command {
 
 	cmd.Flags().StringVarP(&opts.inFileName, flag.File, flag.FileShort, "", usage.EncryptedLogFile)
 	cmd.Flags().StringVarP(&opts.Out, flag.Out, flag.OutputShort, "", usage.OutputLogFile)
+	cmd.Flags().IntVar(&opts.workers, flag.DecryptWorkers, 0, usage.DecryptWorkers)
+	cmd.Flags().BoolVar(&opts.verifyOnly, flag.VerifyOnly, false, usage.VerifyOnly)
+	cmd.Flags().StringVar(&opts.format, flag.DecryptFormat, string(decryption.AuditLogOutputNDJSON), usage.DecryptFormat)
+
+	cmd.Flags().StringSliceVar(&opts.filterOpts.aTypes, flag.AuditType, nil, usage.AuditType)
+	cmd.Flags().StringSliceVar(&opts.filterOpts.users, flag.AuditUser, nil, usage.AuditUser)
+	cmd.Flags().StringSliceVar(&opts.filterOpts.namespaces, flag.AuditNamespace, nil, usage.AuditNamespace)
+	cmd.Flags().StringVar(&opts.filterOpts.since, flag.Since, "", usage.DecryptSince)
+	cmd.Flags().StringVar(&opts.filterOpts.until, flag.Until, "", usage.DecryptUntil)
+
+	opts.addKeyProviderFlags(cmd)
+
+	cmd.MarkFlagsMutuallyExclusive(flag.VerifyOnly, flag.Out)
+	cmd.MarkFlagsMutuallyExclusive(flag.VerifyOnly, flag.DecryptFormat)
+	_ = cmd.MarkFlagFilename(flag.File)
+	_ = cmd.MarkFlagFilename(flag.Out)
+
+	return cmd
+}
+
+// addKeyProviderFlags adds the credentials of the key providers that encrypted the audit logs.
+func (opts *DecryptOpts) addKeyProviderFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&opts.localOpts.localKeyFile, flag.LocalKeyFile, "", "", usage.LocalKeyFile)
+
+	cmd.Flags().StringVarP(&opts.kmipOpts.kmipServerCAFile, flag.KMIPServerCAFile, "", "", usage.KMIPServerCAFile)
+	cmd.Flags().StringVarP(&opts.kmipOpts.kmipClientCertificateFile, flag.KMIPClientCertificateFile, "", "", usage.KMIPClientCertFile)
+	cmd.Flags().StringVarP(&opts.kmipOpts.kmipClientCertificatePassword, flag.KMIPClientCertificatePassword, "", "", usage.KMIPClientCertPassword)
+	cmd.Flags().StringVarP(&opts.kmipOpts.kmipUsername, flag.KMIPUsername, "", "", usage.KMIPUsername)
+	cmd.Flags().StringVarP(&opts.kmipOpts.kmipPassword, flag.KMIPPassword, "", "", usage.KMIPPassword)
 
 	cmd.Flags().StringVarP(&opts.awsOpts.awsAccessKey, flag.AWSAccessKey, "", "", usage.DecryptAWSAccessKey)
 	cmd.Flags().StringVarP(&opts.awsOpts.awsSecretAccessKey, flag.AWSSecretKey, "", "", usage.DecryptAWSSecretKey)
@@ -132,9 +300,7 @@ func DecryptBuilder() *cobra.Command {
 
 	cmd.Flags().StringVarP(&opts.gcpOpts.gcpServiceAccountKey, flag.GCPServiceAccountKey, "", "", usage.GCPServiceAccountKey)
 
-	_ = cmd.MarkFlagRequired(flag.File)
-	_ = cmd.MarkFlagFilename(flag.File)
-	_ = cmd.MarkFlagFilename(flag.Out)
-
-	return cmd
+	_ = cmd.MarkFlagFilename(flag.LocalKeyFile)
+	_ = cmd.MarkFlagFilename(flag.KMIPServerCAFile)
+	_ = cmd.MarkFlagFilename(flag.KMIPClientCertificateFile)
 }
@@ -15,8 +15,13 @@
 package logs
 
 import (
+	"bytes"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/decryption"
+
 	"github.com/spf13/afero"
 )
 
@@ -31,6 +36,21 @@ func TestDecrypt_Run(t *testing.T) {
 	}
 
 	for _, testCase := range testCases {
+		stdinOpts := &DecryptOpts{
+			inFileName: stdInMode,
+			workers:    2,
+		}
+		stdinOpts.Out = "decryptedAuditLogFromStdin"
+		stdinOpts.Fs = afero.NewMemMapFs()
+		stdinOpts.InReader = bytes.NewReader(testCase.input)
+
+		if err := stdinOpts.Run(); err != nil {
+			t.Fatalf("Run() unexpected error: %v", err)
+		}
+		if exists, _ := afero.Exists(stdinOpts.Fs, stdinOpts.Out); !exists {
+			t.Errorf("expected %s to be written", stdinOpts.Out)
+		}
+
 		listOpts := &DecryptOpts{
 			inFileName: "log",
 			awsOpts: DecryptAWSOpts{
@@ -48,3 +68,56 @@ func TestDecrypt_Run(t *testing.T) {
 		}
 	}
 }
+
+func TestDecryptOpts_initFilter(t *testing.T) {
+	opts := &DecryptOpts{
+		filterOpts: DecryptFilterOpts{
+			aTypes: []string{"authenticate"},
+			since:  "2025-01-01T00:00:00Z",
+		},
+	}
+	if err := opts.initFilter(); err != nil {
+		t.Fatalf("initFilter() unexpected error: %v", err)
+	}
+	if opts.filterOpts.filter.Since.Year() != 2025 || opts.filterOpts.filter.ATypes[0] != "authenticate" {
+		t.Errorf("unexpected filter: %+v", opts.filterOpts.filter)
+	}
+
+	opts.filterOpts.until = "yesterday"
+	if err := opts.initFilter(); err == nil {
+		t.Error("initFilter() expected an error for an invalid --until")
+	}
+}
+
+func TestDecryptOpts_validateFormat(t *testing.T) {
+	opts := &DecryptOpts{format: "ocsf"}
+	if err := opts.validateFormat(); err != nil {
+		t.Fatalf("validateFormat() unexpected error: %v", err)
+	}
+
+	opts.format = "xml"
+	if err := opts.validateFormat(); err == nil {
+		t.Error("validateFormat() expected an error for an unsupported format")
+	}
+}
+
+func TestDecrypt_RunVerifyOnly(t *testing.T) {
+	// the local key filename of the header is missing
+	fileJSON := []byte(`{"ts":{"$date":{"$numberLong":"1644232049921"}},"version":"0.0","compressionMode":"zstd","keyStoreIdentifier":{"provider":"local"},"encryptedKey":{"$binary":{"base64":"+yjPCaKKE1M8fZmPGzGHkyfHYxaw34okpavsHzpd8iPVx2+JjOhXwXw5E2FdI5Rcb5JgmcPUFRPISh/7Si1R/g==","subType":"0"}},"MAC":"qE9fUsGK0EuRrrCRAQAAAAAAAAAAAAAA","auditRecordType":"header"}`)
+
+	buf := new(bytes.Buffer)
+	opts := &DecryptOpts{
+		inFileName: stdInMode,
+		verifyOnly: true,
+	}
+	opts.InReader = bytes.NewReader(fileJSON)
+	opts.Template = verifyTemplate
+	opts.OutWriter = buf
+
+	if err := opts.Run(); !errors.Is(err, decryption.ErrAuditLogTampered) {
+		t.Fatalf("Run() expected: %v got: %v", decryption.ErrAuditLogTampered, err)
+	}
+	if !strings.Contains(buf.String(), "INVALID") || !strings.Contains(buf.String(), "invalidHeader") {
+		t.Errorf("unexpected report: %s", buf.String())
+	}
+}
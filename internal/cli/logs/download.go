@@ -28,8 +28,10 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/logfollow"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/validate"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
@@ -45,21 +47,31 @@ type Downloader interface {
 
 type DownloadOpts struct {
 	cli.ProjectOpts
+	cli.OutputOpts
 	cli.DownloaderOpts
-	host       string
-	name       string
-	start      int64
-	end        int64
-	decompress bool
-	store      Downloader
+	cli.LogFollowOpts
+	host         string
+	name         string
+	start        int64
+	end          int64
+	decompress   bool
+	store        Downloader
+	clusterName  string
+	allHosts     bool
+	types        []string
+	parallelism  int
+	decrypt      bool
+	decryptOpts  DecryptOpts
+	clusterStore ClusterLogsDownloader
 }
 
 var downloadMessage = "Download of %s completed.\n"
 
 func (opts *DownloadOpts) initStore(ctx context.Context) func() error {
 	return func() error {
-		var err error
-		opts.store, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		s, err := store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		opts.store = s
+		opts.clusterStore = s
 		return err
 	}
 }
@@ -100,6 +112,10 @@ func (opts *DownloadOpts) write(w io.Writer, r io.Reader) error {
 }
 
 func (opts *DownloadOpts) Run() error {
+	if opts.allHosts {
+		return opts.runAllHosts()
+	}
+
 	w, err := opts.NewWriteCloser()
 	if err != nil {
 		return err
@@ -125,13 +141,53 @@ func (opts *DownloadOpts) Run() error {
 	return nil
 }
 
+// runFollow prints the new lines of the log until the context is done.
+func (opts *DownloadOpts) runFollow(ctx context.Context) error {
+	filter, err := opts.LineFilter()
+	if err != nil {
+		return err
+	}
+
+	fetch := func(_ context.Context, start int64) (io.ReadCloser, error) {
+		params := opts.newHostLogsParams()
+		params.StartDate = &start
+		return opts.store.DownloadLog(params)
+	}
+	return logfollow.NewFollower(fetch, opts.ConfigWriter(), logfollow.WithStart(opts.start), logfollow.WithFilter(filter)).Run(ctx)
+}
+
 func (opts *DownloadOpts) initDefaultOut() error {
+	if opts.Follow {
+		opts.Out = cli.StdOutMode
+		return nil
+	}
+	if opts.Out == "" && opts.allHosts {
+		opts.Out = opts.clusterName
+	}
 	if opts.Out == "" {
 		opts.Out = strings.ReplaceAll(opts.name, ".gz", ".log.gz")
 	}
 	return nil
 }
 
+func (opts *DownloadOpts) validateAllHosts() error {
+	if !opts.allHosts {
+		if opts.decrypt {
+			return fmt.Errorf("--%s requires --%s", flag.Decrypt, flag.AllHosts)
+		}
+		return nil
+	}
+	if opts.ShouldDownloadToStdout() {
+		return fmt.Errorf("--%s can't write to the standard output, set --%s to a directory or a .tar, .tar.gz or .tgz file", flag.AllHosts, flag.Out)
+	}
+	for _, t := range opts.types {
+		if err := validate.FlagInSlice(t, flag.LogTypes, validLogTypes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (opts *DownloadOpts) newHostLogsParams() *atlasv2.DownloadClusterLogApiParams {
 	fileBaseName := strings.TrimSuffix(opts.name, filepath.Ext(opts.name))
 	params := &atlasv2.DownloadClusterLogApiParams{
@@ -149,6 +205,8 @@ func (opts *DownloadOpts) newHostLogsParams() *atlasv2.DownloadClusterLogApiPara
 }
 
 // atlas logs download <hostname> <mongodb.gz|mongos.gz|mongosqld.gz|mongodb-audit-log.gz|mongos-audit-log.gz> [--force] [--output destination] [--projectId projectId].
+// atlas logs download <hostname> <mongodb.gz|mongos.gz|mongosqld.gz|mongodb-audit-log.gz|mongos-audit-log.gz> --follow [--start start] [--grep regexp] [--component component] [--severity severity] [--projectId projectId].
+// atlas logs download --clusterName clusterName --allHosts [--types mongodb,mongos,audit] [--decompress] [--decrypt] [--output destination] [--projectId projectId].
 func DownloadBuilder() *cobra.Command {
 	const argsN = 2
 	opts := &DownloadOpts{}
@@ -160,29 +218,50 @@ func DownloadBuilder() *cobra.Command {
 
 To find the hostnames for an Atlas project, use the process list command.
 
+To keep printing the new lines of the log, like tail -f, use --follow. Atlas CLI downloads the log every 30 seconds and prints the lines that it hasn't printed yet, which you can filter with --grep, --component and --severity.
+
+To download the logs of all the hosts of a cluster, use --clusterName and --allHosts instead of the hostname and log name arguments. Atlas CLI downloads the logs concurrently to a directory tree with a directory per host, or to a tar archive when the output file name ends with .tar, .tar.gz or .tgz.
+
 ` + fmt.Sprintf(usage.RequiredRole, "Project Data Access Read/Write"),
-		Args: cobra.MatchAll(
-			require.ExactArgs(argsN),
-			func(cmd *cobra.Command, args []string) error {
-				if !slices.Contains(cmd.ValidArgs, args[1]) {
-					return fmt.Errorf("<logname> must be one of %s", cmd.ValidArgs)
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.allHosts {
+				if len(args) > 0 {
+					return errAllHostsArgs
 				}
 				return nil
-			},
-		),
+			}
+			return cobra.MatchAll(
+				require.ExactArgs(argsN),
+				func(cmd *cobra.Command, args []string) error {
+					if !slices.Contains(cmd.ValidArgs, args[1]) {
+						return fmt.Errorf("<logname> must be one of %s", cmd.ValidArgs)
+					}
+					return nil
+				},
+			)(cmd, args)
+		},
 		Example: `  # Download the mongodb log file from the host atlas-123abc-shard-00-00.111xx.mongodb.net for the project with the ID 5e2211c17a3e5a48f5497de3:
-  atlas logs download  atlas-123abc-shard-00-00.111xx.mongodb.net mongodb.gz --projectId 5e2211c17a3e5a48f5497de3`,
+  atlas logs download  atlas-123abc-shard-00-00.111xx.mongodb.net mongodb.gz --projectId 5e2211c17a3e5a48f5497de3
+  # Print the new warnings and errors of the replication of a host until you stop the command:
+  atlas logs download atlas-123abc-shard-00-00.111xx.mongodb.net mongodb.gz --follow --component REPL,ELECTION --severity W,E
+  # Download the decompressed mongod, mongos and decrypted audit logs of all the hosts of the cluster myCluster to the incident.tar.gz archive:
+  atlas logs download --clusterName myCluster --allHosts --types mongodb,mongos,audit --decompress --decrypt --localKeyFile /path/to/localKey --out incident.tar.gz`,
 		Annotations: map[string]string{
 			"hostnameDesc": "Label that identifies the host that stores the log files that you want to download.",
 			"mongodb.gz|mongos.gz|mongosqld.gz|mongodb-audit-log.gz|mongos-audit-log.gzDesc": "Log file that you want to return.",
 			"output": downloadMessage,
 		},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			opts.host = args[0]
-			opts.name = args[1]
-			return opts.PreRunE(opts.ValidateProjectID, opts.initStore(cmd.Context()), opts.initDefaultOut)
+			if !opts.allHosts {
+				opts.host = args[0]
+				opts.name = args[1]
+			}
+			return opts.PreRunE(opts.ValidateProjectID, opts.initStore(cmd.Context()), opts.InitOutput(cmd.OutOrStdout(), ""), opts.initDefaultOut, opts.validateAllHosts, opts.ValidateLogFollow)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.Follow {
+				return opts.runFollow(cmd.Context())
+			}
 			return opts.Run()
 		},
 
@@ -194,9 +273,20 @@ To find the hostnames for an Atlas project, use the process list command.
 	cmd.Flags().Int64Var(&opts.end, flag.End, 0, usage.LogEnd)
 	cmd.Flags().BoolVar(&opts.Force, flag.Force, false, usage.ForceFile)
 	cmd.Flags().BoolVarP(&opts.decompress, flag.Decompress, flag.DecompressShort, false, usage.Decompress)
+	cmd.Flags().StringVar(&opts.clusterName, flag.ClusterName, "", usage.LogsClusterName)
+	cmd.Flags().BoolVar(&opts.allHosts, flag.AllHosts, false, usage.AllHosts)
+	cmd.Flags().StringSliceVar(&opts.types, flag.LogTypes, validLogTypes, usage.LogTypes)
+	cmd.Flags().IntVar(&opts.parallelism, flag.Parallelism, defaultParallelism, usage.LogsParallelism)
+	cmd.Flags().BoolVar(&opts.decrypt, flag.Decrypt, false, usage.DecryptLogs)
+	opts.decryptOpts.addKeyProviderFlags(cmd)
+	opts.AddLogFollowFlags(cmd)
 
 	opts.AddProjectOptsFlags(cmd)
 
+	cmd.MarkFlagsRequiredTogether(flag.AllHosts, flag.ClusterName)
+	cmd.MarkFlagsMutuallyExclusive(flag.Follow, flag.AllHosts)
+	cmd.MarkFlagsMutuallyExclusive(flag.Follow, flag.Out)
+	cmd.MarkFlagsMutuallyExclusive(flag.Follow, flag.End)
 	_ = cmd.MarkFlagFilename(flag.Out)
 
 	return cmd
@@ -0,0 +1,371 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/spf13/afero"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=download_cluster_mock_test.go -package=logs -source=download_cluster.go
+
+const (
+	logTypeMongoDB = "mongodb"
+	logTypeMongos  = "mongos"
+	logTypeAudit   = "audit"
+
+	mongosTypeName       = "SHARD_MONGOS"
+	processesPageSize    = 500
+	defaultParallelism   = 4
+	logDirPermissions    = 0o755
+	logFilePermissions   = 0o644
+	compressedLogSuffix  = ".log.gz"
+	logSuffix            = ".log"
+	decryptedAuditSuffix = ".json"
+)
+
+var (
+	validLogTypes = []string{logTypeMongoDB, logTypeMongos, logTypeAudit}
+
+	errClusterHostsNotFound = errors.New("no hosts found for the cluster")
+	errClusterLogsDownload  = errors.New("some logs failed to download")
+	errAllHostsArgs         = fmt.Errorf("the hostname and log name arguments can't be used with --%s", flag.AllHosts)
+)
+
+type ClusterLogsDownloader interface {
+	DownloadLog(*atlasv2.DownloadClusterLogApiParams) (io.ReadCloser, error)
+	Processes(*atlasv2.ListGroupProcessesApiParams) (*atlasv2.PaginatedHostViewAtlas, error)
+	LatestAtlasCluster(string, string) (*atlasv2.ClusterDescription20240805, error)
+}
+
+// clusterLog is a log file of a host of the cluster.
+type clusterLog struct {
+	host    string
+	logName string
+}
+
+func (l clusterLog) isAudit() bool {
+	return strings.HasSuffix(l.logName, "-audit-log")
+}
+
+// clusterHosts returns the hostnames of the standard connection string of the cluster, like
+// cluster0-shard-00-00.abcde.mongodb.net for the mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017,... string.
+func clusterHosts(cluster *atlasv2.ClusterDescription20240805) (map[string]bool, error) {
+	standard := strings.TrimPrefix(cluster.GetConnectionStrings().GetStandard(), "mongodb://")
+	standard, _, _ = strings.Cut(standard, "/")
+	standard, _, _ = strings.Cut(standard, "?")
+
+	hosts := map[string]bool{}
+	for _, host := range strings.Split(standard, ",") {
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		if host != "" {
+			hosts[host] = true
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("%w: %s", errClusterHostsNotFound, cluster.GetName())
+	}
+	return hosts, nil
+}
+
+// clusterProcesses returns the processes of the cluster: the processes with a host of the connection string of the cluster,
+// which are identified by their hostname or their alias, and the other members of their replica sets.
+// Hosts of other clusters with a similar name, like cluster0-dev for cluster0, don't match.
+func clusterProcesses(hosts map[string]bool, processes []atlasv2.ApiHostViewAtlas) []atlasv2.ApiHostViewAtlas {
+	replicaSets := map[string]bool{}
+	for _, p := range processes {
+		if (hosts[p.GetHostname()] || hosts[p.GetUserAlias()]) && p.GetReplicaSetName() != "" {
+			replicaSets[p.GetReplicaSetName()] = true
+		}
+	}
+
+	var matched []atlasv2.ApiHostViewAtlas
+	for _, p := range processes {
+		if hosts[p.GetHostname()] || hosts[p.GetUserAlias()] || replicaSets[p.GetReplicaSetName()] {
+			matched = append(matched, p)
+		}
+	}
+	return matched
+}
+
+// logNames returns the logs of the requested types that a process writes.
+func (opts *DownloadOpts) logNames(process atlasv2.ApiHostViewAtlas) []string {
+	var names []string
+	mongos := process.GetTypeName() == mongosTypeName
+	for _, t := range opts.types {
+		switch {
+		case t == logTypeMongoDB && !mongos:
+			names = append(names, "mongodb")
+		case t == logTypeMongos && mongos:
+			names = append(names, "mongos")
+		case t == logTypeAudit && mongos:
+			names = append(names, "mongos-audit-log")
+		case t == logTypeAudit:
+			names = append(names, "mongodb-audit-log")
+		}
+	}
+	return names
+}
+
+// clusterLogs resolves the hosts of the cluster with the processes of the project.
+func (opts *DownloadOpts) clusterLogs() ([]clusterLog, error) {
+	cluster, err := opts.clusterStore.LatestAtlasCluster(opts.ConfigProjectID(), opts.clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := clusterHosts(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var processes []atlasv2.ApiHostViewAtlas
+	for page := 1; ; page++ {
+		r, err := opts.clusterStore.Processes(&atlasv2.ListGroupProcessesApiParams{
+			GroupId:      opts.ConfigProjectID(),
+			PageNum:      pointer.Get(page),
+			ItemsPerPage: pointer.Get(processesPageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		processes = append(processes, r.GetResults()...)
+		if len(r.GetResults()) < processesPageSize {
+			break
+		}
+	}
+
+	var logs []clusterLog
+	for _, p := range clusterProcesses(hosts, processes) {
+		for _, name := range opts.logNames(p) {
+			l := clusterLog{host: p.GetHostname(), logName: name}
+			if !slices.Contains(logs, l) {
+				logs = append(logs, l)
+			}
+		}
+	}
+
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("%w: %s", errClusterHostsNotFound, opts.clusterName)
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].host != logs[j].host {
+			return logs[i].host < logs[j].host
+		}
+		return logs[i].logName < logs[j].logName
+	})
+	return logs, nil
+}
+
+func (opts *DownloadOpts) clusterLogPath(l clusterLog) string {
+	switch {
+	case opts.decrypt && l.isAudit():
+		return filepath.Join(l.host, l.logName+decryptedAuditSuffix)
+	case opts.decompress:
+		return filepath.Join(l.host, l.logName+logSuffix)
+	default:
+		return filepath.Join(l.host, l.logName+compressedLogSuffix)
+	}
+}
+
+// downloadClusterLog writes a log of the cluster to dir, decompressing it and decrypting audit logs when requested.
+// Decryption may prompt for credentials, so only one log is decrypted at a time.
+// Like single log downloads, existing files are only overwritten with --force.
+func (opts *DownloadOpts) downloadClusterLog(dir string, l clusterLog, decryptMu *sync.Mutex) (err error) {
+	filename := filepath.Join(dir, opts.clusterLogPath(l))
+	if err := opts.Fs.MkdirAll(filepath.Dir(filename), logDirPermissions); err != nil {
+		return err
+	}
+
+	params := &atlasv2.DownloadClusterLogApiParams{
+		GroupId:  opts.ConfigProjectID(),
+		HostName: l.host,
+		LogName:  l.logName,
+	}
+	if opts.start != 0 {
+		params.StartDate = &opts.start
+	}
+	if opts.end != 0 {
+		params.EndDate = &opts.end
+	}
+
+	r, err := opts.clusterStore.DownloadLog(params)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	ff := os.O_CREATE | os.O_TRUNC | os.O_WRONLY
+	if !opts.Force {
+		ff |= os.O_EXCL
+	}
+	f, err := opts.Fs.OpenFile(filename, ff, logFilePermissions)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		if err != nil {
+			_ = opts.Fs.Remove(filename)
+		}
+	}()
+
+	if !opts.decrypt || !l.isAudit() {
+		return opts.write(f, r)
+	}
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	decryptMu.Lock()
+	defer decryptMu.Unlock()
+	return opts.decryptOpts.newDecryption().Decrypt(gr, f)
+}
+
+// downloadClusterLogs downloads the logs concurrently, with at most parallelism downloads at a time.
+func (opts *DownloadOpts) downloadClusterLogs(dir string, logs []clusterLog) error {
+	parallelism := max(opts.parallelism, 1)
+	sem := make(chan struct{}, parallelism)
+	errs := make([]error, len(logs))
+	var decryptMu sync.Mutex
+	var wg sync.WaitGroup
+	for i, l := range logs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := opts.downloadClusterLog(dir, l, &decryptMu); err != nil {
+				errs[i] = fmt.Errorf("%s %s: %w", l.host, l.logName, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("%w:\n%w", errClusterLogsDownload, err)
+	}
+	return nil
+}
+
+func (opts *DownloadOpts) isTarArchive() bool {
+	return strings.HasSuffix(opts.Out, ".tar") || opts.isTarGzArchive()
+}
+
+func (opts *DownloadOpts) isTarGzArchive() bool {
+	return strings.HasSuffix(opts.Out, ".tar.gz") || strings.HasSuffix(opts.Out, ".tgz")
+}
+
+// writeTarArchive archives the files of dir, with paths relative to dir.
+func (opts *DownloadOpts) writeTarArchive(dir string) error {
+	w, err := opts.NewWriteCloser()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	var out io.Writer = w
+	if opts.isTarGzArchive() {
+		gw := gzip.NewWriter(w)
+		defer gw.Close()
+		out = gw
+	}
+
+	tw := tar.NewWriter(out)
+	err = afero.Walk(opts.Fs, dir, func(name string, info fs.FileInfo, err error) error {
+		if err != nil || name == dir {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := opts.Fs.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		_ = opts.OnError(w)
+		return err
+	}
+	return tw.Close()
+}
+
+// runAllHosts downloads the logs of all the hosts of the cluster to a directory tree, or a tar archive when
+// the output file name ends with .tar, .tar.gz or .tgz.
+func (opts *DownloadOpts) runAllHosts() error {
+	logs, err := opts.clusterLogs()
+	if err != nil {
+		return err
+	}
+
+	dir := opts.Out
+	if opts.isTarArchive() {
+		if dir, err = afero.TempDir(opts.Fs, "", "atlas-logs-"); err != nil {
+			return err
+		}
+		defer func() { _ = opts.Fs.RemoveAll(dir) }()
+	}
+
+	downloadErr := opts.downloadClusterLogs(dir, logs)
+
+	if opts.isTarArchive() {
+		if err := opts.writeTarArchive(dir); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(opts.ConfigWriter(), downloadMessage, opts.Out); err != nil {
+		return err
+	}
+	return downloadErr
+}
@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: download_cluster.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=download_cluster_mock_test.go -package=logs -source=download_cluster.go
+//
+
+// Package logs is a generated GoMock package.
+package logs
+
+import (
+	io "io"
+	reflect "reflect"
+
+	admin "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClusterLogsDownloader is a mock of ClusterLogsDownloader interface.
+type MockClusterLogsDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterLogsDownloaderMockRecorder
+	isgomock struct{}
+}
+
+// MockClusterLogsDownloaderMockRecorder is the mock recorder for MockClusterLogsDownloader.
+type MockClusterLogsDownloaderMockRecorder struct {
+	mock *MockClusterLogsDownloader
+}
+
+// NewMockClusterLogsDownloader creates a new mock instance.
+func NewMockClusterLogsDownloader(ctrl *gomock.Controller) *MockClusterLogsDownloader {
+	mock := &MockClusterLogsDownloader{ctrl: ctrl}
+	mock.recorder = &MockClusterLogsDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterLogsDownloader) EXPECT() *MockClusterLogsDownloaderMockRecorder {
+	return m.recorder
+}
+
+// DownloadLog mocks base method.
+func (m *MockClusterLogsDownloader) DownloadLog(arg0 *admin.DownloadClusterLogApiParams) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadLog", arg0)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadLog indicates an expected call of DownloadLog.
+func (mr *MockClusterLogsDownloaderMockRecorder) DownloadLog(arg0 any) *MockClusterLogsDownloaderDownloadLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadLog", reflect.TypeOf((*MockClusterLogsDownloader)(nil).DownloadLog), arg0)
+	return &MockClusterLogsDownloaderDownloadLogCall{Call: call}
+}
+
+// MockClusterLogsDownloaderDownloadLogCall wrap *gomock.Call
+type MockClusterLogsDownloaderDownloadLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterLogsDownloaderDownloadLogCall) Return(arg0 io.ReadCloser, arg1 error) *MockClusterLogsDownloaderDownloadLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterLogsDownloaderDownloadLogCall) Do(f func(*admin.DownloadClusterLogApiParams) (io.ReadCloser, error)) *MockClusterLogsDownloaderDownloadLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterLogsDownloaderDownloadLogCall) DoAndReturn(f func(*admin.DownloadClusterLogApiParams) (io.ReadCloser, error)) *MockClusterLogsDownloaderDownloadLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestAtlasCluster mocks base method.
+func (m *MockClusterLogsDownloader) LatestAtlasCluster(arg0, arg1 string) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestAtlasCluster", arg0, arg1)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestAtlasCluster indicates an expected call of LatestAtlasCluster.
+func (mr *MockClusterLogsDownloaderMockRecorder) LatestAtlasCluster(arg0, arg1 any) *MockClusterLogsDownloaderLatestAtlasClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAtlasCluster", reflect.TypeOf((*MockClusterLogsDownloader)(nil).LatestAtlasCluster), arg0, arg1)
+	return &MockClusterLogsDownloaderLatestAtlasClusterCall{Call: call}
+}
+
+// MockClusterLogsDownloaderLatestAtlasClusterCall wrap *gomock.Call
+type MockClusterLogsDownloaderLatestAtlasClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterLogsDownloaderLatestAtlasClusterCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockClusterLogsDownloaderLatestAtlasClusterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterLogsDownloaderLatestAtlasClusterCall) Do(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockClusterLogsDownloaderLatestAtlasClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterLogsDownloaderLatestAtlasClusterCall) DoAndReturn(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockClusterLogsDownloaderLatestAtlasClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Processes mocks base method.
+func (m *MockClusterLogsDownloader) Processes(arg0 *admin.ListGroupProcessesApiParams) (*admin.PaginatedHostViewAtlas, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Processes", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedHostViewAtlas)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Processes indicates an expected call of Processes.
+func (mr *MockClusterLogsDownloaderMockRecorder) Processes(arg0 any) *MockClusterLogsDownloaderProcessesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Processes", reflect.TypeOf((*MockClusterLogsDownloader)(nil).Processes), arg0)
+	return &MockClusterLogsDownloaderProcessesCall{Call: call}
+}
+
+// MockClusterLogsDownloaderProcessesCall wrap *gomock.Call
+type MockClusterLogsDownloaderProcessesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterLogsDownloaderProcessesCall) Return(arg0 *admin.PaginatedHostViewAtlas, arg1 error) *MockClusterLogsDownloaderProcessesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterLogsDownloaderProcessesCall) Do(f func(*admin.ListGroupProcessesApiParams) (*admin.PaginatedHostViewAtlas, error)) *MockClusterLogsDownloaderProcessesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterLogsDownloaderProcessesCall) DoAndReturn(f func(*admin.ListGroupProcessesApiParams) (*admin.PaginatedHostViewAtlas, error)) *MockClusterLogsDownloaderProcessesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
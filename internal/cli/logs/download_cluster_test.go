@@ -0,0 +1,223 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+const testProjectID = "5e2211c17a3e5a48f5497de3"
+
+func gzipped(t *testing.T, s string) io.ReadCloser {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	gw := gzip.NewWriter(buf)
+	_, err := gw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	return io.NopCloser(buf)
+}
+
+func mockClusterProcesses(mockStore *MockClusterLogsDownloader) {
+	mockStore.
+		EXPECT().
+		LatestAtlasCluster(testProjectID, "Cluster0").
+		Return(&atlasv2.ClusterDescription20240805{
+			Name: pointer.Get("Cluster0"),
+			ConnectionStrings: &atlasv2.ClusterConnectionStrings{
+				Standard:    pointer.Get("mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017,cluster0-mongos-00-00.abcde.mongodb.net:27016/?ssl=true&authSource=admin"),
+				StandardSrv: pointer.Get("mongodb+srv://cluster0.abcde.mongodb.net"),
+			},
+		}, nil).
+		Times(1)
+
+	mockStore.
+		EXPECT().
+		Processes(gomock.Any()).
+		Return(&atlasv2.PaginatedHostViewAtlas{
+			Results: []atlasv2.ApiHostViewAtlas{
+				{Hostname: pointer.Get("atlas-x1-shard-00-00.abcde.mongodb.net"), UserAlias: pointer.Get("cluster0-shard-00-00.abcde.mongodb.net"), ReplicaSetName: pointer.Get("atlas-x1-shard-0"), TypeName: pointer.Get("SHARD_PRIMARY")},
+				{Hostname: pointer.Get("atlas-x1-shard-00-01.abcde.mongodb.net"), UserAlias: pointer.Get("cluster0-shard-00-01.abcde.mongodb.net"), ReplicaSetName: pointer.Get("atlas-x1-shard-0"), TypeName: pointer.Get("SHARD_SECONDARY")},
+				{Hostname: pointer.Get("atlas-x1-mongos-00-00.abcde.mongodb.net"), UserAlias: pointer.Get("cluster0-mongos-00-00.abcde.mongodb.net"), TypeName: pointer.Get(mongosTypeName)},
+				{Hostname: pointer.Get("atlas-y2-shard-00-00.abcde.mongodb.net"), UserAlias: pointer.Get("cluster1-shard-00-00.abcde.mongodb.net"), ReplicaSetName: pointer.Get("atlas-y2-shard-0"), TypeName: pointer.Get("REPLICA_PRIMARY")},
+				{Hostname: pointer.Get("atlas-z3-shard-00-00.abcde.mongodb.net"), UserAlias: pointer.Get("cluster0-dev-shard-00-00.abcde.mongodb.net"), ReplicaSetName: pointer.Get("atlas-z3-shard-0"), TypeName: pointer.Get("REPLICA_PRIMARY")},
+			},
+		}, nil).
+		Times(1)
+}
+
+func TestDownloadOpts_clusterLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockClusterLogsDownloader(ctrl)
+	mockClusterProcesses(mockStore)
+
+	opts := &DownloadOpts{
+		ProjectOpts:  cli.ProjectOpts{ProjectID: testProjectID},
+		clusterName:  "Cluster0",
+		allHosts:     true,
+		types:        validLogTypes,
+		clusterStore: mockStore,
+	}
+
+	logs, err := opts.clusterLogs()
+	require.NoError(t, err)
+	assert.Equal(t, []clusterLog{
+		{host: "atlas-x1-mongos-00-00.abcde.mongodb.net", logName: "mongos"},
+		{host: "atlas-x1-mongos-00-00.abcde.mongodb.net", logName: "mongos-audit-log"},
+		{host: "atlas-x1-shard-00-00.abcde.mongodb.net", logName: "mongodb"},
+		{host: "atlas-x1-shard-00-00.abcde.mongodb.net", logName: "mongodb-audit-log"},
+		{host: "atlas-x1-shard-00-01.abcde.mongodb.net", logName: "mongodb"},
+		{host: "atlas-x1-shard-00-01.abcde.mongodb.net", logName: "mongodb-audit-log"},
+	}, logs)
+}
+
+func TestDownloadOpts_RunAllHosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockClusterLogsDownloader(ctrl)
+	mockClusterProcesses(mockStore)
+
+	mockStore.
+		EXPECT().
+		DownloadLog(gomock.Any()).
+		DoAndReturn(func(params *atlasv2.DownloadClusterLogApiParams) (io.ReadCloser, error) {
+			if params.LogName == "mongos" {
+				return nil, errors.New("not found")
+			}
+			return gzipped(t, params.HostName+" "+params.LogName), nil
+		}).
+		Times(3)
+
+	opts := &DownloadOpts{
+		ProjectOpts:  cli.ProjectOpts{ProjectID: testProjectID},
+		clusterName:  "Cluster0",
+		allHosts:     true,
+		types:        []string{logTypeMongoDB, logTypeMongos},
+		parallelism:  2,
+		decompress:   true,
+		clusterStore: mockStore,
+	}
+	opts.Out = "logs"
+	opts.Fs = afero.NewMemMapFs()
+	buf := new(bytes.Buffer)
+	opts.OutWriter = buf
+
+	err := opts.Run()
+	require.ErrorIs(t, err, errClusterLogsDownload)
+	assert.Equal(t, "Download of logs completed.\n", buf.String())
+	assert.Contains(t, err.Error(), "atlas-x1-mongos-00-00.abcde.mongodb.net mongos: not found")
+
+	got, err := afero.ReadFile(opts.Fs, "logs/atlas-x1-shard-00-00.abcde.mongodb.net/mongodb.log")
+	require.NoError(t, err)
+	assert.Equal(t, "atlas-x1-shard-00-00.abcde.mongodb.net mongodb", string(got))
+
+	exists, err := afero.Exists(opts.Fs, "logs/atlas-x1-mongos-00-00.abcde.mongodb.net/mongos.log")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestDownloadOpts_RunAllHostsTarArchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockClusterLogsDownloader(ctrl)
+	mockClusterProcesses(mockStore)
+
+	mockStore.
+		EXPECT().
+		DownloadLog(gomock.Any()).
+		DoAndReturn(func(params *atlasv2.DownloadClusterLogApiParams) (io.ReadCloser, error) {
+			return gzipped(t, params.LogName), nil
+		}).
+		Times(3)
+
+	opts := &DownloadOpts{
+		ProjectOpts:  cli.ProjectOpts{ProjectID: testProjectID},
+		clusterName:  "Cluster0",
+		allHosts:     true,
+		types:        []string{logTypeAudit},
+		parallelism:  1,
+		clusterStore: mockStore,
+	}
+	opts.Out = "logs.tar.gz"
+	opts.Fs = afero.NewMemMapFs()
+	opts.OutWriter = new(bytes.Buffer)
+
+	require.NoError(t, opts.Run())
+
+	f, err := opts.Fs.Open("logs.tar.gz")
+	require.NoError(t, err)
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	var files []string
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if header.Typeflag == tar.TypeReg {
+			files = append(files, header.Name)
+		}
+	}
+	assert.Equal(t, []string{
+		"atlas-x1-mongos-00-00.abcde.mongodb.net/mongos-audit-log.log.gz",
+		"atlas-x1-shard-00-00.abcde.mongodb.net/mongodb-audit-log.log.gz",
+		"atlas-x1-shard-00-01.abcde.mongodb.net/mongodb-audit-log.log.gz",
+	}, files)
+}
+
+func TestDownloadOpts_validateAllHosts(t *testing.T) {
+	opts := &DownloadOpts{allHosts: true, types: []string{"mongodb", "mongosqld"}}
+	opts.Out = "logs"
+	require.Error(t, opts.validateAllHosts())
+
+	opts.types = []string{"audit"}
+	require.NoError(t, opts.validateAllHosts())
+
+	opts.Out = cli.StdOutMode
+	require.Error(t, opts.validateAllHosts())
+
+	opts = &DownloadOpts{decrypt: true}
+	require.Error(t, opts.validateAllHosts())
+	assert.True(t, strings.Contains(opts.validateAllHosts().Error(), "--allHosts"))
+}
+
+func Test_clusterHosts(t *testing.T) {
+	hosts, err := clusterHosts(&atlasv2.ClusterDescription20240805{
+		ConnectionStrings: &atlasv2.ClusterConnectionStrings{
+			Standard: pointer.Get("mongodb://cluster0-shard-00-00.abcde.mongodb.net:27017,cluster0-shard-00-01.abcde.mongodb.net:27017/?replicaSet=atlas-x1-shard-0"),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"cluster0-shard-00-00.abcde.mongodb.net": true, "cluster0-shard-00-01.abcde.mongodb.net": true}, hosts)
+
+	_, err = clusterHosts(&atlasv2.ClusterDescription20240805{Name: pointer.Get("Cluster0")})
+	require.ErrorIs(t, err, errClusterHostsNotFound)
+}
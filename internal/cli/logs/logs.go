@@ -33,6 +33,7 @@ func Builder() *cobra.Command {
 		DownloadBuilder(),
 		decryption.KeyProvidersBuilder(),
 		DecryptBuilder(),
+		AnalyzeBuilder(),
 	)
 
 	return cmd
@@ -0,0 +1,467 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/prompt"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/telemetry"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+const (
+	applyTemplate = `Applied {{len .Changes}} change(s) to project '{{.ProjectID}}'.
+`
+	idle = "IDLE"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=apply_mock_test.go -package=manifest -source=apply.go
+
+type ClusterApplier interface {
+	LatestAtlasCluster(string, string) (*atlasv2.ClusterDescription20240805, error)
+	CreateClusterLatest(*atlasv2.ClusterDescription20240805) (*atlasv2.ClusterDescription20240805, error)
+	UpdateClusterLatest(string, string, *atlasv2.ClusterDescription20240805) (*atlasv2.ClusterDescription20240805, error)
+	DeleteCluster(string, string) error
+}
+
+type DatabaseUserApplier interface {
+	CreateDatabaseUser(*atlasv2.CloudDatabaseUser) (*atlasv2.CloudDatabaseUser, error)
+	UpdateDatabaseUser(*atlasv2.UpdateDatabaseUserApiParams) (*atlasv2.CloudDatabaseUser, error)
+	DeleteDatabaseUser(string, string, string) error
+}
+
+type ProjectIPAccessListApplier interface {
+	CreateProjectIPAccessList([]*atlasv2.NetworkPermissionEntry) (*atlasv2.PaginatedNetworkAccess, error)
+	DeleteProjectIPAccessList(string, string) error
+}
+
+type DatabaseRoleApplier interface {
+	CreateDatabaseRole(string, *atlasv2.UserCustomDBRole) (*atlasv2.UserCustomDBRole, error)
+	UpdateDatabaseRole(string, string, *atlasv2.UserCustomDBRole) (*atlasv2.UserCustomDBRole, error)
+	DeleteDatabaseRole(string, string) error
+}
+
+type AlertConfigurationApplier interface {
+	CreateAlertConfiguration(*atlasv2.GroupAlertsConfig) (*atlasv2.GroupAlertsConfig, error)
+	UpdateAlertConfiguration(*atlasv2.GroupAlertsConfig) (*atlasv2.GroupAlertsConfig, error)
+	DeleteAlertConfiguration(string, string) error
+}
+
+type SearchIndexApplier interface {
+	CreateSearchIndexes(string, string, *atlasv2.SearchIndexCreateRequest) (*atlasv2.SearchIndexResponse, error)
+	UpdateSearchIndexes(string, string, string, *atlasv2.SearchIndexUpdateRequest) (*atlasv2.SearchIndexResponse, error)
+	DeleteSearchIndex(string, string, string) error
+}
+
+type Store interface {
+	Lister
+	ClusterApplier
+	DatabaseUserApplier
+	ProjectIPAccessListApplier
+	DatabaseRoleApplier
+	AlertConfigurationApplier
+	SearchIndexApplier
+}
+
+type ApplyOpts struct {
+	cli.ProjectOpts
+	cli.WatchOpts
+	manifestOpts
+	confirm        bool
+	progressWriter io.Writer
+	store          Store
+}
+
+func (opts *ApplyOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ApplyOpts) Run(ctx context.Context) error {
+	p, err := opts.plan(opts.store, opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+
+	if !p.HasChanges() {
+		opts.Template = diffTemplate
+		return opts.Print(p)
+	}
+
+	if err := opts.printPlan(p); err != nil {
+		return err
+	}
+
+	if err := opts.warnAlertConfigurationDeletions(p); err != nil {
+		return err
+	}
+
+	if err := opts.prompt(p); err != nil {
+		return err
+	}
+
+	if !opts.confirm {
+		_, err := fmt.Fprintln(opts.progressWriter, "Apply cancelled.")
+		return err
+	}
+
+	if err := opts.apply(ctx, p); err != nil {
+		return err
+	}
+
+	return opts.Print(p)
+}
+
+// printPlan shows the plan before asking for confirmation, the plan is only printed once for json output.
+func (opts *ApplyOpts) printPlan(p *Plan) error {
+	if !opts.IsPlainOutput() {
+		return nil
+	}
+
+	diffOutput := cli.OutputOpts{Template: diffTemplate, OutWriter: opts.progressWriter, Output: opts.Output}
+	return diffOutput.Print(p)
+}
+
+// warnAlertConfigurationDeletions warns about the alert configurations that are not in the manifest,
+// Atlas creates default alert configurations for every project and they are deleted as well.
+func (opts *ApplyOpts) warnAlertConfigurationDeletions(p *Plan) error {
+	count := 0
+	for _, c := range p.Changes {
+		if c.Kind == AlertConfigurationKind && c.Action == DeleteAction {
+			count++
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(opts.progressWriter,
+		"Warning: %d alert configuration(s) of project '%s' are not in the manifest and will be deleted, including the default alert configurations of the project.\n",
+		count, p.ProjectID)
+	return err
+}
+
+func (opts *ApplyOpts) prompt(p *Plan) error {
+	if opts.confirm {
+		return nil
+	}
+
+	message := fmt.Sprintf("Apply %d change(s) to project '%s'? (%d to create, %d to update, %d to delete)",
+		len(p.Changes), p.ProjectID, p.Count(CreateAction), p.Count(UpdateAction), p.Count(DeleteAction))
+	return telemetry.TrackAskOne(prompt.NewConfirm(message), &opts.confirm)
+}
+
+// apply executes the changes in order.
+// Clusters are waited on until they are IDLE when --watch is set or when the plan changes search indexes of that cluster.
+func (opts *ApplyOpts) apply(ctx context.Context, p *Plan) error {
+	for _, c := range p.Changes {
+		if err := opts.applyChange(c); err != nil {
+			return fmt.Errorf("failed to %s %s '%s': %w", c.Action, c.Kind, c.Name, err)
+		}
+
+		if _, err := fmt.Fprintf(opts.progressWriter, "%s %s '%s': done\n", c.Action, c.Kind, c.Name); err != nil {
+			return err
+		}
+
+		if c.Kind == ClusterKind && c.Action != DeleteAction && (opts.EnableWatch || hasSearchIndexChanges(p, c.Name)) {
+			if err := opts.waitForCluster(ctx, c.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasSearchIndexChanges(p *Plan, clusterName string) bool {
+	for _, c := range p.Changes {
+		if c.Kind != SearchIndexKind {
+			continue
+		}
+
+		r := c.Desired
+		if r == nil {
+			r = c.Live
+		}
+
+		if r.String("clusterName") == clusterName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (opts *ApplyOpts) waitForCluster(ctx context.Context, name string) error {
+	_, err := opts.WatchContext(ctx, func() (any, bool, error) {
+		result, err := opts.store.LatestAtlasCluster(opts.ConfigProjectID(), name)
+		if err != nil {
+			return nil, false, err
+		}
+		return nil, result.GetStateName() == idle, nil
+	})
+
+	return err
+}
+
+func (opts *ApplyOpts) applyChange(c Change) error {
+	switch c.Kind {
+	case ClusterKind:
+		return opts.applyCluster(c)
+	case DatabaseUserKind:
+		return opts.applyDatabaseUser(c)
+	case AccessListKind:
+		return opts.applyAccessList(c)
+	case CustomDBRoleKind:
+		return opts.applyCustomDBRole(c)
+	case AlertConfigurationKind:
+		return opts.applyAlertConfiguration(c)
+	case SearchIndexKind:
+		return opts.applySearchIndex(c)
+	}
+
+	return fmt.Errorf("unsupported kind: %s", c.Kind)
+}
+
+// decode converts a manifest resource into an Atlas SDK request.
+func decode[T any](r Resource, extra map[string]any) (*T, error) {
+	body := make(Resource, len(r)+len(extra))
+	for k, v := range r {
+		body[k] = v
+	}
+	for k, v := range extra {
+		body[k] = v
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(T)
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (opts *ApplyOpts) applyCluster(c Change) error {
+	projectID := opts.ConfigProjectID()
+	if c.Action == DeleteAction {
+		return opts.store.DeleteCluster(projectID, c.Name)
+	}
+
+	cluster, err := decode[atlasv2.ClusterDescription20240805](c.Desired, map[string]any{"groupId": projectID})
+	if err != nil {
+		return err
+	}
+
+	if c.Action == CreateAction {
+		_, err = opts.store.CreateClusterLatest(cluster)
+		return err
+	}
+
+	_, err = opts.store.UpdateClusterLatest(projectID, c.Name, cluster)
+	return err
+}
+
+func (opts *ApplyOpts) applyDatabaseUser(c Change) error {
+	projectID := opts.ConfigProjectID()
+	if c.Action == DeleteAction {
+		return opts.store.DeleteDatabaseUser(c.Live.String("databaseName"), projectID, c.Live.String("username"))
+	}
+
+	databaseName := c.Desired.String("databaseName")
+	if databaseName == "" {
+		databaseName = defaultAuthDB
+	}
+
+	user, err := decode[atlasv2.CloudDatabaseUser](c.Desired, map[string]any{"groupId": projectID, "databaseName": databaseName})
+	if err != nil {
+		return err
+	}
+
+	if c.Action == CreateAction {
+		_, err = opts.store.CreateDatabaseUser(user)
+		return err
+	}
+
+	_, err = opts.store.UpdateDatabaseUser(&atlasv2.UpdateDatabaseUserApiParams{
+		GroupId:           projectID,
+		DatabaseName:      databaseName,
+		Username:          user.Username,
+		CloudDatabaseUser: user,
+	})
+	return err
+}
+
+func (opts *ApplyOpts) applyAccessList(c Change) error {
+	projectID := opts.ConfigProjectID()
+	if c.Action == DeleteAction {
+		entry := c.Live.String("ipAddress")
+		if entry == "" {
+			entry = c.Name
+		}
+		return opts.store.DeleteProjectIPAccessList(projectID, entry)
+	}
+
+	// creating an existing entry updates it
+	entry, err := decode[atlasv2.NetworkPermissionEntry](c.Desired, map[string]any{"groupId": projectID})
+	if err != nil {
+		return err
+	}
+
+	_, err = opts.store.CreateProjectIPAccessList([]*atlasv2.NetworkPermissionEntry{entry})
+	return err
+}
+
+func (opts *ApplyOpts) applyCustomDBRole(c Change) error {
+	projectID := opts.ConfigProjectID()
+	if c.Action == DeleteAction {
+		return opts.store.DeleteDatabaseRole(projectID, c.Name)
+	}
+
+	role, err := decode[atlasv2.UserCustomDBRole](c.Desired, nil)
+	if err != nil {
+		return err
+	}
+
+	if c.Action == CreateAction {
+		_, err = opts.store.CreateDatabaseRole(projectID, role)
+		return err
+	}
+
+	_, err = opts.store.UpdateDatabaseRole(projectID, c.Name, role)
+	return err
+}
+
+func (opts *ApplyOpts) applyAlertConfiguration(c Change) error {
+	projectID := opts.ConfigProjectID()
+	if c.Action == DeleteAction {
+		return opts.store.DeleteAlertConfiguration(projectID, c.Live.String("id"))
+	}
+
+	if c.Action == CreateAction {
+		alert, err := decode[atlasv2.GroupAlertsConfig](c.Desired, map[string]any{"groupId": projectID})
+		if err != nil {
+			return err
+		}
+		_, err = opts.store.CreateAlertConfiguration(alert)
+		return err
+	}
+
+	// alert configurations are replaced as a whole, keep the fields that are not in the manifest
+	alert, err := decode[atlasv2.GroupAlertsConfig](c.Live, c.Desired)
+	if err != nil {
+		return err
+	}
+
+	_, err = opts.store.UpdateAlertConfiguration(alert)
+	return err
+}
+
+func (opts *ApplyOpts) applySearchIndex(c Change) error {
+	projectID := opts.ConfigProjectID()
+	if c.Action == DeleteAction {
+		return opts.store.DeleteSearchIndex(projectID, c.Live.String("clusterName"), c.Live.String("indexID"))
+	}
+
+	clusterName := c.Desired.String("clusterName")
+	if c.Action == CreateAction {
+		index, err := decode[atlasv2.SearchIndexCreateRequest](c.Desired, nil)
+		if err != nil {
+			return err
+		}
+		_, err = opts.store.CreateSearchIndexes(projectID, clusterName, index)
+		return err
+	}
+
+	index, err := decode[atlasv2.SearchIndexUpdateRequest](Resource{"definition": c.Desired["definition"]}, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = opts.store.UpdateSearchIndexes(projectID, clusterName, c.Live.String("indexID"), index)
+	return err
+}
+
+// atlas apply --file manifest.yaml [--projectId projectId] [--force] [--watch].
+func ApplyBuilder() *cobra.Command {
+	opts := &ApplyOpts{
+		manifestOpts: manifestOpts{fs: afero.NewOsFs()},
+	}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Make the resources of your project match a manifest file.",
+		Long: `The command computes the same plan as the diff command and runs it in dependency order:
+custom database roles, database users, IP access list entries, clusters, search indexes and alert configurations are created or updated first,
+then the resources that are not in the manifest are deleted in the reverse order.
+Clusters, database users, IP access list entries, custom database roles and alert configurations are fully reconciled for every kind present in the manifest:
+all the resources of that kind that are not in the manifest are deleted, including the default alert configurations of the project.
+Search indexes are only reconciled for the collections the manifest references, the search indexes of other collections are left untouched.
+Clusters are watched until they are available before creating or updating their search indexes.
+
+` + fmt.Sprintf(usage.RequiredRole, "Project Owner"),
+		Args: require.NoArgs,
+		Example: `  # Show the plan, ask for confirmation and apply the manifest.yaml file:
+  atlas apply --file manifest.yaml --projectId 5e2211c17a3e5a48f5497de3
+
+  # Apply the manifest.yaml file without confirmation and wait for all clusters to be available:
+  atlas apply --file manifest.yaml --projectId 5e2211c17a3e5a48f5497de3 --force --watch`,
+		Annotations: map[string]string{
+			"output": applyTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			opts.progressWriter = cmd.ErrOrStderr()
+			return opts.PreRunE(
+				opts.loadManifest(&opts.ProjectOpts),
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), applyTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.ManifestFilename)
+	cmd.Flags().BoolVar(&opts.confirm, flag.Force, false, usage.ForceApply)
+	cmd.Flags().BoolVarP(&opts.EnableWatch, flag.EnableWatch, flag.EnableWatchShort, false, usage.EnableWatch)
+	_ = cmd.MarkFlagFilename(flag.File)
+	_ = cmd.MarkFlagRequired(flag.File)
+
+	opts.AddProjectOptsFlags(cmd)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
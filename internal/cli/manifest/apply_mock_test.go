@@ -0,0 +1,1812 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: apply.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=apply_mock_test.go -package=manifest -source=apply.go
+//
+
+// Package manifest is a generated GoMock package.
+package manifest
+
+import (
+	reflect "reflect"
+
+	store "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	admin "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClusterApplier is a mock of ClusterApplier interface.
+type MockClusterApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterApplierMockRecorder
+	isgomock struct{}
+}
+
+// MockClusterApplierMockRecorder is the mock recorder for MockClusterApplier.
+type MockClusterApplierMockRecorder struct {
+	mock *MockClusterApplier
+}
+
+// NewMockClusterApplier creates a new mock instance.
+func NewMockClusterApplier(ctrl *gomock.Controller) *MockClusterApplier {
+	mock := &MockClusterApplier{ctrl: ctrl}
+	mock.recorder = &MockClusterApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterApplier) EXPECT() *MockClusterApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateClusterLatest mocks base method.
+func (m *MockClusterApplier) CreateClusterLatest(arg0 *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterLatest", arg0)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterLatest indicates an expected call of CreateClusterLatest.
+func (mr *MockClusterApplierMockRecorder) CreateClusterLatest(arg0 any) *MockClusterApplierCreateClusterLatestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterLatest", reflect.TypeOf((*MockClusterApplier)(nil).CreateClusterLatest), arg0)
+	return &MockClusterApplierCreateClusterLatestCall{Call: call}
+}
+
+// MockClusterApplierCreateClusterLatestCall wrap *gomock.Call
+type MockClusterApplierCreateClusterLatestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterApplierCreateClusterLatestCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockClusterApplierCreateClusterLatestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterApplierCreateClusterLatestCall) Do(f func(*admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockClusterApplierCreateClusterLatestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterApplierCreateClusterLatestCall) DoAndReturn(f func(*admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockClusterApplierCreateClusterLatestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCluster mocks base method.
+func (m *MockClusterApplier) DeleteCluster(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCluster", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCluster indicates an expected call of DeleteCluster.
+func (mr *MockClusterApplierMockRecorder) DeleteCluster(arg0, arg1 any) *MockClusterApplierDeleteClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClusterApplier)(nil).DeleteCluster), arg0, arg1)
+	return &MockClusterApplierDeleteClusterCall{Call: call}
+}
+
+// MockClusterApplierDeleteClusterCall wrap *gomock.Call
+type MockClusterApplierDeleteClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterApplierDeleteClusterCall) Return(arg0 error) *MockClusterApplierDeleteClusterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterApplierDeleteClusterCall) Do(f func(string, string) error) *MockClusterApplierDeleteClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterApplierDeleteClusterCall) DoAndReturn(f func(string, string) error) *MockClusterApplierDeleteClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestAtlasCluster mocks base method.
+func (m *MockClusterApplier) LatestAtlasCluster(arg0, arg1 string) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestAtlasCluster", arg0, arg1)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestAtlasCluster indicates an expected call of LatestAtlasCluster.
+func (mr *MockClusterApplierMockRecorder) LatestAtlasCluster(arg0, arg1 any) *MockClusterApplierLatestAtlasClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAtlasCluster", reflect.TypeOf((*MockClusterApplier)(nil).LatestAtlasCluster), arg0, arg1)
+	return &MockClusterApplierLatestAtlasClusterCall{Call: call}
+}
+
+// MockClusterApplierLatestAtlasClusterCall wrap *gomock.Call
+type MockClusterApplierLatestAtlasClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterApplierLatestAtlasClusterCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockClusterApplierLatestAtlasClusterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterApplierLatestAtlasClusterCall) Do(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockClusterApplierLatestAtlasClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterApplierLatestAtlasClusterCall) DoAndReturn(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockClusterApplierLatestAtlasClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateClusterLatest mocks base method.
+func (m *MockClusterApplier) UpdateClusterLatest(arg0, arg1 string, arg2 *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterLatest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterLatest indicates an expected call of UpdateClusterLatest.
+func (mr *MockClusterApplierMockRecorder) UpdateClusterLatest(arg0, arg1, arg2 any) *MockClusterApplierUpdateClusterLatestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterLatest", reflect.TypeOf((*MockClusterApplier)(nil).UpdateClusterLatest), arg0, arg1, arg2)
+	return &MockClusterApplierUpdateClusterLatestCall{Call: call}
+}
+
+// MockClusterApplierUpdateClusterLatestCall wrap *gomock.Call
+type MockClusterApplierUpdateClusterLatestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterApplierUpdateClusterLatestCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockClusterApplierUpdateClusterLatestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterApplierUpdateClusterLatestCall) Do(f func(string, string, *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockClusterApplierUpdateClusterLatestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterApplierUpdateClusterLatestCall) DoAndReturn(f func(string, string, *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockClusterApplierUpdateClusterLatestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDatabaseUserApplier is a mock of DatabaseUserApplier interface.
+type MockDatabaseUserApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseUserApplierMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseUserApplierMockRecorder is the mock recorder for MockDatabaseUserApplier.
+type MockDatabaseUserApplierMockRecorder struct {
+	mock *MockDatabaseUserApplier
+}
+
+// NewMockDatabaseUserApplier creates a new mock instance.
+func NewMockDatabaseUserApplier(ctrl *gomock.Controller) *MockDatabaseUserApplier {
+	mock := &MockDatabaseUserApplier{ctrl: ctrl}
+	mock.recorder = &MockDatabaseUserApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseUserApplier) EXPECT() *MockDatabaseUserApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateDatabaseUser mocks base method.
+func (m *MockDatabaseUserApplier) CreateDatabaseUser(arg0 *admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*admin.CloudDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseUser indicates an expected call of CreateDatabaseUser.
+func (mr *MockDatabaseUserApplierMockRecorder) CreateDatabaseUser(arg0 any) *MockDatabaseUserApplierCreateDatabaseUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseUser", reflect.TypeOf((*MockDatabaseUserApplier)(nil).CreateDatabaseUser), arg0)
+	return &MockDatabaseUserApplierCreateDatabaseUserCall{Call: call}
+}
+
+// MockDatabaseUserApplierCreateDatabaseUserCall wrap *gomock.Call
+type MockDatabaseUserApplierCreateDatabaseUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseUserApplierCreateDatabaseUserCall) Return(arg0 *admin.CloudDatabaseUser, arg1 error) *MockDatabaseUserApplierCreateDatabaseUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseUserApplierCreateDatabaseUserCall) Do(f func(*admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error)) *MockDatabaseUserApplierCreateDatabaseUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseUserApplierCreateDatabaseUserCall) DoAndReturn(f func(*admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error)) *MockDatabaseUserApplierCreateDatabaseUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteDatabaseUser mocks base method.
+func (m *MockDatabaseUserApplier) DeleteDatabaseUser(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseUser indicates an expected call of DeleteDatabaseUser.
+func (mr *MockDatabaseUserApplierMockRecorder) DeleteDatabaseUser(arg0, arg1, arg2 any) *MockDatabaseUserApplierDeleteDatabaseUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseUser", reflect.TypeOf((*MockDatabaseUserApplier)(nil).DeleteDatabaseUser), arg0, arg1, arg2)
+	return &MockDatabaseUserApplierDeleteDatabaseUserCall{Call: call}
+}
+
+// MockDatabaseUserApplierDeleteDatabaseUserCall wrap *gomock.Call
+type MockDatabaseUserApplierDeleteDatabaseUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseUserApplierDeleteDatabaseUserCall) Return(arg0 error) *MockDatabaseUserApplierDeleteDatabaseUserCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseUserApplierDeleteDatabaseUserCall) Do(f func(string, string, string) error) *MockDatabaseUserApplierDeleteDatabaseUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseUserApplierDeleteDatabaseUserCall) DoAndReturn(f func(string, string, string) error) *MockDatabaseUserApplierDeleteDatabaseUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDatabaseUser mocks base method.
+func (m *MockDatabaseUserApplier) UpdateDatabaseUser(arg0 *admin.UpdateDatabaseUserApiParams) (*admin.CloudDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*admin.CloudDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseUser indicates an expected call of UpdateDatabaseUser.
+func (mr *MockDatabaseUserApplierMockRecorder) UpdateDatabaseUser(arg0 any) *MockDatabaseUserApplierUpdateDatabaseUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseUser", reflect.TypeOf((*MockDatabaseUserApplier)(nil).UpdateDatabaseUser), arg0)
+	return &MockDatabaseUserApplierUpdateDatabaseUserCall{Call: call}
+}
+
+// MockDatabaseUserApplierUpdateDatabaseUserCall wrap *gomock.Call
+type MockDatabaseUserApplierUpdateDatabaseUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseUserApplierUpdateDatabaseUserCall) Return(arg0 *admin.CloudDatabaseUser, arg1 error) *MockDatabaseUserApplierUpdateDatabaseUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseUserApplierUpdateDatabaseUserCall) Do(f func(*admin.UpdateDatabaseUserApiParams) (*admin.CloudDatabaseUser, error)) *MockDatabaseUserApplierUpdateDatabaseUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseUserApplierUpdateDatabaseUserCall) DoAndReturn(f func(*admin.UpdateDatabaseUserApiParams) (*admin.CloudDatabaseUser, error)) *MockDatabaseUserApplierUpdateDatabaseUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockProjectIPAccessListApplier is a mock of ProjectIPAccessListApplier interface.
+type MockProjectIPAccessListApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectIPAccessListApplierMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectIPAccessListApplierMockRecorder is the mock recorder for MockProjectIPAccessListApplier.
+type MockProjectIPAccessListApplierMockRecorder struct {
+	mock *MockProjectIPAccessListApplier
+}
+
+// NewMockProjectIPAccessListApplier creates a new mock instance.
+func NewMockProjectIPAccessListApplier(ctrl *gomock.Controller) *MockProjectIPAccessListApplier {
+	mock := &MockProjectIPAccessListApplier{ctrl: ctrl}
+	mock.recorder = &MockProjectIPAccessListApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectIPAccessListApplier) EXPECT() *MockProjectIPAccessListApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateProjectIPAccessList mocks base method.
+func (m *MockProjectIPAccessListApplier) CreateProjectIPAccessList(arg0 []*admin.NetworkPermissionEntry) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectIPAccessList", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectIPAccessList indicates an expected call of CreateProjectIPAccessList.
+func (mr *MockProjectIPAccessListApplierMockRecorder) CreateProjectIPAccessList(arg0 any) *MockProjectIPAccessListApplierCreateProjectIPAccessListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectIPAccessList", reflect.TypeOf((*MockProjectIPAccessListApplier)(nil).CreateProjectIPAccessList), arg0)
+	return &MockProjectIPAccessListApplierCreateProjectIPAccessListCall{Call: call}
+}
+
+// MockProjectIPAccessListApplierCreateProjectIPAccessListCall wrap *gomock.Call
+type MockProjectIPAccessListApplierCreateProjectIPAccessListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectIPAccessListApplierCreateProjectIPAccessListCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockProjectIPAccessListApplierCreateProjectIPAccessListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectIPAccessListApplierCreateProjectIPAccessListCall) Do(f func([]*admin.NetworkPermissionEntry) (*admin.PaginatedNetworkAccess, error)) *MockProjectIPAccessListApplierCreateProjectIPAccessListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectIPAccessListApplierCreateProjectIPAccessListCall) DoAndReturn(f func([]*admin.NetworkPermissionEntry) (*admin.PaginatedNetworkAccess, error)) *MockProjectIPAccessListApplierCreateProjectIPAccessListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProjectIPAccessList mocks base method.
+func (m *MockProjectIPAccessListApplier) DeleteProjectIPAccessList(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectIPAccessList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectIPAccessList indicates an expected call of DeleteProjectIPAccessList.
+func (mr *MockProjectIPAccessListApplierMockRecorder) DeleteProjectIPAccessList(arg0, arg1 any) *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectIPAccessList", reflect.TypeOf((*MockProjectIPAccessListApplier)(nil).DeleteProjectIPAccessList), arg0, arg1)
+	return &MockProjectIPAccessListApplierDeleteProjectIPAccessListCall{Call: call}
+}
+
+// MockProjectIPAccessListApplierDeleteProjectIPAccessListCall wrap *gomock.Call
+type MockProjectIPAccessListApplierDeleteProjectIPAccessListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall) Return(arg0 error) *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall) Do(f func(string, string) error) *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall) DoAndReturn(f func(string, string) error) *MockProjectIPAccessListApplierDeleteProjectIPAccessListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDatabaseRoleApplier is a mock of DatabaseRoleApplier interface.
+type MockDatabaseRoleApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseRoleApplierMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseRoleApplierMockRecorder is the mock recorder for MockDatabaseRoleApplier.
+type MockDatabaseRoleApplierMockRecorder struct {
+	mock *MockDatabaseRoleApplier
+}
+
+// NewMockDatabaseRoleApplier creates a new mock instance.
+func NewMockDatabaseRoleApplier(ctrl *gomock.Controller) *MockDatabaseRoleApplier {
+	mock := &MockDatabaseRoleApplier{ctrl: ctrl}
+	mock.recorder = &MockDatabaseRoleApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseRoleApplier) EXPECT() *MockDatabaseRoleApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateDatabaseRole mocks base method.
+func (m *MockDatabaseRoleApplier) CreateDatabaseRole(arg0 string, arg1 *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(*admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseRole indicates an expected call of CreateDatabaseRole.
+func (mr *MockDatabaseRoleApplierMockRecorder) CreateDatabaseRole(arg0, arg1 any) *MockDatabaseRoleApplierCreateDatabaseRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).CreateDatabaseRole), arg0, arg1)
+	return &MockDatabaseRoleApplierCreateDatabaseRoleCall{Call: call}
+}
+
+// MockDatabaseRoleApplierCreateDatabaseRoleCall wrap *gomock.Call
+type MockDatabaseRoleApplierCreateDatabaseRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseRoleApplierCreateDatabaseRoleCall) Return(arg0 *admin.UserCustomDBRole, arg1 error) *MockDatabaseRoleApplierCreateDatabaseRoleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseRoleApplierCreateDatabaseRoleCall) Do(f func(string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockDatabaseRoleApplierCreateDatabaseRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseRoleApplierCreateDatabaseRoleCall) DoAndReturn(f func(string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockDatabaseRoleApplierCreateDatabaseRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteDatabaseRole mocks base method.
+func (m *MockDatabaseRoleApplier) DeleteDatabaseRole(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseRole indicates an expected call of DeleteDatabaseRole.
+func (mr *MockDatabaseRoleApplierMockRecorder) DeleteDatabaseRole(arg0, arg1 any) *MockDatabaseRoleApplierDeleteDatabaseRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).DeleteDatabaseRole), arg0, arg1)
+	return &MockDatabaseRoleApplierDeleteDatabaseRoleCall{Call: call}
+}
+
+// MockDatabaseRoleApplierDeleteDatabaseRoleCall wrap *gomock.Call
+type MockDatabaseRoleApplierDeleteDatabaseRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseRoleApplierDeleteDatabaseRoleCall) Return(arg0 error) *MockDatabaseRoleApplierDeleteDatabaseRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseRoleApplierDeleteDatabaseRoleCall) Do(f func(string, string) error) *MockDatabaseRoleApplierDeleteDatabaseRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseRoleApplierDeleteDatabaseRoleCall) DoAndReturn(f func(string, string) error) *MockDatabaseRoleApplierDeleteDatabaseRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDatabaseRole mocks base method.
+func (m *MockDatabaseRoleApplier) UpdateDatabaseRole(arg0, arg1 string, arg2 *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseRole indicates an expected call of UpdateDatabaseRole.
+func (mr *MockDatabaseRoleApplierMockRecorder) UpdateDatabaseRole(arg0, arg1, arg2 any) *MockDatabaseRoleApplierUpdateDatabaseRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseRole", reflect.TypeOf((*MockDatabaseRoleApplier)(nil).UpdateDatabaseRole), arg0, arg1, arg2)
+	return &MockDatabaseRoleApplierUpdateDatabaseRoleCall{Call: call}
+}
+
+// MockDatabaseRoleApplierUpdateDatabaseRoleCall wrap *gomock.Call
+type MockDatabaseRoleApplierUpdateDatabaseRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseRoleApplierUpdateDatabaseRoleCall) Return(arg0 *admin.UserCustomDBRole, arg1 error) *MockDatabaseRoleApplierUpdateDatabaseRoleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseRoleApplierUpdateDatabaseRoleCall) Do(f func(string, string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockDatabaseRoleApplierUpdateDatabaseRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseRoleApplierUpdateDatabaseRoleCall) DoAndReturn(f func(string, string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockDatabaseRoleApplierUpdateDatabaseRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAlertConfigurationApplier is a mock of AlertConfigurationApplier interface.
+type MockAlertConfigurationApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertConfigurationApplierMockRecorder
+	isgomock struct{}
+}
+
+// MockAlertConfigurationApplierMockRecorder is the mock recorder for MockAlertConfigurationApplier.
+type MockAlertConfigurationApplierMockRecorder struct {
+	mock *MockAlertConfigurationApplier
+}
+
+// NewMockAlertConfigurationApplier creates a new mock instance.
+func NewMockAlertConfigurationApplier(ctrl *gomock.Controller) *MockAlertConfigurationApplier {
+	mock := &MockAlertConfigurationApplier{ctrl: ctrl}
+	mock.recorder = &MockAlertConfigurationApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertConfigurationApplier) EXPECT() *MockAlertConfigurationApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateAlertConfiguration mocks base method.
+func (m *MockAlertConfigurationApplier) CreateAlertConfiguration(arg0 *admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertConfiguration", arg0)
+	ret0, _ := ret[0].(*admin.GroupAlertsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertConfiguration indicates an expected call of CreateAlertConfiguration.
+func (mr *MockAlertConfigurationApplierMockRecorder) CreateAlertConfiguration(arg0 any) *MockAlertConfigurationApplierCreateAlertConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertConfiguration", reflect.TypeOf((*MockAlertConfigurationApplier)(nil).CreateAlertConfiguration), arg0)
+	return &MockAlertConfigurationApplierCreateAlertConfigurationCall{Call: call}
+}
+
+// MockAlertConfigurationApplierCreateAlertConfigurationCall wrap *gomock.Call
+type MockAlertConfigurationApplierCreateAlertConfigurationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAlertConfigurationApplierCreateAlertConfigurationCall) Return(arg0 *admin.GroupAlertsConfig, arg1 error) *MockAlertConfigurationApplierCreateAlertConfigurationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAlertConfigurationApplierCreateAlertConfigurationCall) Do(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockAlertConfigurationApplierCreateAlertConfigurationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAlertConfigurationApplierCreateAlertConfigurationCall) DoAndReturn(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockAlertConfigurationApplierCreateAlertConfigurationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAlertConfiguration mocks base method.
+func (m *MockAlertConfigurationApplier) DeleteAlertConfiguration(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertConfiguration indicates an expected call of DeleteAlertConfiguration.
+func (mr *MockAlertConfigurationApplierMockRecorder) DeleteAlertConfiguration(arg0, arg1 any) *MockAlertConfigurationApplierDeleteAlertConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertConfiguration", reflect.TypeOf((*MockAlertConfigurationApplier)(nil).DeleteAlertConfiguration), arg0, arg1)
+	return &MockAlertConfigurationApplierDeleteAlertConfigurationCall{Call: call}
+}
+
+// MockAlertConfigurationApplierDeleteAlertConfigurationCall wrap *gomock.Call
+type MockAlertConfigurationApplierDeleteAlertConfigurationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAlertConfigurationApplierDeleteAlertConfigurationCall) Return(arg0 error) *MockAlertConfigurationApplierDeleteAlertConfigurationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAlertConfigurationApplierDeleteAlertConfigurationCall) Do(f func(string, string) error) *MockAlertConfigurationApplierDeleteAlertConfigurationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAlertConfigurationApplierDeleteAlertConfigurationCall) DoAndReturn(f func(string, string) error) *MockAlertConfigurationApplierDeleteAlertConfigurationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAlertConfiguration mocks base method.
+func (m *MockAlertConfigurationApplier) UpdateAlertConfiguration(arg0 *admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertConfiguration", arg0)
+	ret0, _ := ret[0].(*admin.GroupAlertsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlertConfiguration indicates an expected call of UpdateAlertConfiguration.
+func (mr *MockAlertConfigurationApplierMockRecorder) UpdateAlertConfiguration(arg0 any) *MockAlertConfigurationApplierUpdateAlertConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertConfiguration", reflect.TypeOf((*MockAlertConfigurationApplier)(nil).UpdateAlertConfiguration), arg0)
+	return &MockAlertConfigurationApplierUpdateAlertConfigurationCall{Call: call}
+}
+
+// MockAlertConfigurationApplierUpdateAlertConfigurationCall wrap *gomock.Call
+type MockAlertConfigurationApplierUpdateAlertConfigurationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAlertConfigurationApplierUpdateAlertConfigurationCall) Return(arg0 *admin.GroupAlertsConfig, arg1 error) *MockAlertConfigurationApplierUpdateAlertConfigurationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAlertConfigurationApplierUpdateAlertConfigurationCall) Do(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockAlertConfigurationApplierUpdateAlertConfigurationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAlertConfigurationApplierUpdateAlertConfigurationCall) DoAndReturn(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockAlertConfigurationApplierUpdateAlertConfigurationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSearchIndexApplier is a mock of SearchIndexApplier interface.
+type MockSearchIndexApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchIndexApplierMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchIndexApplierMockRecorder is the mock recorder for MockSearchIndexApplier.
+type MockSearchIndexApplierMockRecorder struct {
+	mock *MockSearchIndexApplier
+}
+
+// NewMockSearchIndexApplier creates a new mock instance.
+func NewMockSearchIndexApplier(ctrl *gomock.Controller) *MockSearchIndexApplier {
+	mock := &MockSearchIndexApplier{ctrl: ctrl}
+	mock.recorder = &MockSearchIndexApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchIndexApplier) EXPECT() *MockSearchIndexApplierMockRecorder {
+	return m.recorder
+}
+
+// CreateSearchIndexes mocks base method.
+func (m *MockSearchIndexApplier) CreateSearchIndexes(arg0, arg1 string, arg2 *admin.SearchIndexCreateRequest) (*admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchIndexes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchIndexes indicates an expected call of CreateSearchIndexes.
+func (mr *MockSearchIndexApplierMockRecorder) CreateSearchIndexes(arg0, arg1, arg2 any) *MockSearchIndexApplierCreateSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchIndexes", reflect.TypeOf((*MockSearchIndexApplier)(nil).CreateSearchIndexes), arg0, arg1, arg2)
+	return &MockSearchIndexApplierCreateSearchIndexesCall{Call: call}
+}
+
+// MockSearchIndexApplierCreateSearchIndexesCall wrap *gomock.Call
+type MockSearchIndexApplierCreateSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSearchIndexApplierCreateSearchIndexesCall) Return(arg0 *admin.SearchIndexResponse, arg1 error) *MockSearchIndexApplierCreateSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSearchIndexApplierCreateSearchIndexesCall) Do(f func(string, string, *admin.SearchIndexCreateRequest) (*admin.SearchIndexResponse, error)) *MockSearchIndexApplierCreateSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSearchIndexApplierCreateSearchIndexesCall) DoAndReturn(f func(string, string, *admin.SearchIndexCreateRequest) (*admin.SearchIndexResponse, error)) *MockSearchIndexApplierCreateSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSearchIndex mocks base method.
+func (m *MockSearchIndexApplier) DeleteSearchIndex(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSearchIndex", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSearchIndex indicates an expected call of DeleteSearchIndex.
+func (mr *MockSearchIndexApplierMockRecorder) DeleteSearchIndex(arg0, arg1, arg2 any) *MockSearchIndexApplierDeleteSearchIndexCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchIndex", reflect.TypeOf((*MockSearchIndexApplier)(nil).DeleteSearchIndex), arg0, arg1, arg2)
+	return &MockSearchIndexApplierDeleteSearchIndexCall{Call: call}
+}
+
+// MockSearchIndexApplierDeleteSearchIndexCall wrap *gomock.Call
+type MockSearchIndexApplierDeleteSearchIndexCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSearchIndexApplierDeleteSearchIndexCall) Return(arg0 error) *MockSearchIndexApplierDeleteSearchIndexCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSearchIndexApplierDeleteSearchIndexCall) Do(f func(string, string, string) error) *MockSearchIndexApplierDeleteSearchIndexCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSearchIndexApplierDeleteSearchIndexCall) DoAndReturn(f func(string, string, string) error) *MockSearchIndexApplierDeleteSearchIndexCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSearchIndexes mocks base method.
+func (m *MockSearchIndexApplier) UpdateSearchIndexes(arg0, arg1, arg2 string, arg3 *admin.SearchIndexUpdateRequest) (*admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSearchIndexes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSearchIndexes indicates an expected call of UpdateSearchIndexes.
+func (mr *MockSearchIndexApplierMockRecorder) UpdateSearchIndexes(arg0, arg1, arg2, arg3 any) *MockSearchIndexApplierUpdateSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchIndexes", reflect.TypeOf((*MockSearchIndexApplier)(nil).UpdateSearchIndexes), arg0, arg1, arg2, arg3)
+	return &MockSearchIndexApplierUpdateSearchIndexesCall{Call: call}
+}
+
+// MockSearchIndexApplierUpdateSearchIndexesCall wrap *gomock.Call
+type MockSearchIndexApplierUpdateSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSearchIndexApplierUpdateSearchIndexesCall) Return(arg0 *admin.SearchIndexResponse, arg1 error) *MockSearchIndexApplierUpdateSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSearchIndexApplierUpdateSearchIndexesCall) Do(f func(string, string, string, *admin.SearchIndexUpdateRequest) (*admin.SearchIndexResponse, error)) *MockSearchIndexApplierUpdateSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSearchIndexApplierUpdateSearchIndexesCall) DoAndReturn(f func(string, string, string, *admin.SearchIndexUpdateRequest) (*admin.SearchIndexResponse, error)) *MockSearchIndexApplierUpdateSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// AlertConfigurations mocks base method.
+func (m *MockStore) AlertConfigurations(arg0 *admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigurations", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedAlertConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertConfigurations indicates an expected call of AlertConfigurations.
+func (mr *MockStoreMockRecorder) AlertConfigurations(arg0 any) *MockStoreAlertConfigurationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockStore)(nil).AlertConfigurations), arg0)
+	return &MockStoreAlertConfigurationsCall{Call: call}
+}
+
+// MockStoreAlertConfigurationsCall wrap *gomock.Call
+type MockStoreAlertConfigurationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreAlertConfigurationsCall) Return(arg0 *admin.PaginatedAlertConfig, arg1 error) *MockStoreAlertConfigurationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreAlertConfigurationsCall) Do(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockStoreAlertConfigurationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreAlertConfigurationsCall) DoAndReturn(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockStoreAlertConfigurationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAlertConfiguration mocks base method.
+func (m *MockStore) CreateAlertConfiguration(arg0 *admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertConfiguration", arg0)
+	ret0, _ := ret[0].(*admin.GroupAlertsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertConfiguration indicates an expected call of CreateAlertConfiguration.
+func (mr *MockStoreMockRecorder) CreateAlertConfiguration(arg0 any) *MockStoreCreateAlertConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertConfiguration", reflect.TypeOf((*MockStore)(nil).CreateAlertConfiguration), arg0)
+	return &MockStoreCreateAlertConfigurationCall{Call: call}
+}
+
+// MockStoreCreateAlertConfigurationCall wrap *gomock.Call
+type MockStoreCreateAlertConfigurationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateAlertConfigurationCall) Return(arg0 *admin.GroupAlertsConfig, arg1 error) *MockStoreCreateAlertConfigurationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateAlertConfigurationCall) Do(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockStoreCreateAlertConfigurationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateAlertConfigurationCall) DoAndReturn(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockStoreCreateAlertConfigurationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateClusterLatest mocks base method.
+func (m *MockStore) CreateClusterLatest(arg0 *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterLatest", arg0)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterLatest indicates an expected call of CreateClusterLatest.
+func (mr *MockStoreMockRecorder) CreateClusterLatest(arg0 any) *MockStoreCreateClusterLatestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterLatest", reflect.TypeOf((*MockStore)(nil).CreateClusterLatest), arg0)
+	return &MockStoreCreateClusterLatestCall{Call: call}
+}
+
+// MockStoreCreateClusterLatestCall wrap *gomock.Call
+type MockStoreCreateClusterLatestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateClusterLatestCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockStoreCreateClusterLatestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateClusterLatestCall) Do(f func(*admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockStoreCreateClusterLatestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateClusterLatestCall) DoAndReturn(f func(*admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockStoreCreateClusterLatestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateDatabaseRole mocks base method.
+func (m *MockStore) CreateDatabaseRole(arg0 string, arg1 *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(*admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseRole indicates an expected call of CreateDatabaseRole.
+func (mr *MockStoreMockRecorder) CreateDatabaseRole(arg0, arg1 any) *MockStoreCreateDatabaseRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseRole", reflect.TypeOf((*MockStore)(nil).CreateDatabaseRole), arg0, arg1)
+	return &MockStoreCreateDatabaseRoleCall{Call: call}
+}
+
+// MockStoreCreateDatabaseRoleCall wrap *gomock.Call
+type MockStoreCreateDatabaseRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateDatabaseRoleCall) Return(arg0 *admin.UserCustomDBRole, arg1 error) *MockStoreCreateDatabaseRoleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateDatabaseRoleCall) Do(f func(string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockStoreCreateDatabaseRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateDatabaseRoleCall) DoAndReturn(f func(string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockStoreCreateDatabaseRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateDatabaseUser mocks base method.
+func (m *MockStore) CreateDatabaseUser(arg0 *admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*admin.CloudDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabaseUser indicates an expected call of CreateDatabaseUser.
+func (mr *MockStoreMockRecorder) CreateDatabaseUser(arg0 any) *MockStoreCreateDatabaseUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabaseUser", reflect.TypeOf((*MockStore)(nil).CreateDatabaseUser), arg0)
+	return &MockStoreCreateDatabaseUserCall{Call: call}
+}
+
+// MockStoreCreateDatabaseUserCall wrap *gomock.Call
+type MockStoreCreateDatabaseUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateDatabaseUserCall) Return(arg0 *admin.CloudDatabaseUser, arg1 error) *MockStoreCreateDatabaseUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateDatabaseUserCall) Do(f func(*admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error)) *MockStoreCreateDatabaseUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateDatabaseUserCall) DoAndReturn(f func(*admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error)) *MockStoreCreateDatabaseUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateProjectIPAccessList mocks base method.
+func (m *MockStore) CreateProjectIPAccessList(arg0 []*admin.NetworkPermissionEntry) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectIPAccessList", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectIPAccessList indicates an expected call of CreateProjectIPAccessList.
+func (mr *MockStoreMockRecorder) CreateProjectIPAccessList(arg0 any) *MockStoreCreateProjectIPAccessListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectIPAccessList", reflect.TypeOf((*MockStore)(nil).CreateProjectIPAccessList), arg0)
+	return &MockStoreCreateProjectIPAccessListCall{Call: call}
+}
+
+// MockStoreCreateProjectIPAccessListCall wrap *gomock.Call
+type MockStoreCreateProjectIPAccessListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateProjectIPAccessListCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockStoreCreateProjectIPAccessListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateProjectIPAccessListCall) Do(f func([]*admin.NetworkPermissionEntry) (*admin.PaginatedNetworkAccess, error)) *MockStoreCreateProjectIPAccessListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateProjectIPAccessListCall) DoAndReturn(f func([]*admin.NetworkPermissionEntry) (*admin.PaginatedNetworkAccess, error)) *MockStoreCreateProjectIPAccessListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSearchIndexes mocks base method.
+func (m *MockStore) CreateSearchIndexes(arg0, arg1 string, arg2 *admin.SearchIndexCreateRequest) (*admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchIndexes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchIndexes indicates an expected call of CreateSearchIndexes.
+func (mr *MockStoreMockRecorder) CreateSearchIndexes(arg0, arg1, arg2 any) *MockStoreCreateSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchIndexes", reflect.TypeOf((*MockStore)(nil).CreateSearchIndexes), arg0, arg1, arg2)
+	return &MockStoreCreateSearchIndexesCall{Call: call}
+}
+
+// MockStoreCreateSearchIndexesCall wrap *gomock.Call
+type MockStoreCreateSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreCreateSearchIndexesCall) Return(arg0 *admin.SearchIndexResponse, arg1 error) *MockStoreCreateSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreCreateSearchIndexesCall) Do(f func(string, string, *admin.SearchIndexCreateRequest) (*admin.SearchIndexResponse, error)) *MockStoreCreateSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreCreateSearchIndexesCall) DoAndReturn(f func(string, string, *admin.SearchIndexCreateRequest) (*admin.SearchIndexResponse, error)) *MockStoreCreateSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseRoles mocks base method.
+func (m *MockStore) DatabaseRoles(arg0 string) ([]admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0)
+	ret0, _ := ret[0].([]admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles.
+func (mr *MockStoreMockRecorder) DatabaseRoles(arg0 any) *MockStoreDatabaseRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockStore)(nil).DatabaseRoles), arg0)
+	return &MockStoreDatabaseRolesCall{Call: call}
+}
+
+// MockStoreDatabaseRolesCall wrap *gomock.Call
+type MockStoreDatabaseRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDatabaseRolesCall) Return(arg0 []admin.UserCustomDBRole, arg1 error) *MockStoreDatabaseRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDatabaseRolesCall) Do(f func(string) ([]admin.UserCustomDBRole, error)) *MockStoreDatabaseRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDatabaseRolesCall) DoAndReturn(f func(string) ([]admin.UserCustomDBRole, error)) *MockStoreDatabaseRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseUsers mocks base method.
+func (m *MockStore) DatabaseUsers(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedApiAtlasDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers.
+func (mr *MockStoreMockRecorder) DatabaseUsers(arg0, arg1 any) *MockStoreDatabaseUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockStore)(nil).DatabaseUsers), arg0, arg1)
+	return &MockStoreDatabaseUsersCall{Call: call}
+}
+
+// MockStoreDatabaseUsersCall wrap *gomock.Call
+type MockStoreDatabaseUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDatabaseUsersCall) Return(arg0 *admin.PaginatedApiAtlasDatabaseUser, arg1 error) *MockStoreDatabaseUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDatabaseUsersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockStoreDatabaseUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDatabaseUsersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockStoreDatabaseUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAlertConfiguration mocks base method.
+func (m *MockStore) DeleteAlertConfiguration(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertConfiguration indicates an expected call of DeleteAlertConfiguration.
+func (mr *MockStoreMockRecorder) DeleteAlertConfiguration(arg0, arg1 any) *MockStoreDeleteAlertConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertConfiguration", reflect.TypeOf((*MockStore)(nil).DeleteAlertConfiguration), arg0, arg1)
+	return &MockStoreDeleteAlertConfigurationCall{Call: call}
+}
+
+// MockStoreDeleteAlertConfigurationCall wrap *gomock.Call
+type MockStoreDeleteAlertConfigurationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteAlertConfigurationCall) Return(arg0 error) *MockStoreDeleteAlertConfigurationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteAlertConfigurationCall) Do(f func(string, string) error) *MockStoreDeleteAlertConfigurationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteAlertConfigurationCall) DoAndReturn(f func(string, string) error) *MockStoreDeleteAlertConfigurationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCluster mocks base method.
+func (m *MockStore) DeleteCluster(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCluster", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCluster indicates an expected call of DeleteCluster.
+func (mr *MockStoreMockRecorder) DeleteCluster(arg0, arg1 any) *MockStoreDeleteClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockStore)(nil).DeleteCluster), arg0, arg1)
+	return &MockStoreDeleteClusterCall{Call: call}
+}
+
+// MockStoreDeleteClusterCall wrap *gomock.Call
+type MockStoreDeleteClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteClusterCall) Return(arg0 error) *MockStoreDeleteClusterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteClusterCall) Do(f func(string, string) error) *MockStoreDeleteClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteClusterCall) DoAndReturn(f func(string, string) error) *MockStoreDeleteClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteDatabaseRole mocks base method.
+func (m *MockStore) DeleteDatabaseRole(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseRole indicates an expected call of DeleteDatabaseRole.
+func (mr *MockStoreMockRecorder) DeleteDatabaseRole(arg0, arg1 any) *MockStoreDeleteDatabaseRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseRole", reflect.TypeOf((*MockStore)(nil).DeleteDatabaseRole), arg0, arg1)
+	return &MockStoreDeleteDatabaseRoleCall{Call: call}
+}
+
+// MockStoreDeleteDatabaseRoleCall wrap *gomock.Call
+type MockStoreDeleteDatabaseRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteDatabaseRoleCall) Return(arg0 error) *MockStoreDeleteDatabaseRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteDatabaseRoleCall) Do(f func(string, string) error) *MockStoreDeleteDatabaseRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteDatabaseRoleCall) DoAndReturn(f func(string, string) error) *MockStoreDeleteDatabaseRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteDatabaseUser mocks base method.
+func (m *MockStore) DeleteDatabaseUser(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseUser indicates an expected call of DeleteDatabaseUser.
+func (mr *MockStoreMockRecorder) DeleteDatabaseUser(arg0, arg1, arg2 any) *MockStoreDeleteDatabaseUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseUser", reflect.TypeOf((*MockStore)(nil).DeleteDatabaseUser), arg0, arg1, arg2)
+	return &MockStoreDeleteDatabaseUserCall{Call: call}
+}
+
+// MockStoreDeleteDatabaseUserCall wrap *gomock.Call
+type MockStoreDeleteDatabaseUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteDatabaseUserCall) Return(arg0 error) *MockStoreDeleteDatabaseUserCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteDatabaseUserCall) Do(f func(string, string, string) error) *MockStoreDeleteDatabaseUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteDatabaseUserCall) DoAndReturn(f func(string, string, string) error) *MockStoreDeleteDatabaseUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProjectIPAccessList mocks base method.
+func (m *MockStore) DeleteProjectIPAccessList(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectIPAccessList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectIPAccessList indicates an expected call of DeleteProjectIPAccessList.
+func (mr *MockStoreMockRecorder) DeleteProjectIPAccessList(arg0, arg1 any) *MockStoreDeleteProjectIPAccessListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectIPAccessList", reflect.TypeOf((*MockStore)(nil).DeleteProjectIPAccessList), arg0, arg1)
+	return &MockStoreDeleteProjectIPAccessListCall{Call: call}
+}
+
+// MockStoreDeleteProjectIPAccessListCall wrap *gomock.Call
+type MockStoreDeleteProjectIPAccessListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteProjectIPAccessListCall) Return(arg0 error) *MockStoreDeleteProjectIPAccessListCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteProjectIPAccessListCall) Do(f func(string, string) error) *MockStoreDeleteProjectIPAccessListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteProjectIPAccessListCall) DoAndReturn(f func(string, string) error) *MockStoreDeleteProjectIPAccessListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSearchIndex mocks base method.
+func (m *MockStore) DeleteSearchIndex(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSearchIndex", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSearchIndex indicates an expected call of DeleteSearchIndex.
+func (mr *MockStoreMockRecorder) DeleteSearchIndex(arg0, arg1, arg2 any) *MockStoreDeleteSearchIndexCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchIndex", reflect.TypeOf((*MockStore)(nil).DeleteSearchIndex), arg0, arg1, arg2)
+	return &MockStoreDeleteSearchIndexCall{Call: call}
+}
+
+// MockStoreDeleteSearchIndexCall wrap *gomock.Call
+type MockStoreDeleteSearchIndexCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreDeleteSearchIndexCall) Return(arg0 error) *MockStoreDeleteSearchIndexCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreDeleteSearchIndexCall) Do(f func(string, string, string) error) *MockStoreDeleteSearchIndexCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreDeleteSearchIndexCall) DoAndReturn(f func(string, string, string) error) *MockStoreDeleteSearchIndexCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestAtlasCluster mocks base method.
+func (m *MockStore) LatestAtlasCluster(arg0, arg1 string) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestAtlasCluster", arg0, arg1)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestAtlasCluster indicates an expected call of LatestAtlasCluster.
+func (mr *MockStoreMockRecorder) LatestAtlasCluster(arg0, arg1 any) *MockStoreLatestAtlasClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAtlasCluster", reflect.TypeOf((*MockStore)(nil).LatestAtlasCluster), arg0, arg1)
+	return &MockStoreLatestAtlasClusterCall{Call: call}
+}
+
+// MockStoreLatestAtlasClusterCall wrap *gomock.Call
+type MockStoreLatestAtlasClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreLatestAtlasClusterCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockStoreLatestAtlasClusterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreLatestAtlasClusterCall) Do(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockStoreLatestAtlasClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreLatestAtlasClusterCall) DoAndReturn(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockStoreLatestAtlasClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestProjectClusters mocks base method.
+func (m *MockStore) LatestProjectClusters(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestProjectClusters indicates an expected call of LatestProjectClusters.
+func (mr *MockStoreMockRecorder) LatestProjectClusters(arg0, arg1 any) *MockStoreLatestProjectClustersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestProjectClusters", reflect.TypeOf((*MockStore)(nil).LatestProjectClusters), arg0, arg1)
+	return &MockStoreLatestProjectClustersCall{Call: call}
+}
+
+// MockStoreLatestProjectClustersCall wrap *gomock.Call
+type MockStoreLatestProjectClustersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreLatestProjectClustersCall) Return(arg0 *admin.PaginatedClusterDescription20240805, arg1 error) *MockStoreLatestProjectClustersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreLatestProjectClustersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockStoreLatestProjectClustersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreLatestProjectClustersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockStoreLatestProjectClustersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectIPAccessLists mocks base method.
+func (m *MockStore) ProjectIPAccessLists(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists.
+func (mr *MockStoreMockRecorder) ProjectIPAccessLists(arg0, arg1 any) *MockStoreProjectIPAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockStore)(nil).ProjectIPAccessLists), arg0, arg1)
+	return &MockStoreProjectIPAccessListsCall{Call: call}
+}
+
+// MockStoreProjectIPAccessListsCall wrap *gomock.Call
+type MockStoreProjectIPAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreProjectIPAccessListsCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockStoreProjectIPAccessListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreProjectIPAccessListsCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockStoreProjectIPAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreProjectIPAccessListsCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockStoreProjectIPAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchIndexes mocks base method.
+func (m *MockStore) SearchIndexes(arg0, arg1, arg2, arg3 string) ([]admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIndexes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIndexes indicates an expected call of SearchIndexes.
+func (mr *MockStoreMockRecorder) SearchIndexes(arg0, arg1, arg2, arg3 any) *MockStoreSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIndexes", reflect.TypeOf((*MockStore)(nil).SearchIndexes), arg0, arg1, arg2, arg3)
+	return &MockStoreSearchIndexesCall{Call: call}
+}
+
+// MockStoreSearchIndexesCall wrap *gomock.Call
+type MockStoreSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreSearchIndexesCall) Return(arg0 []admin.SearchIndexResponse, arg1 error) *MockStoreSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreSearchIndexesCall) Do(f func(string, string, string, string) ([]admin.SearchIndexResponse, error)) *MockStoreSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreSearchIndexesCall) DoAndReturn(f func(string, string, string, string) ([]admin.SearchIndexResponse, error)) *MockStoreSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAlertConfiguration mocks base method.
+func (m *MockStore) UpdateAlertConfiguration(arg0 *admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertConfiguration", arg0)
+	ret0, _ := ret[0].(*admin.GroupAlertsConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlertConfiguration indicates an expected call of UpdateAlertConfiguration.
+func (mr *MockStoreMockRecorder) UpdateAlertConfiguration(arg0 any) *MockStoreUpdateAlertConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertConfiguration", reflect.TypeOf((*MockStore)(nil).UpdateAlertConfiguration), arg0)
+	return &MockStoreUpdateAlertConfigurationCall{Call: call}
+}
+
+// MockStoreUpdateAlertConfigurationCall wrap *gomock.Call
+type MockStoreUpdateAlertConfigurationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateAlertConfigurationCall) Return(arg0 *admin.GroupAlertsConfig, arg1 error) *MockStoreUpdateAlertConfigurationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateAlertConfigurationCall) Do(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockStoreUpdateAlertConfigurationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateAlertConfigurationCall) DoAndReturn(f func(*admin.GroupAlertsConfig) (*admin.GroupAlertsConfig, error)) *MockStoreUpdateAlertConfigurationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateClusterLatest mocks base method.
+func (m *MockStore) UpdateClusterLatest(arg0, arg1 string, arg2 *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterLatest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterLatest indicates an expected call of UpdateClusterLatest.
+func (mr *MockStoreMockRecorder) UpdateClusterLatest(arg0, arg1, arg2 any) *MockStoreUpdateClusterLatestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterLatest", reflect.TypeOf((*MockStore)(nil).UpdateClusterLatest), arg0, arg1, arg2)
+	return &MockStoreUpdateClusterLatestCall{Call: call}
+}
+
+// MockStoreUpdateClusterLatestCall wrap *gomock.Call
+type MockStoreUpdateClusterLatestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateClusterLatestCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockStoreUpdateClusterLatestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateClusterLatestCall) Do(f func(string, string, *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockStoreUpdateClusterLatestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateClusterLatestCall) DoAndReturn(f func(string, string, *admin.ClusterDescription20240805) (*admin.ClusterDescription20240805, error)) *MockStoreUpdateClusterLatestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDatabaseRole mocks base method.
+func (m *MockStore) UpdateDatabaseRole(arg0, arg1 string, arg2 *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseRole indicates an expected call of UpdateDatabaseRole.
+func (mr *MockStoreMockRecorder) UpdateDatabaseRole(arg0, arg1, arg2 any) *MockStoreUpdateDatabaseRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseRole", reflect.TypeOf((*MockStore)(nil).UpdateDatabaseRole), arg0, arg1, arg2)
+	return &MockStoreUpdateDatabaseRoleCall{Call: call}
+}
+
+// MockStoreUpdateDatabaseRoleCall wrap *gomock.Call
+type MockStoreUpdateDatabaseRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateDatabaseRoleCall) Return(arg0 *admin.UserCustomDBRole, arg1 error) *MockStoreUpdateDatabaseRoleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateDatabaseRoleCall) Do(f func(string, string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockStoreUpdateDatabaseRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateDatabaseRoleCall) DoAndReturn(f func(string, string, *admin.UserCustomDBRole) (*admin.UserCustomDBRole, error)) *MockStoreUpdateDatabaseRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDatabaseUser mocks base method.
+func (m *MockStore) UpdateDatabaseUser(arg0 *admin.UpdateDatabaseUserApiParams) (*admin.CloudDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatabaseUser", arg0)
+	ret0, _ := ret[0].(*admin.CloudDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatabaseUser indicates an expected call of UpdateDatabaseUser.
+func (mr *MockStoreMockRecorder) UpdateDatabaseUser(arg0 any) *MockStoreUpdateDatabaseUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabaseUser", reflect.TypeOf((*MockStore)(nil).UpdateDatabaseUser), arg0)
+	return &MockStoreUpdateDatabaseUserCall{Call: call}
+}
+
+// MockStoreUpdateDatabaseUserCall wrap *gomock.Call
+type MockStoreUpdateDatabaseUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateDatabaseUserCall) Return(arg0 *admin.CloudDatabaseUser, arg1 error) *MockStoreUpdateDatabaseUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateDatabaseUserCall) Do(f func(*admin.UpdateDatabaseUserApiParams) (*admin.CloudDatabaseUser, error)) *MockStoreUpdateDatabaseUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateDatabaseUserCall) DoAndReturn(f func(*admin.UpdateDatabaseUserApiParams) (*admin.CloudDatabaseUser, error)) *MockStoreUpdateDatabaseUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSearchIndexes mocks base method.
+func (m *MockStore) UpdateSearchIndexes(arg0, arg1, arg2 string, arg3 *admin.SearchIndexUpdateRequest) (*admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSearchIndexes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSearchIndexes indicates an expected call of UpdateSearchIndexes.
+func (mr *MockStoreMockRecorder) UpdateSearchIndexes(arg0, arg1, arg2, arg3 any) *MockStoreUpdateSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchIndexes", reflect.TypeOf((*MockStore)(nil).UpdateSearchIndexes), arg0, arg1, arg2, arg3)
+	return &MockStoreUpdateSearchIndexesCall{Call: call}
+}
+
+// MockStoreUpdateSearchIndexesCall wrap *gomock.Call
+type MockStoreUpdateSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStoreUpdateSearchIndexesCall) Return(arg0 *admin.SearchIndexResponse, arg1 error) *MockStoreUpdateSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStoreUpdateSearchIndexesCall) Do(f func(string, string, string, *admin.SearchIndexUpdateRequest) (*admin.SearchIndexResponse, error)) *MockStoreUpdateSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStoreUpdateSearchIndexesCall) DoAndReturn(f func(string, string, string, *admin.SearchIndexUpdateRequest) (*admin.SearchIndexResponse, error)) *MockStoreUpdateSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+func TestApplyOpts_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockStore(ctrl)
+
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	buf := new(bytes.Buffer)
+	opts := &ApplyOpts{
+		ProjectOpts: cli.ProjectOpts{ProjectID: projectID},
+		WatchOpts: cli.WatchOpts{
+			OutputOpts: cli.OutputOpts{
+				Template:  applyTemplate,
+				OutWriter: buf,
+			},
+		},
+		manifestOpts: manifestOpts{
+			manifest: &Manifest{
+				Clusters: []Resource{{"name": "Cluster0", "clusterType": "REPLICASET"}},
+				SearchIndexes: []Resource{
+					{"clusterName": "Cluster0", "database": "db", "collectionName": "coll", "name": "default"},
+				},
+			},
+		},
+		confirm:        true,
+		progressWriter: io.Discard,
+		store:          mockStore,
+	}
+
+	gomock.InOrder(
+		mockStore.
+			EXPECT().
+			LatestProjectClusters(projectID, gomock.Any()).
+			Return(&atlasv2.PaginatedClusterDescription20240805{
+				Results: &[]atlasv2.ClusterDescription20240805{{Name: pointer.Get("Cluster1")}},
+			}, nil).
+			Times(1),
+		mockStore.
+			EXPECT().
+			CreateClusterLatest(&atlasv2.ClusterDescription20240805{
+				GroupId:     pointer.Get(projectID),
+				Name:        pointer.Get("Cluster0"),
+				ClusterType: pointer.Get("REPLICASET"),
+			}).
+			Return(&atlasv2.ClusterDescription20240805{}, nil).
+			Times(1),
+		// the search index is created once the cluster is available
+		mockStore.
+			EXPECT().
+			LatestAtlasCluster(projectID, "Cluster0").
+			Return(&atlasv2.ClusterDescription20240805{StateName: pointer.Get(idle)}, nil).
+			Times(1),
+		mockStore.
+			EXPECT().
+			CreateSearchIndexes(projectID, "Cluster0", &atlasv2.SearchIndexCreateRequest{
+				Database:       "db",
+				CollectionName: "coll",
+				Name:           "default",
+			}).
+			Return(&atlasv2.SearchIndexResponse{}, nil).
+			Times(1),
+		mockStore.
+			EXPECT().
+			DeleteCluster(projectID, "Cluster1").
+			Return(nil).
+			Times(1),
+	)
+
+	require.NoError(t, opts.Run(t.Context()))
+	assert.Equal(t, "Applied 3 change(s) to project '5e2211c17a3e5a48f5497de3'.\n", buf.String())
+}
+
+func TestApplyOpts_printPlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockStore(ctrl)
+
+	progress := new(bytes.Buffer)
+	opts := &ApplyOpts{
+		ProjectOpts: cli.ProjectOpts{ProjectID: "5e2211c17a3e5a48f5497de3"},
+		manifestOpts: manifestOpts{
+			manifest: &Manifest{Clusters: []Resource{}},
+		},
+		progressWriter: progress,
+		store:          mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		LatestProjectClusters(opts.ProjectID, gomock.Any()).
+		Return(&atlasv2.PaginatedClusterDescription20240805{
+			Results: &[]atlasv2.ClusterDescription20240805{{Name: pointer.Get("Cluster0")}},
+		}, nil).
+		Times(1)
+
+	plan, err := opts.plan(mockStore, opts.ProjectID)
+	require.NoError(t, err)
+	require.NoError(t, opts.printPlan(plan))
+	assert.Contains(t, progress.String(), "delete   cluster   Cluster0")
+}
+
+func TestApplyOpts_warnAlertConfigurationDeletions(t *testing.T) {
+	progress := new(bytes.Buffer)
+	opts := &ApplyOpts{progressWriter: progress}
+
+	p := &Plan{
+		ProjectID: "5e2211c17a3e5a48f5497de3",
+		Changes: []Change{
+			{Action: DeleteAction, Kind: AlertConfigurationKind, Name: "5e2211c17a3e5a48f5497de4"},
+			{Action: DeleteAction, Kind: AlertConfigurationKind, Name: "5e2211c17a3e5a48f5497de5"},
+			{Action: DeleteAction, Kind: ClusterKind, Name: "Cluster0"},
+		},
+	}
+	require.NoError(t, opts.warnAlertConfigurationDeletions(p))
+	assert.Contains(t, progress.String(), "Warning: 2 alert configuration(s) of project '5e2211c17a3e5a48f5497de3' are not in the manifest")
+
+	progress.Reset()
+	p.Changes = []Change{{Action: UpdateAction, Kind: AlertConfigurationKind, Name: "5e2211c17a3e5a48f5497de4"}}
+	require.NoError(t, opts.warnAlertConfigurationDeletions(p))
+	assert.Empty(t, progress.String())
+}
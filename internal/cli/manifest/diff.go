@@ -0,0 +1,135 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const diffTemplate = `{{if .Changes}}ACTION	KIND	NAME	FIELDS{{range .Changes}}
+{{.Action}}	{{.Kind}}	{{.Name}}	{{range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f}}{{end}}{{end}}
+{{else}}No changes. The project matches the manifest.
+{{end}}`
+
+// manifestOpts holds the manifest shared by the diff and apply commands.
+type manifestOpts struct {
+	filename string
+	manifest *Manifest
+	fs       afero.Fs
+}
+
+// loadManifest reads the manifest and uses its projectId unless one was provided with --projectId.
+func (opts *manifestOpts) loadManifest(projectOpts *cli.ProjectOpts) func() error {
+	return func() error {
+		m, err := Load(opts.fs, opts.filename)
+		if err != nil {
+			return err
+		}
+		opts.manifest = m
+
+		if projectOpts.ProjectID == "" {
+			projectOpts.ProjectID = m.ProjectID
+		}
+
+		return nil
+	}
+}
+
+func (opts *manifestOpts) plan(s Lister, projectID string) (*Plan, error) {
+	live, err := FetchLiveState(s, projectID, opts.manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlan(projectID, opts.manifest, live)
+}
+
+type DiffOpts struct {
+	cli.ProjectOpts
+	cli.OutputOpts
+	manifestOpts
+	store Lister
+}
+
+func (opts *DiffOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *DiffOpts) Run() error {
+	p, err := opts.plan(opts.store, opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+
+	return opts.Print(p)
+}
+
+// atlas diff --file manifest.yaml [--projectId projectId].
+func DiffBuilder() *cobra.Command {
+	opts := &DiffOpts{
+		manifestOpts: manifestOpts{fs: afero.NewOsFs()},
+	}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare the resources of your project with a manifest file.",
+		Long: `The manifest describes clusters, database users, IP access list entries, custom database roles, search indexes and alert configurations.
+Only the resource kinds present in the manifest are compared, and only the fields set in the manifest are taken into account.
+Clusters, database users, IP access list entries, custom database roles and alert configurations that exist in the project but not in the manifest are reported as deletions,
+including the default alert configurations of the project.
+Search indexes are only compared for the collections the manifest references, the search indexes of other collections are not reported.
+
+` + fmt.Sprintf(usage.RequiredRole, "Project Read Only"),
+		Args: require.NoArgs,
+		Example: `  # Show the changes needed to make the project match the manifest.yaml file:
+  atlas diff --file manifest.yaml --projectId 5e2211c17a3e5a48f5497de3`,
+		Annotations: map[string]string{
+			"output": diffTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.loadManifest(&opts.ProjectOpts),
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), diffTemplate),
+			)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.filename, flag.File, flag.FileShort, "", usage.ManifestFilename)
+	_ = cmd.MarkFlagFilename(flag.File)
+	_ = cmd.MarkFlagRequired(flag.File)
+
+	opts.AddProjectOptsFlags(cmd)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffOpts_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockLister(ctrl)
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "manifest.yaml", []byte(testManifest), 0600))
+
+	buf := new(bytes.Buffer)
+	opts := &DiffOpts{
+		OutputOpts: cli.OutputOpts{
+			Template:  diffTemplate,
+			OutWriter: buf,
+		},
+		manifestOpts: manifestOpts{
+			filename: "manifest.yaml",
+			fs:       fs,
+		},
+		store: mockStore,
+	}
+	require.NoError(t, opts.loadManifest(&opts.ProjectOpts)())
+	assert.Equal(t, "5e2211c17a3e5a48f5497de3", opts.ProjectID)
+
+	mockStore.
+		EXPECT().
+		LatestProjectClusters(opts.ProjectID, gomock.Any()).
+		Return(&atlasv2.PaginatedClusterDescription20240805{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DatabaseUsers(opts.ProjectID, gomock.Any()).
+		Return(&atlasv2.PaginatedApiAtlasDatabaseUser{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(opts.ProjectID, gomock.Any()).
+		Return(&atlasv2.PaginatedNetworkAccess{
+			Results: &[]atlasv2.NetworkPermissionEntry{
+				{CidrBlock: pointer.Get("10.0.0.0/8")},
+			},
+		}, nil).
+		Times(1)
+
+	require.NoError(t, opts.Run())
+	assert.Equal(t, "ACTION   KIND           NAME         FIELDS\n"+
+		"create   databaseUser   admin/app    \n"+
+		"delete   accessList     10.0.0.0/8   \n", buf.String())
+}
+
+func TestDiffOpts_Run_NoChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockLister(ctrl)
+
+	buf := new(bytes.Buffer)
+	opts := &DiffOpts{
+		ProjectOpts: cli.ProjectOpts{ProjectID: "5e2211c17a3e5a48f5497de3"},
+		OutputOpts: cli.OutputOpts{
+			Template:  diffTemplate,
+			OutWriter: buf,
+		},
+		manifestOpts: manifestOpts{
+			manifest: &Manifest{},
+		},
+		store: mockStore,
+	}
+
+	mockStore.
+		EXPECT().
+		LatestProjectClusters(opts.ProjectID, gomock.Any()).
+		Return(&atlasv2.PaginatedClusterDescription20240805{
+			Results: &[]atlasv2.ClusterDescription20240805{{Name: pointer.Get("Cluster0")}},
+		}, nil).
+		Times(1)
+
+	require.NoError(t, opts.Run())
+	assert.Equal(t, "No changes. The project matches the manifest.\n", buf.String())
+}
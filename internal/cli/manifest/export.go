@@ -0,0 +1,115 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=export_mock_test.go -package=manifest -source=export.go
+
+type ClusterSearchIndexLister interface {
+	ClusterSearchIndexes(string, string) ([]atlasv2.SearchIndexResponse, error)
+}
+
+type Exporter interface {
+	ResourceLister
+	ClusterSearchIndexLister
+}
+
+// Export builds a manifest with all the resources of a project.
+// Fields computed by Atlas are left out so the manifest can be applied to another project.
+func Export(s Exporter, projectID string) (*Manifest, error) {
+	all := &Manifest{
+		CustomDBRoles:       []Resource{},
+		DatabaseUsers:       []Resource{},
+		AccessList:          []Resource{},
+		AlertConfigurations: []Resource{},
+	}
+
+	live, err := fetchResources(s, projectID, all)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range live[ClusterKind] {
+		clusterName := c.String("name")
+		indexes, err := s.ClusterSearchIndexes(projectID, clusterName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, index := range indexes {
+			r, err := normalize(index)
+			if err != nil {
+				return nil, err
+			}
+			// the cluster is part of the request path and not returned by the API
+			r["clusterName"] = clusterName
+			live[SearchIndexKind] = append(live[SearchIndexKind], r)
+		}
+	}
+
+	return &Manifest{
+		ProjectID:           projectID,
+		CustomDBRoles:       exportAll(CustomDBRoleKind, live),
+		DatabaseUsers:       exportAll(DatabaseUserKind, live),
+		AccessList:          exportAll(AccessListKind, live),
+		Clusters:            exportAll(ClusterKind, live),
+		SearchIndexes:       exportAll(SearchIndexKind, live),
+		AlertConfigurations: exportAll(AlertConfigurationKind, live),
+	}, nil
+}
+
+// exportAll never returns nil so resources missing from the project are deleted when applying the manifest.
+func exportAll(kind string, live LiveState) []Resource {
+	resources := []Resource{}
+	for _, k := range kinds {
+		if k.Name != kind {
+			continue
+		}
+		for _, r := range live[kind] {
+			resources = append(resources, k.export(r))
+		}
+	}
+
+	return resources
+}
+
+// export returns a copy of a live resource without the read only fields, using the manifest field names.
+func (k *Kind) export(live Resource) Resource {
+	r := make(Resource, len(live))
+	for field, value := range live {
+		r[field] = value
+	}
+
+	for _, field := range k.ReadOnly {
+		delete(r, field)
+	}
+
+	for field, liveField := range k.LiveFields {
+		if value, ok := r[liveField]; ok {
+			r[field] = value
+			delete(r, liveField)
+		}
+	}
+
+	// the API returns the cidrBlock of single IP entries, only one of them can be sent back
+	if k.Name == AccessListKind && r.String("ipAddress") != "" {
+		delete(r, "cidrBlock")
+	}
+
+	return r
+}
@@ -0,0 +1,339 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=export_mock_test.go -package=manifest -source=export.go
+//
+
+// Package manifest is a generated GoMock package.
+package manifest
+
+import (
+	reflect "reflect"
+
+	store "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	admin "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClusterSearchIndexLister is a mock of ClusterSearchIndexLister interface.
+type MockClusterSearchIndexLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterSearchIndexListerMockRecorder
+	isgomock struct{}
+}
+
+// MockClusterSearchIndexListerMockRecorder is the mock recorder for MockClusterSearchIndexLister.
+type MockClusterSearchIndexListerMockRecorder struct {
+	mock *MockClusterSearchIndexLister
+}
+
+// NewMockClusterSearchIndexLister creates a new mock instance.
+func NewMockClusterSearchIndexLister(ctrl *gomock.Controller) *MockClusterSearchIndexLister {
+	mock := &MockClusterSearchIndexLister{ctrl: ctrl}
+	mock.recorder = &MockClusterSearchIndexListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterSearchIndexLister) EXPECT() *MockClusterSearchIndexListerMockRecorder {
+	return m.recorder
+}
+
+// ClusterSearchIndexes mocks base method.
+func (m *MockClusterSearchIndexLister) ClusterSearchIndexes(arg0, arg1 string) ([]admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterSearchIndexes", arg0, arg1)
+	ret0, _ := ret[0].([]admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterSearchIndexes indicates an expected call of ClusterSearchIndexes.
+func (mr *MockClusterSearchIndexListerMockRecorder) ClusterSearchIndexes(arg0, arg1 any) *MockClusterSearchIndexListerClusterSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterSearchIndexes", reflect.TypeOf((*MockClusterSearchIndexLister)(nil).ClusterSearchIndexes), arg0, arg1)
+	return &MockClusterSearchIndexListerClusterSearchIndexesCall{Call: call}
+}
+
+// MockClusterSearchIndexListerClusterSearchIndexesCall wrap *gomock.Call
+type MockClusterSearchIndexListerClusterSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterSearchIndexListerClusterSearchIndexesCall) Return(arg0 []admin.SearchIndexResponse, arg1 error) *MockClusterSearchIndexListerClusterSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterSearchIndexListerClusterSearchIndexesCall) Do(f func(string, string) ([]admin.SearchIndexResponse, error)) *MockClusterSearchIndexListerClusterSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterSearchIndexListerClusterSearchIndexesCall) DoAndReturn(f func(string, string) ([]admin.SearchIndexResponse, error)) *MockClusterSearchIndexListerClusterSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockExporter is a mock of Exporter interface.
+type MockExporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockExporterMockRecorder
+	isgomock struct{}
+}
+
+// MockExporterMockRecorder is the mock recorder for MockExporter.
+type MockExporterMockRecorder struct {
+	mock *MockExporter
+}
+
+// NewMockExporter creates a new mock instance.
+func NewMockExporter(ctrl *gomock.Controller) *MockExporter {
+	mock := &MockExporter{ctrl: ctrl}
+	mock.recorder = &MockExporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExporter) EXPECT() *MockExporterMockRecorder {
+	return m.recorder
+}
+
+// AlertConfigurations mocks base method.
+func (m *MockExporter) AlertConfigurations(arg0 *admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigurations", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedAlertConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertConfigurations indicates an expected call of AlertConfigurations.
+func (mr *MockExporterMockRecorder) AlertConfigurations(arg0 any) *MockExporterAlertConfigurationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockExporter)(nil).AlertConfigurations), arg0)
+	return &MockExporterAlertConfigurationsCall{Call: call}
+}
+
+// MockExporterAlertConfigurationsCall wrap *gomock.Call
+type MockExporterAlertConfigurationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterAlertConfigurationsCall) Return(arg0 *admin.PaginatedAlertConfig, arg1 error) *MockExporterAlertConfigurationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterAlertConfigurationsCall) Do(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockExporterAlertConfigurationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterAlertConfigurationsCall) DoAndReturn(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockExporterAlertConfigurationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClusterSearchIndexes mocks base method.
+func (m *MockExporter) ClusterSearchIndexes(arg0, arg1 string) ([]admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterSearchIndexes", arg0, arg1)
+	ret0, _ := ret[0].([]admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterSearchIndexes indicates an expected call of ClusterSearchIndexes.
+func (mr *MockExporterMockRecorder) ClusterSearchIndexes(arg0, arg1 any) *MockExporterClusterSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterSearchIndexes", reflect.TypeOf((*MockExporter)(nil).ClusterSearchIndexes), arg0, arg1)
+	return &MockExporterClusterSearchIndexesCall{Call: call}
+}
+
+// MockExporterClusterSearchIndexesCall wrap *gomock.Call
+type MockExporterClusterSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterClusterSearchIndexesCall) Return(arg0 []admin.SearchIndexResponse, arg1 error) *MockExporterClusterSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterClusterSearchIndexesCall) Do(f func(string, string) ([]admin.SearchIndexResponse, error)) *MockExporterClusterSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterClusterSearchIndexesCall) DoAndReturn(f func(string, string) ([]admin.SearchIndexResponse, error)) *MockExporterClusterSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseRoles mocks base method.
+func (m *MockExporter) DatabaseRoles(arg0 string) ([]admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0)
+	ret0, _ := ret[0].([]admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles.
+func (mr *MockExporterMockRecorder) DatabaseRoles(arg0 any) *MockExporterDatabaseRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockExporter)(nil).DatabaseRoles), arg0)
+	return &MockExporterDatabaseRolesCall{Call: call}
+}
+
+// MockExporterDatabaseRolesCall wrap *gomock.Call
+type MockExporterDatabaseRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterDatabaseRolesCall) Return(arg0 []admin.UserCustomDBRole, arg1 error) *MockExporterDatabaseRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterDatabaseRolesCall) Do(f func(string) ([]admin.UserCustomDBRole, error)) *MockExporterDatabaseRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterDatabaseRolesCall) DoAndReturn(f func(string) ([]admin.UserCustomDBRole, error)) *MockExporterDatabaseRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseUsers mocks base method.
+func (m *MockExporter) DatabaseUsers(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedApiAtlasDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers.
+func (mr *MockExporterMockRecorder) DatabaseUsers(arg0, arg1 any) *MockExporterDatabaseUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockExporter)(nil).DatabaseUsers), arg0, arg1)
+	return &MockExporterDatabaseUsersCall{Call: call}
+}
+
+// MockExporterDatabaseUsersCall wrap *gomock.Call
+type MockExporterDatabaseUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterDatabaseUsersCall) Return(arg0 *admin.PaginatedApiAtlasDatabaseUser, arg1 error) *MockExporterDatabaseUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterDatabaseUsersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockExporterDatabaseUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterDatabaseUsersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockExporterDatabaseUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestProjectClusters mocks base method.
+func (m *MockExporter) LatestProjectClusters(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestProjectClusters indicates an expected call of LatestProjectClusters.
+func (mr *MockExporterMockRecorder) LatestProjectClusters(arg0, arg1 any) *MockExporterLatestProjectClustersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestProjectClusters", reflect.TypeOf((*MockExporter)(nil).LatestProjectClusters), arg0, arg1)
+	return &MockExporterLatestProjectClustersCall{Call: call}
+}
+
+// MockExporterLatestProjectClustersCall wrap *gomock.Call
+type MockExporterLatestProjectClustersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterLatestProjectClustersCall) Return(arg0 *admin.PaginatedClusterDescription20240805, arg1 error) *MockExporterLatestProjectClustersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterLatestProjectClustersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockExporterLatestProjectClustersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterLatestProjectClustersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockExporterLatestProjectClustersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectIPAccessLists mocks base method.
+func (m *MockExporter) ProjectIPAccessLists(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists.
+func (mr *MockExporterMockRecorder) ProjectIPAccessLists(arg0, arg1 any) *MockExporterProjectIPAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockExporter)(nil).ProjectIPAccessLists), arg0, arg1)
+	return &MockExporterProjectIPAccessListsCall{Call: call}
+}
+
+// MockExporterProjectIPAccessListsCall wrap *gomock.Call
+type MockExporterProjectIPAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExporterProjectIPAccessListsCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockExporterProjectIPAccessListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExporterProjectIPAccessListsCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockExporterProjectIPAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExporterProjectIPAccessListsCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockExporterProjectIPAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/file"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockExporter(ctrl)
+
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	mockStore.
+		EXPECT().
+		LatestProjectClusters(projectID, gomock.Any()).
+		Return(&atlasv2.PaginatedClusterDescription20240805{
+			Results: &[]atlasv2.ClusterDescription20240805{
+				{Id: pointer.Get("1"), Name: pointer.Get("Cluster0"), StateName: pointer.Get(idle), ClusterType: pointer.Get("REPLICASET")},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DatabaseUsers(projectID, gomock.Any()).
+		Return(&atlasv2.PaginatedApiAtlasDatabaseUser{
+			Results: &[]atlasv2.CloudDatabaseUser{
+				{GroupId: projectID, DatabaseName: "admin", Username: "app"},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(projectID, gomock.Any()).
+		Return(&atlasv2.PaginatedNetworkAccess{
+			Results: &[]atlasv2.NetworkPermissionEntry{
+				{GroupId: pointer.Get(projectID), IpAddress: pointer.Get("10.0.0.1"), CidrBlock: pointer.Get("10.0.0.1/32")},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DatabaseRoles(projectID).
+		Return(nil, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		AlertConfigurations(gomock.Any()).
+		Return(&atlasv2.PaginatedAlertConfig{
+			Results: &[]atlasv2.GroupAlertsConfig{
+				{Id: pointer.Get("2"), GroupId: pointer.Get(projectID), EventTypeName: pointer.Get("NO_PRIMARY"), Enabled: pointer.Get(true)},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ClusterSearchIndexes(projectID, "Cluster0").
+		Return([]atlasv2.SearchIndexResponse{
+			{
+				IndexID:          pointer.Get("3"),
+				Name:             pointer.Get("default"),
+				Database:         pointer.Get("db"),
+				CollectionName:   pointer.Get("coll"),
+				Status:           pointer.Get("READY"),
+				LatestDefinition: &atlasv2.BaseSearchIndexResponseLatestDefinition{Analyzer: pointer.Get("lucene.standard")},
+			},
+		}, nil).
+		Times(1)
+
+	m, err := Export(mockStore, projectID)
+	require.NoError(t, err)
+
+	assert.Equal(t, &Manifest{
+		ProjectID:     projectID,
+		CustomDBRoles: []Resource{},
+		DatabaseUsers: []Resource{{"databaseName": "admin", "username": "app"}},
+		AccessList:    []Resource{{"ipAddress": "10.0.0.1"}},
+		Clusters:      []Resource{{"name": "Cluster0", "clusterType": "REPLICASET"}},
+		SearchIndexes: []Resource{
+			{"clusterName": "Cluster0", "name": "default", "database": "db", "collectionName": "coll", "definition": map[string]any{"analyzer": "lucene.standard"}},
+		},
+		AlertConfigurations: []Resource{{"eventTypeName": "NO_PRIMARY", "enabled": true}},
+	}, m)
+
+	// applying the export to the same project is a no-op
+	p, err := NewPlan(projectID, m, LiveState{
+		ClusterKind:            {{"id": "1", "name": "Cluster0", "clusterType": "REPLICASET", "stateName": idle}},
+		DatabaseUserKind:       {{"groupId": projectID, "databaseName": "admin", "username": "app"}},
+		AccessListKind:         {{"ipAddress": "10.0.0.1", "cidrBlock": "10.0.0.1/32"}},
+		AlertConfigurationKind: {{"id": "2", "eventTypeName": "NO_PRIMARY", "enabled": true}},
+		SearchIndexKind: {
+			{"clusterName": "Cluster0", "name": "default", "database": "db", "collectionName": "coll", "indexID": "3", "latestDefinition": map[string]any{"analyzer": "lucene.standard"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, p.HasChanges())
+
+	// kinds without resources are still managed once the export is saved and loaded again
+	for _, filename := range []string{"manifest.yaml", "manifest.json"} {
+		t.Run(filename, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, file.Save(fs, filename, m))
+
+			loaded, err := Load(fs, filename)
+			require.NoError(t, err)
+			assert.NotNil(t, loaded.CustomDBRoles)
+			assert.Equal(t, m, loaded)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=live_mock_test.go -package=manifest -source=live.go
+
+const maxItemsPerPage = 500
+
+type ClusterLister interface {
+	LatestProjectClusters(string, *store.ListOptions) (*atlasv2.PaginatedClusterDescription20240805, error)
+}
+
+type DatabaseUserLister interface {
+	DatabaseUsers(string, *store.ListOptions) (*atlasv2.PaginatedApiAtlasDatabaseUser, error)
+}
+
+type ProjectIPAccessListLister interface {
+	ProjectIPAccessLists(string, *store.ListOptions) (*atlasv2.PaginatedNetworkAccess, error)
+}
+
+type DatabaseRoleLister interface {
+	DatabaseRoles(string) ([]atlasv2.UserCustomDBRole, error)
+}
+
+type AlertConfigurationLister interface {
+	AlertConfigurations(*atlasv2.ListAlertConfigsApiParams) (*atlasv2.PaginatedAlertConfig, error)
+}
+
+type SearchIndexLister interface {
+	SearchIndexes(string, string, string, string) ([]atlasv2.SearchIndexResponse, error)
+}
+
+type ResourceLister interface {
+	ClusterLister
+	DatabaseUserLister
+	ProjectIPAccessListLister
+	DatabaseRoleLister
+	AlertConfigurationLister
+}
+
+type Lister interface {
+	ResourceLister
+	SearchIndexLister
+}
+
+// listAll keeps requesting pages until a page returns less items than requested.
+func listAll[T any](list func(*store.ListOptions) ([]T, error)) ([]Resource, error) {
+	var resources []Resource
+	for page := 1; ; page++ {
+		items, err := list(&store.ListOptions{PageNum: page, ItemsPerPage: maxItemsPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			r, err := normalize(item)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, r)
+		}
+
+		if len(items) < maxItemsPerPage {
+			return resources, nil
+		}
+	}
+}
+
+// FetchLiveState lists the current resources of all the kinds managed by the manifest.
+func FetchLiveState(s Lister, projectID string, m *Manifest) (LiveState, error) {
+	live, err := fetchResources(s, projectID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.SearchIndexes != nil {
+		if live[SearchIndexKind], err = fetchSearchIndexes(s, projectID, m.SearchIndexes, live[ClusterKind]); err != nil {
+			return nil, err
+		}
+	}
+
+	return live, nil
+}
+
+// fetchResources lists the clusters and the resources of the other kinds managed by the manifest, except search indexes.
+func fetchResources(s ResourceLister, projectID string, m *Manifest) (LiveState, error) {
+	live := LiveState{}
+
+	clusters, err := listAll(func(opts *store.ListOptions) ([]atlasv2.ClusterDescription20240805, error) {
+		r, err := s.LatestProjectClusters(projectID, opts)
+		return r.GetResults(), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	live[ClusterKind] = clusters
+
+	if m.DatabaseUsers != nil {
+		if live[DatabaseUserKind], err = listAll(func(opts *store.ListOptions) ([]atlasv2.CloudDatabaseUser, error) {
+			r, err := s.DatabaseUsers(projectID, opts)
+			return r.GetResults(), err
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.AccessList != nil {
+		if live[AccessListKind], err = listAll(func(opts *store.ListOptions) ([]atlasv2.NetworkPermissionEntry, error) {
+			r, err := s.ProjectIPAccessLists(projectID, opts)
+			return r.GetResults(), err
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.CustomDBRoles != nil {
+		roles, err := s.DatabaseRoles(projectID)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			r, err := normalize(role)
+			if err != nil {
+				return nil, err
+			}
+			live[CustomDBRoleKind] = append(live[CustomDBRoleKind], r)
+		}
+	}
+
+	if m.AlertConfigurations != nil {
+		if live[AlertConfigurationKind], err = listAll(func(opts *store.ListOptions) ([]atlasv2.GroupAlertsConfig, error) {
+			r, err := s.AlertConfigurations(&atlasv2.ListAlertConfigsApiParams{
+				GroupId:      projectID,
+				PageNum:      pointer.Get(opts.PageNum),
+				ItemsPerPage: pointer.Get(opts.ItemsPerPage),
+			})
+			return r.GetResults(), err
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return live, nil
+}
+
+// fetchSearchIndexes lists the search indexes of every collection referenced in the manifest.
+// Collections of clusters that don't exist yet are skipped.
+func fetchSearchIndexes(s SearchIndexLister, projectID string, desired []Resource, clusters []Resource) ([]Resource, error) {
+	existingClusters := map[string]bool{}
+	for _, c := range clusters {
+		existingClusters[c.String("name")] = true
+	}
+
+	var indexes []Resource
+	visited := map[[3]string]bool{}
+	for _, d := range desired {
+		scope := [3]string{d.String("clusterName"), d.String("database"), d.String("collectionName")}
+		if visited[scope] || !existingClusters[scope[0]] {
+			continue
+		}
+		visited[scope] = true
+
+		r, err := s.SearchIndexes(projectID, scope[0], scope[1], scope[2])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, index := range r {
+			resource, err := normalize(index)
+			if err != nil {
+				return nil, err
+			}
+			// the cluster is part of the request path and not returned by the API
+			resource["clusterName"] = scope[0]
+			indexes = append(indexes, resource)
+		}
+	}
+
+	return indexes, nil
+}
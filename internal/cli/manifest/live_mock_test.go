@@ -0,0 +1,873 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: live.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=live_mock_test.go -package=manifest -source=live.go
+//
+
+// Package manifest is a generated GoMock package.
+package manifest
+
+import (
+	reflect "reflect"
+
+	store "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	admin "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClusterLister is a mock of ClusterLister interface.
+type MockClusterLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterListerMockRecorder
+	isgomock struct{}
+}
+
+// MockClusterListerMockRecorder is the mock recorder for MockClusterLister.
+type MockClusterListerMockRecorder struct {
+	mock *MockClusterLister
+}
+
+// NewMockClusterLister creates a new mock instance.
+func NewMockClusterLister(ctrl *gomock.Controller) *MockClusterLister {
+	mock := &MockClusterLister{ctrl: ctrl}
+	mock.recorder = &MockClusterListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterLister) EXPECT() *MockClusterListerMockRecorder {
+	return m.recorder
+}
+
+// LatestProjectClusters mocks base method.
+func (m *MockClusterLister) LatestProjectClusters(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestProjectClusters indicates an expected call of LatestProjectClusters.
+func (mr *MockClusterListerMockRecorder) LatestProjectClusters(arg0, arg1 any) *MockClusterListerLatestProjectClustersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestProjectClusters", reflect.TypeOf((*MockClusterLister)(nil).LatestProjectClusters), arg0, arg1)
+	return &MockClusterListerLatestProjectClustersCall{Call: call}
+}
+
+// MockClusterListerLatestProjectClustersCall wrap *gomock.Call
+type MockClusterListerLatestProjectClustersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterListerLatestProjectClustersCall) Return(arg0 *admin.PaginatedClusterDescription20240805, arg1 error) *MockClusterListerLatestProjectClustersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterListerLatestProjectClustersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockClusterListerLatestProjectClustersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterListerLatestProjectClustersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockClusterListerLatestProjectClustersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDatabaseUserLister is a mock of DatabaseUserLister interface.
+type MockDatabaseUserLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseUserListerMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseUserListerMockRecorder is the mock recorder for MockDatabaseUserLister.
+type MockDatabaseUserListerMockRecorder struct {
+	mock *MockDatabaseUserLister
+}
+
+// NewMockDatabaseUserLister creates a new mock instance.
+func NewMockDatabaseUserLister(ctrl *gomock.Controller) *MockDatabaseUserLister {
+	mock := &MockDatabaseUserLister{ctrl: ctrl}
+	mock.recorder = &MockDatabaseUserListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseUserLister) EXPECT() *MockDatabaseUserListerMockRecorder {
+	return m.recorder
+}
+
+// DatabaseUsers mocks base method.
+func (m *MockDatabaseUserLister) DatabaseUsers(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedApiAtlasDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers.
+func (mr *MockDatabaseUserListerMockRecorder) DatabaseUsers(arg0, arg1 any) *MockDatabaseUserListerDatabaseUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockDatabaseUserLister)(nil).DatabaseUsers), arg0, arg1)
+	return &MockDatabaseUserListerDatabaseUsersCall{Call: call}
+}
+
+// MockDatabaseUserListerDatabaseUsersCall wrap *gomock.Call
+type MockDatabaseUserListerDatabaseUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseUserListerDatabaseUsersCall) Return(arg0 *admin.PaginatedApiAtlasDatabaseUser, arg1 error) *MockDatabaseUserListerDatabaseUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseUserListerDatabaseUsersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockDatabaseUserListerDatabaseUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseUserListerDatabaseUsersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockDatabaseUserListerDatabaseUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockProjectIPAccessListLister is a mock of ProjectIPAccessListLister interface.
+type MockProjectIPAccessListLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectIPAccessListListerMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectIPAccessListListerMockRecorder is the mock recorder for MockProjectIPAccessListLister.
+type MockProjectIPAccessListListerMockRecorder struct {
+	mock *MockProjectIPAccessListLister
+}
+
+// NewMockProjectIPAccessListLister creates a new mock instance.
+func NewMockProjectIPAccessListLister(ctrl *gomock.Controller) *MockProjectIPAccessListLister {
+	mock := &MockProjectIPAccessListLister{ctrl: ctrl}
+	mock.recorder = &MockProjectIPAccessListListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectIPAccessListLister) EXPECT() *MockProjectIPAccessListListerMockRecorder {
+	return m.recorder
+}
+
+// ProjectIPAccessLists mocks base method.
+func (m *MockProjectIPAccessListLister) ProjectIPAccessLists(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists.
+func (mr *MockProjectIPAccessListListerMockRecorder) ProjectIPAccessLists(arg0, arg1 any) *MockProjectIPAccessListListerProjectIPAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockProjectIPAccessListLister)(nil).ProjectIPAccessLists), arg0, arg1)
+	return &MockProjectIPAccessListListerProjectIPAccessListsCall{Call: call}
+}
+
+// MockProjectIPAccessListListerProjectIPAccessListsCall wrap *gomock.Call
+type MockProjectIPAccessListListerProjectIPAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectIPAccessListListerProjectIPAccessListsCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockProjectIPAccessListListerProjectIPAccessListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectIPAccessListListerProjectIPAccessListsCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockProjectIPAccessListListerProjectIPAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectIPAccessListListerProjectIPAccessListsCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockProjectIPAccessListListerProjectIPAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDatabaseRoleLister is a mock of DatabaseRoleLister interface.
+type MockDatabaseRoleLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseRoleListerMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseRoleListerMockRecorder is the mock recorder for MockDatabaseRoleLister.
+type MockDatabaseRoleListerMockRecorder struct {
+	mock *MockDatabaseRoleLister
+}
+
+// NewMockDatabaseRoleLister creates a new mock instance.
+func NewMockDatabaseRoleLister(ctrl *gomock.Controller) *MockDatabaseRoleLister {
+	mock := &MockDatabaseRoleLister{ctrl: ctrl}
+	mock.recorder = &MockDatabaseRoleListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseRoleLister) EXPECT() *MockDatabaseRoleListerMockRecorder {
+	return m.recorder
+}
+
+// DatabaseRoles mocks base method.
+func (m *MockDatabaseRoleLister) DatabaseRoles(arg0 string) ([]admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0)
+	ret0, _ := ret[0].([]admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles.
+func (mr *MockDatabaseRoleListerMockRecorder) DatabaseRoles(arg0 any) *MockDatabaseRoleListerDatabaseRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockDatabaseRoleLister)(nil).DatabaseRoles), arg0)
+	return &MockDatabaseRoleListerDatabaseRolesCall{Call: call}
+}
+
+// MockDatabaseRoleListerDatabaseRolesCall wrap *gomock.Call
+type MockDatabaseRoleListerDatabaseRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDatabaseRoleListerDatabaseRolesCall) Return(arg0 []admin.UserCustomDBRole, arg1 error) *MockDatabaseRoleListerDatabaseRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDatabaseRoleListerDatabaseRolesCall) Do(f func(string) ([]admin.UserCustomDBRole, error)) *MockDatabaseRoleListerDatabaseRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDatabaseRoleListerDatabaseRolesCall) DoAndReturn(f func(string) ([]admin.UserCustomDBRole, error)) *MockDatabaseRoleListerDatabaseRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAlertConfigurationLister is a mock of AlertConfigurationLister interface.
+type MockAlertConfigurationLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertConfigurationListerMockRecorder
+	isgomock struct{}
+}
+
+// MockAlertConfigurationListerMockRecorder is the mock recorder for MockAlertConfigurationLister.
+type MockAlertConfigurationListerMockRecorder struct {
+	mock *MockAlertConfigurationLister
+}
+
+// NewMockAlertConfigurationLister creates a new mock instance.
+func NewMockAlertConfigurationLister(ctrl *gomock.Controller) *MockAlertConfigurationLister {
+	mock := &MockAlertConfigurationLister{ctrl: ctrl}
+	mock.recorder = &MockAlertConfigurationListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertConfigurationLister) EXPECT() *MockAlertConfigurationListerMockRecorder {
+	return m.recorder
+}
+
+// AlertConfigurations mocks base method.
+func (m *MockAlertConfigurationLister) AlertConfigurations(arg0 *admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigurations", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedAlertConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertConfigurations indicates an expected call of AlertConfigurations.
+func (mr *MockAlertConfigurationListerMockRecorder) AlertConfigurations(arg0 any) *MockAlertConfigurationListerAlertConfigurationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockAlertConfigurationLister)(nil).AlertConfigurations), arg0)
+	return &MockAlertConfigurationListerAlertConfigurationsCall{Call: call}
+}
+
+// MockAlertConfigurationListerAlertConfigurationsCall wrap *gomock.Call
+type MockAlertConfigurationListerAlertConfigurationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAlertConfigurationListerAlertConfigurationsCall) Return(arg0 *admin.PaginatedAlertConfig, arg1 error) *MockAlertConfigurationListerAlertConfigurationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAlertConfigurationListerAlertConfigurationsCall) Do(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockAlertConfigurationListerAlertConfigurationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAlertConfigurationListerAlertConfigurationsCall) DoAndReturn(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockAlertConfigurationListerAlertConfigurationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSearchIndexLister is a mock of SearchIndexLister interface.
+type MockSearchIndexLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchIndexListerMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchIndexListerMockRecorder is the mock recorder for MockSearchIndexLister.
+type MockSearchIndexListerMockRecorder struct {
+	mock *MockSearchIndexLister
+}
+
+// NewMockSearchIndexLister creates a new mock instance.
+func NewMockSearchIndexLister(ctrl *gomock.Controller) *MockSearchIndexLister {
+	mock := &MockSearchIndexLister{ctrl: ctrl}
+	mock.recorder = &MockSearchIndexListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchIndexLister) EXPECT() *MockSearchIndexListerMockRecorder {
+	return m.recorder
+}
+
+// SearchIndexes mocks base method.
+func (m *MockSearchIndexLister) SearchIndexes(arg0, arg1, arg2, arg3 string) ([]admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIndexes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIndexes indicates an expected call of SearchIndexes.
+func (mr *MockSearchIndexListerMockRecorder) SearchIndexes(arg0, arg1, arg2, arg3 any) *MockSearchIndexListerSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIndexes", reflect.TypeOf((*MockSearchIndexLister)(nil).SearchIndexes), arg0, arg1, arg2, arg3)
+	return &MockSearchIndexListerSearchIndexesCall{Call: call}
+}
+
+// MockSearchIndexListerSearchIndexesCall wrap *gomock.Call
+type MockSearchIndexListerSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSearchIndexListerSearchIndexesCall) Return(arg0 []admin.SearchIndexResponse, arg1 error) *MockSearchIndexListerSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSearchIndexListerSearchIndexesCall) Do(f func(string, string, string, string) ([]admin.SearchIndexResponse, error)) *MockSearchIndexListerSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSearchIndexListerSearchIndexesCall) DoAndReturn(f func(string, string, string, string) ([]admin.SearchIndexResponse, error)) *MockSearchIndexListerSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockResourceLister is a mock of ResourceLister interface.
+type MockResourceLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceListerMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceListerMockRecorder is the mock recorder for MockResourceLister.
+type MockResourceListerMockRecorder struct {
+	mock *MockResourceLister
+}
+
+// NewMockResourceLister creates a new mock instance.
+func NewMockResourceLister(ctrl *gomock.Controller) *MockResourceLister {
+	mock := &MockResourceLister{ctrl: ctrl}
+	mock.recorder = &MockResourceListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceLister) EXPECT() *MockResourceListerMockRecorder {
+	return m.recorder
+}
+
+// AlertConfigurations mocks base method.
+func (m *MockResourceLister) AlertConfigurations(arg0 *admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigurations", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedAlertConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertConfigurations indicates an expected call of AlertConfigurations.
+func (mr *MockResourceListerMockRecorder) AlertConfigurations(arg0 any) *MockResourceListerAlertConfigurationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockResourceLister)(nil).AlertConfigurations), arg0)
+	return &MockResourceListerAlertConfigurationsCall{Call: call}
+}
+
+// MockResourceListerAlertConfigurationsCall wrap *gomock.Call
+type MockResourceListerAlertConfigurationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceListerAlertConfigurationsCall) Return(arg0 *admin.PaginatedAlertConfig, arg1 error) *MockResourceListerAlertConfigurationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceListerAlertConfigurationsCall) Do(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockResourceListerAlertConfigurationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceListerAlertConfigurationsCall) DoAndReturn(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockResourceListerAlertConfigurationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseRoles mocks base method.
+func (m *MockResourceLister) DatabaseRoles(arg0 string) ([]admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0)
+	ret0, _ := ret[0].([]admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles.
+func (mr *MockResourceListerMockRecorder) DatabaseRoles(arg0 any) *MockResourceListerDatabaseRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockResourceLister)(nil).DatabaseRoles), arg0)
+	return &MockResourceListerDatabaseRolesCall{Call: call}
+}
+
+// MockResourceListerDatabaseRolesCall wrap *gomock.Call
+type MockResourceListerDatabaseRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceListerDatabaseRolesCall) Return(arg0 []admin.UserCustomDBRole, arg1 error) *MockResourceListerDatabaseRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceListerDatabaseRolesCall) Do(f func(string) ([]admin.UserCustomDBRole, error)) *MockResourceListerDatabaseRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceListerDatabaseRolesCall) DoAndReturn(f func(string) ([]admin.UserCustomDBRole, error)) *MockResourceListerDatabaseRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseUsers mocks base method.
+func (m *MockResourceLister) DatabaseUsers(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedApiAtlasDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers.
+func (mr *MockResourceListerMockRecorder) DatabaseUsers(arg0, arg1 any) *MockResourceListerDatabaseUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockResourceLister)(nil).DatabaseUsers), arg0, arg1)
+	return &MockResourceListerDatabaseUsersCall{Call: call}
+}
+
+// MockResourceListerDatabaseUsersCall wrap *gomock.Call
+type MockResourceListerDatabaseUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceListerDatabaseUsersCall) Return(arg0 *admin.PaginatedApiAtlasDatabaseUser, arg1 error) *MockResourceListerDatabaseUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceListerDatabaseUsersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockResourceListerDatabaseUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceListerDatabaseUsersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockResourceListerDatabaseUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestProjectClusters mocks base method.
+func (m *MockResourceLister) LatestProjectClusters(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestProjectClusters indicates an expected call of LatestProjectClusters.
+func (mr *MockResourceListerMockRecorder) LatestProjectClusters(arg0, arg1 any) *MockResourceListerLatestProjectClustersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestProjectClusters", reflect.TypeOf((*MockResourceLister)(nil).LatestProjectClusters), arg0, arg1)
+	return &MockResourceListerLatestProjectClustersCall{Call: call}
+}
+
+// MockResourceListerLatestProjectClustersCall wrap *gomock.Call
+type MockResourceListerLatestProjectClustersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceListerLatestProjectClustersCall) Return(arg0 *admin.PaginatedClusterDescription20240805, arg1 error) *MockResourceListerLatestProjectClustersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceListerLatestProjectClustersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockResourceListerLatestProjectClustersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceListerLatestProjectClustersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockResourceListerLatestProjectClustersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectIPAccessLists mocks base method.
+func (m *MockResourceLister) ProjectIPAccessLists(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists.
+func (mr *MockResourceListerMockRecorder) ProjectIPAccessLists(arg0, arg1 any) *MockResourceListerProjectIPAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockResourceLister)(nil).ProjectIPAccessLists), arg0, arg1)
+	return &MockResourceListerProjectIPAccessListsCall{Call: call}
+}
+
+// MockResourceListerProjectIPAccessListsCall wrap *gomock.Call
+type MockResourceListerProjectIPAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceListerProjectIPAccessListsCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockResourceListerProjectIPAccessListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceListerProjectIPAccessListsCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockResourceListerProjectIPAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceListerProjectIPAccessListsCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockResourceListerProjectIPAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockLister is a mock of Lister interface.
+type MockLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockListerMockRecorder
+	isgomock struct{}
+}
+
+// MockListerMockRecorder is the mock recorder for MockLister.
+type MockListerMockRecorder struct {
+	mock *MockLister
+}
+
+// NewMockLister creates a new mock instance.
+func NewMockLister(ctrl *gomock.Controller) *MockLister {
+	mock := &MockLister{ctrl: ctrl}
+	mock.recorder = &MockListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLister) EXPECT() *MockListerMockRecorder {
+	return m.recorder
+}
+
+// AlertConfigurations mocks base method.
+func (m *MockLister) AlertConfigurations(arg0 *admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigurations", arg0)
+	ret0, _ := ret[0].(*admin.PaginatedAlertConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertConfigurations indicates an expected call of AlertConfigurations.
+func (mr *MockListerMockRecorder) AlertConfigurations(arg0 any) *MockListerAlertConfigurationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockLister)(nil).AlertConfigurations), arg0)
+	return &MockListerAlertConfigurationsCall{Call: call}
+}
+
+// MockListerAlertConfigurationsCall wrap *gomock.Call
+type MockListerAlertConfigurationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListerAlertConfigurationsCall) Return(arg0 *admin.PaginatedAlertConfig, arg1 error) *MockListerAlertConfigurationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListerAlertConfigurationsCall) Do(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockListerAlertConfigurationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListerAlertConfigurationsCall) DoAndReturn(f func(*admin.ListAlertConfigsApiParams) (*admin.PaginatedAlertConfig, error)) *MockListerAlertConfigurationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseRoles mocks base method.
+func (m *MockLister) DatabaseRoles(arg0 string) ([]admin.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0)
+	ret0, _ := ret[0].([]admin.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles.
+func (mr *MockListerMockRecorder) DatabaseRoles(arg0 any) *MockListerDatabaseRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockLister)(nil).DatabaseRoles), arg0)
+	return &MockListerDatabaseRolesCall{Call: call}
+}
+
+// MockListerDatabaseRolesCall wrap *gomock.Call
+type MockListerDatabaseRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListerDatabaseRolesCall) Return(arg0 []admin.UserCustomDBRole, arg1 error) *MockListerDatabaseRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListerDatabaseRolesCall) Do(f func(string) ([]admin.UserCustomDBRole, error)) *MockListerDatabaseRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListerDatabaseRolesCall) DoAndReturn(f func(string) ([]admin.UserCustomDBRole, error)) *MockListerDatabaseRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseUsers mocks base method.
+func (m *MockLister) DatabaseUsers(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedApiAtlasDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers.
+func (mr *MockListerMockRecorder) DatabaseUsers(arg0, arg1 any) *MockListerDatabaseUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockLister)(nil).DatabaseUsers), arg0, arg1)
+	return &MockListerDatabaseUsersCall{Call: call}
+}
+
+// MockListerDatabaseUsersCall wrap *gomock.Call
+type MockListerDatabaseUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListerDatabaseUsersCall) Return(arg0 *admin.PaginatedApiAtlasDatabaseUser, arg1 error) *MockListerDatabaseUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListerDatabaseUsersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockListerDatabaseUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListerDatabaseUsersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedApiAtlasDatabaseUser, error)) *MockListerDatabaseUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestProjectClusters mocks base method.
+func (m *MockLister) LatestProjectClusters(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestProjectClusters indicates an expected call of LatestProjectClusters.
+func (mr *MockListerMockRecorder) LatestProjectClusters(arg0, arg1 any) *MockListerLatestProjectClustersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestProjectClusters", reflect.TypeOf((*MockLister)(nil).LatestProjectClusters), arg0, arg1)
+	return &MockListerLatestProjectClustersCall{Call: call}
+}
+
+// MockListerLatestProjectClustersCall wrap *gomock.Call
+type MockListerLatestProjectClustersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListerLatestProjectClustersCall) Return(arg0 *admin.PaginatedClusterDescription20240805, arg1 error) *MockListerLatestProjectClustersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListerLatestProjectClustersCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockListerLatestProjectClustersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListerLatestProjectClustersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedClusterDescription20240805, error)) *MockListerLatestProjectClustersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectIPAccessLists mocks base method.
+func (m *MockLister) ProjectIPAccessLists(arg0 string, arg1 *store.ListOptions) (*admin.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*admin.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists.
+func (mr *MockListerMockRecorder) ProjectIPAccessLists(arg0, arg1 any) *MockListerProjectIPAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockLister)(nil).ProjectIPAccessLists), arg0, arg1)
+	return &MockListerProjectIPAccessListsCall{Call: call}
+}
+
+// MockListerProjectIPAccessListsCall wrap *gomock.Call
+type MockListerProjectIPAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListerProjectIPAccessListsCall) Return(arg0 *admin.PaginatedNetworkAccess, arg1 error) *MockListerProjectIPAccessListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListerProjectIPAccessListsCall) Do(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockListerProjectIPAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListerProjectIPAccessListsCall) DoAndReturn(f func(string, *store.ListOptions) (*admin.PaginatedNetworkAccess, error)) *MockListerProjectIPAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchIndexes mocks base method.
+func (m *MockLister) SearchIndexes(arg0, arg1, arg2, arg3 string) ([]admin.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIndexes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]admin.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIndexes indicates an expected call of SearchIndexes.
+func (mr *MockListerMockRecorder) SearchIndexes(arg0, arg1, arg2, arg3 any) *MockListerSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIndexes", reflect.TypeOf((*MockLister)(nil).SearchIndexes), arg0, arg1, arg2, arg3)
+	return &MockListerSearchIndexesCall{Call: call}
+}
+
+// MockListerSearchIndexesCall wrap *gomock.Call
+type MockListerSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockListerSearchIndexesCall) Return(arg0 []admin.SearchIndexResponse, arg1 error) *MockListerSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockListerSearchIndexesCall) Do(f func(string, string, string, string) ([]admin.SearchIndexResponse, error)) *MockListerSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockListerSearchIndexesCall) DoAndReturn(f func(string, string, string, string) ([]admin.SearchIndexResponse, error)) *MockListerSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/file"
+	"github.com/spf13/afero"
+)
+
+const defaultAuthDB = "admin"
+
+var ErrMissingKeyField = errors.New("resource is missing a required field")
+
+// Resource is a single entry of the manifest.
+// Fields use the same names as the Atlas Administration API request bodies.
+type Resource map[string]any
+
+// Manifest describes the desired state of the resources of a project.
+// A nil list means the resource kind is not managed by the manifest, an empty list means all resources of that kind should be deleted.
+type Manifest struct {
+	ProjectID           string     `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	CustomDBRoles       []Resource `json:"customDbRoles,omitempty" yaml:"customDbRoles,omitempty"`
+	DatabaseUsers       []Resource `json:"databaseUsers,omitempty" yaml:"databaseUsers,omitempty"`
+	AccessList          []Resource `json:"accessList,omitempty" yaml:"accessList,omitempty"`
+	Clusters            []Resource `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+	SearchIndexes       []Resource `json:"searchIndexes,omitempty" yaml:"searchIndexes,omitempty"`
+	AlertConfigurations []Resource `json:"alertConfigurations,omitempty" yaml:"alertConfigurations,omitempty"`
+}
+
+// manifestFile is the manifest as it's written to a file, nil lists are left out and empty lists are kept,
+// so the kinds that have no resources are still managed when the file is loaded again.
+type manifestFile struct {
+	ProjectID           string      `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	CustomDBRoles       *[]Resource `json:"customDbRoles,omitempty" yaml:"customDbRoles,omitempty"`
+	DatabaseUsers       *[]Resource `json:"databaseUsers,omitempty" yaml:"databaseUsers,omitempty"`
+	AccessList          *[]Resource `json:"accessList,omitempty" yaml:"accessList,omitempty"`
+	Clusters            *[]Resource `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+	SearchIndexes       *[]Resource `json:"searchIndexes,omitempty" yaml:"searchIndexes,omitempty"`
+	AlertConfigurations *[]Resource `json:"alertConfigurations,omitempty" yaml:"alertConfigurations,omitempty"`
+}
+
+func managed(resources []Resource) *[]Resource {
+	if resources == nil {
+		return nil
+	}
+	return &resources
+}
+
+func (m Manifest) file() manifestFile {
+	return manifestFile{
+		ProjectID:           m.ProjectID,
+		CustomDBRoles:       managed(m.CustomDBRoles),
+		DatabaseUsers:       managed(m.DatabaseUsers),
+		AccessList:          managed(m.AccessList),
+		Clusters:            managed(m.Clusters),
+		SearchIndexes:       managed(m.SearchIndexes),
+		AlertConfigurations: managed(m.AlertConfigurations),
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Manifest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.file())
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (m Manifest) MarshalYAML() (any, error) {
+	return m.file(), nil
+}
+
+// Load reads a manifest from a json or yaml file.
+func Load(fs afero.Fs, filename string) (*Manifest, error) {
+	m := new(Manifest)
+	if err := file.Load(fs, filename, m); err != nil {
+		return nil, err
+	}
+
+	// normalize the values so they can be compared with the API responses
+	for _, k := range kinds {
+		resources := k.resources(m)
+		for i := range resources {
+			normalized, err := normalize(resources[i])
+			if err != nil {
+				return nil, err
+			}
+			resources[i] = normalized
+		}
+	}
+
+	return m, nil
+}
+
+// normalize converts any value to the types produced by encoding/json.
+func normalize[T any](in T) (Resource, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var out Resource
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r Resource) String(field string) string {
+	value, _ := r[field].(string)
+	return value
+}
+
+// Kind describes how a type of resource is identified and compared.
+type Kind struct {
+	Name string
+	// Key returns the value that uniquely identifies a resource of this kind.
+	Key func(Resource) (string, error)
+	// WriteOnly contains fields that are never returned by the API, like passwords.
+	WriteOnly []string
+	// ReadOnly contains fields computed by Atlas that are left out of exported manifests.
+	ReadOnly []string
+	// LiveFields maps a manifest field to the field the API uses to return it.
+	LiveFields map[string]string
+	resources  func(*Manifest) []Resource
+}
+
+const (
+	CustomDBRoleKind       = "customDbRole"
+	DatabaseUserKind       = "databaseUser"
+	AccessListKind         = "accessList"
+	ClusterKind            = "cluster"
+	SearchIndexKind        = "searchIndex"
+	AlertConfigurationKind = "alertConfiguration"
+)
+
+// kinds are sorted in the order resources are created, deletions happen in the reverse order.
+var kinds = []*Kind{
+	{
+		Name:      CustomDBRoleKind,
+		Key:       fieldKey("roleName"),
+		resources: func(m *Manifest) []Resource { return m.CustomDBRoles },
+	},
+	{
+		Name:      DatabaseUserKind,
+		Key:       databaseUserKey,
+		WriteOnly: []string{"password"},
+		ReadOnly:  []string{"groupId", "links"},
+		resources: func(m *Manifest) []Resource { return m.DatabaseUsers },
+	},
+	{
+		Name:      AccessListKind,
+		Key:       accessListKey,
+		ReadOnly:  []string{"groupId", "links"},
+		resources: func(m *Manifest) []Resource { return m.AccessList },
+	},
+	{
+		Name: ClusterKind,
+		Key:  fieldKey("name"),
+		ReadOnly: []string{
+			"id", "groupId", "createDate", "stateName", "mongoDBVersion", "connectionStrings", "links",
+			"featureCompatibilityVersion", "featureCompatibilityVersionExpirationDate",
+		},
+		resources: func(m *Manifest) []Resource { return m.Clusters },
+	},
+	{
+		Name:       SearchIndexKind,
+		Key:        searchIndexKey,
+		LiveFields: map[string]string{"definition": "latestDefinition"},
+		ReadOnly: []string{
+			"indexID", "status", "statusDetail", "latestDefinitionVersion", "queryable",
+			"synonymMappingStatus", "synonymMappingStatusDetail",
+		},
+		resources: func(m *Manifest) []Resource { return m.SearchIndexes },
+	},
+	{
+		Name:      AlertConfigurationKind,
+		Key:       fieldKey("id"),
+		ReadOnly:  []string{"id", "groupId", "created", "updated", "links"},
+		resources: func(m *Manifest) []Resource { return m.AlertConfigurations },
+	},
+}
+
+func fieldKey(field string) func(Resource) (string, error) {
+	return func(r Resource) (string, error) {
+		value := r.String(field)
+		if value == "" {
+			return "", fmt.Errorf("%w: %s", ErrMissingKeyField, field)
+		}
+		return value, nil
+	}
+}
+
+func databaseUserKey(r Resource) (string, error) {
+	username := r.String("username")
+	if username == "" {
+		return "", fmt.Errorf("%w: username", ErrMissingKeyField)
+	}
+
+	authDB := r.String("databaseName")
+	if authDB == "" {
+		authDB = defaultAuthDB
+	}
+
+	return authDB + "/" + username, nil
+}
+
+// accessListKey uses the CIDR notation for IP addresses, the API returns both ipAddress and cidrBlock for those.
+func accessListKey(r Resource) (string, error) {
+	if sg := r.String("awsSecurityGroup"); sg != "" {
+		return sg, nil
+	}
+
+	if cidr := r.String("cidrBlock"); cidr != "" {
+		return cidr, nil
+	}
+
+	ip := r.String("ipAddress")
+	if ip == "" {
+		return "", fmt.Errorf("%w: one of awsSecurityGroup, cidrBlock or ipAddress", ErrMissingKeyField)
+	}
+
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+		return ip + "/128", nil
+	}
+
+	return ip + "/32", nil
+}
+
+func searchIndexKey(r Resource) (string, error) {
+	fields := []string{"clusterName", "database", "collectionName", "name"}
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value := r.String(field)
+		if value == "" {
+			return "", fmt.Errorf("%w: %s", ErrMissingKeyField, field)
+		}
+		parts = append(parts, value)
+	}
+
+	return strings.Join(parts, "/"), nil
+}
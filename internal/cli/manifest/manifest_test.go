@@ -0,0 +1,53 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `projectId: 5e2211c17a3e5a48f5497de3
+databaseUsers:
+  - username: app
+    password: secret
+    roles:
+      - roleName: readWrite
+        databaseName: app
+accessList: []
+`
+
+func TestLoad(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "manifest.yaml", []byte(testManifest), 0600))
+
+	m, err := Load(fs, "manifest.yaml")
+	require.NoError(t, err)
+
+	assert.Equal(t, "5e2211c17a3e5a48f5497de3", m.ProjectID)
+	assert.Equal(t, []Resource{
+		{
+			"username": "app",
+			"password": "secret",
+			"roles":    []any{map[string]any{"roleName": "readWrite", "databaseName": "app"}},
+		},
+	}, m.DatabaseUsers)
+	assert.NotNil(t, m.AccessList)
+	assert.Empty(t, m.AccessList)
+	assert.Nil(t, m.Clusters)
+}
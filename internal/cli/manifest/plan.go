@@ -0,0 +1,224 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+const (
+	CreateAction = "create"
+	UpdateAction = "update"
+	DeleteAction = "delete"
+)
+
+// Change is a single step of a plan.
+type Change struct {
+	Action string   `json:"action"`
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Fields []string `json:"fields,omitempty"`
+	// Desired is the manifest entry, nil for deletions.
+	Desired Resource `json:"-"`
+	// Live is the current state returned by the API, nil for creations.
+	Live Resource `json:"-"`
+}
+
+// Plan contains the changes needed to reconcile the live state with a manifest, sorted in dependency order.
+type Plan struct {
+	ProjectID string   `json:"projectId"`
+	Changes   []Change `json:"changes"`
+}
+
+// LiveState holds the current resources of a project, keyed by kind name.
+type LiveState map[string][]Resource
+
+// NewPlan compares a manifest with the live state.
+//
+// Resources are compared field by field, only the fields present in the manifest are taken into account,
+// so values computed by Atlas don't show up as changes.
+// Creations and updates are sorted in dependency order, followed by the deletions in reverse dependency order.
+func NewPlan(projectID string, m *Manifest, live LiveState) (*Plan, error) {
+	plan := &Plan{
+		ProjectID: projectID,
+		Changes:   []Change{},
+	}
+
+	var deletions [][]Change
+	for _, k := range kinds {
+		desired := k.resources(m)
+		if desired == nil {
+			continue
+		}
+
+		changes, deleted, err := k.diff(desired, live[k.Name])
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Changes = append(plan.Changes, changes...)
+		deletions = append(deletions, deleted)
+	}
+
+	for _, deleted := range slices.Backward(deletions) {
+		plan.Changes = append(plan.Changes, deleted...)
+	}
+
+	return plan, nil
+}
+
+// HasChanges returns true if the plan has at least one change.
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Count returns the number of changes for the given action.
+func (p *Plan) Count(action string) int {
+	count := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (k *Kind) diff(desired, live []Resource) (changes, deleted []Change, err error) {
+	liveByKey := make(map[string]Resource, len(live))
+	liveKeys := make([]string, 0, len(live))
+	for _, l := range live {
+		key, err := k.Key(l)
+		if err != nil {
+			// the API didn't return an identifier for this resource, there is nothing we can reconcile
+			continue
+		}
+		liveByKey[key] = l
+		liveKeys = append(liveKeys, key)
+	}
+
+	matched := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		key, err := k.Key(d)
+		if err != nil {
+			// alert configurations have no natural key, match those by content
+			if k.Name != AlertConfigurationKind {
+				return nil, nil, fmt.Errorf("%s: %w", k.Name, err)
+			}
+			key = k.matchByContent(d, live, matched)
+			if key == "" {
+				changes = append(changes, Change{Action: CreateAction, Kind: k.Name, Name: d.String("eventTypeName"), Desired: d})
+				continue
+			}
+		}
+
+		if matched[key] {
+			return nil, nil, fmt.Errorf("%s: duplicated entry %q", k.Name, key)
+		}
+
+		l, ok := liveByKey[key]
+		if !ok {
+			changes = append(changes, Change{Action: CreateAction, Kind: k.Name, Name: key, Desired: d})
+			continue
+		}
+
+		matched[key] = true
+		if fields := k.changedFields(d, l); len(fields) > 0 {
+			changes = append(changes, Change{Action: UpdateAction, Kind: k.Name, Name: key, Fields: fields, Desired: d, Live: l})
+		}
+	}
+
+	for _, key := range liveKeys {
+		if !matched[key] {
+			deleted = append(deleted, Change{Action: DeleteAction, Kind: k.Name, Name: key, Live: liveByKey[key]})
+		}
+	}
+
+	return changes, deleted, nil
+}
+
+// matchByContent returns the key of the first live resource not matched yet that has all the desired fields.
+func (k *Kind) matchByContent(desired Resource, live []Resource, matched map[string]bool) string {
+	for _, l := range live {
+		key, err := k.Key(l)
+		if err != nil || matched[key] {
+			continue
+		}
+
+		if len(k.changedFields(desired, l)) == 0 {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// changedFields returns the sorted paths of the desired fields that don't match the live resource.
+func (k *Kind) changedFields(desired, live Resource) []string {
+	var fields []string
+	for field, value := range desired {
+		if slices.Contains(k.WriteOnly, field) {
+			continue
+		}
+
+		liveField := field
+		if alias, ok := k.LiveFields[field]; ok {
+			liveField = alias
+		}
+
+		fields = append(fields, diffValues(field, value, live[liveField])...)
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+// diffValues compares a desired value with a live value.
+// Maps are compared recursively only on the desired keys, arrays must have the same length and are compared element by element.
+func diffValues(path string, desired, live any) []string {
+	switch d := desired.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return []string{path}
+		}
+
+		var fields []string
+		for key, value := range d {
+			fields = append(fields, diffValues(path+"."+key, value, l[key])...)
+		}
+		return fields
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(d) {
+			return []string{path}
+		}
+
+		var fields []string
+		for i := range d {
+			fields = append(fields, diffValues(path+"["+strconv.Itoa(i)+"]", d[i], l[i])...)
+		}
+		return fields
+	default:
+		if !reflect.DeepEqual(desired, live) {
+			return []string{path}
+		}
+		return nil
+	}
+}
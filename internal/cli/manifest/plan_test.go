@@ -0,0 +1,173 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func actions(p *Plan) []string {
+	result := make([]string, 0, len(p.Changes))
+	for _, c := range p.Changes {
+		result = append(result, c.Action+" "+c.Kind+" "+c.Name)
+	}
+	return result
+}
+
+func TestNewPlan(t *testing.T) {
+	m := &Manifest{
+		CustomDBRoles: []Resource{
+			{"roleName": "reader", "actions": []any{map[string]any{"action": "FIND"}}},
+		},
+		DatabaseUsers: []Resource{
+			{"username": "app", "password": "secret", "roles": []any{map[string]any{"roleName": "reader", "databaseName": "admin"}}},
+			{"username": "new", "databaseName": "$external", "x509Type": "MANAGED"},
+		},
+		AccessList: []Resource{
+			{"ipAddress": "10.0.0.1", "comment": "vpn"},
+		},
+		Clusters: []Resource{
+			{"name": "Cluster0", "clusterType": "REPLICASET"},
+		},
+	}
+
+	live := LiveState{
+		CustomDBRoleKind: {
+			{"roleName": "reader", "actions": []any{map[string]any{"action": "FIND", "resources": []any{}}}},
+			{"roleName": "legacy"},
+		},
+		DatabaseUserKind: {
+			{"username": "app", "databaseName": "admin", "roles": []any{map[string]any{"roleName": "read", "databaseName": "admin"}}},
+		},
+		AccessListKind: {
+			{"ipAddress": "10.0.0.1", "cidrBlock": "10.0.0.1/32", "comment": "vpn"},
+			{"cidrBlock": "192.168.0.0/24"},
+		},
+		ClusterKind: {
+			{"name": "Cluster0", "clusterType": "REPLICASET", "stateName": "IDLE"},
+			{"name": "Unmanaged"},
+		},
+	}
+
+	p, err := NewPlan("project", m, live)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"update databaseUser admin/app",
+		"create databaseUser $external/new",
+		"delete cluster Unmanaged",
+		"delete accessList 192.168.0.0/24",
+		"delete customDbRole legacy",
+	}, actions(p))
+	assert.Equal(t, []string{"roles[0].roleName"}, p.Changes[0].Fields)
+	assert.Equal(t, 2, p.Count(CreateAction)+p.Count(UpdateAction))
+	assert.True(t, p.HasChanges())
+}
+
+func TestNewPlanUnmanagedKinds(t *testing.T) {
+	live := LiveState{
+		ClusterKind: {{"name": "Cluster0"}},
+	}
+
+	p, err := NewPlan("project", &Manifest{}, live)
+	require.NoError(t, err)
+	assert.False(t, p.HasChanges())
+
+	p, err = NewPlan("project", &Manifest{Clusters: []Resource{}}, live)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"delete cluster Cluster0"}, actions(p))
+}
+
+func TestNewPlanSearchIndexes(t *testing.T) {
+	m := &Manifest{
+		SearchIndexes: []Resource{
+			{"clusterName": "Cluster0", "database": "db", "collectionName": "coll", "name": "default", "definition": map[string]any{"mappings": map[string]any{"dynamic": true}}},
+		},
+	}
+	live := LiveState{
+		SearchIndexKind: {
+			{"clusterName": "Cluster0", "database": "db", "collectionName": "coll", "name": "default", "indexID": "1", "latestDefinition": map[string]any{"mappings": map[string]any{"dynamic": false}}},
+		},
+	}
+
+	p, err := NewPlan("project", m, live)
+	require.NoError(t, err)
+	require.Equal(t, []string{"update searchIndex Cluster0/db/coll/default"}, actions(p))
+	assert.Equal(t, []string{"definition.mappings.dynamic"}, p.Changes[0].Fields)
+}
+
+func TestNewPlanAlertConfigurations(t *testing.T) {
+	m := &Manifest{
+		AlertConfigurations: []Resource{
+			{"eventTypeName": "NO_PRIMARY", "enabled": true},
+			{"eventTypeName": "HOST_DOWN", "enabled": true},
+			{"id": "3", "eventTypeName": "CLUSTER_MONGOS_IS_MISSING", "enabled": false},
+		},
+	}
+	live := LiveState{
+		AlertConfigurationKind: {
+			{"id": "1", "eventTypeName": "NO_PRIMARY", "enabled": true, "notifications": []any{}},
+			{"id": "2", "eventTypeName": "OUTSIDE_METRIC_THRESHOLD", "enabled": true},
+			{"id": "3", "eventTypeName": "CLUSTER_MONGOS_IS_MISSING", "enabled": true},
+		},
+	}
+
+	p, err := NewPlan("project", m, live)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"create alertConfiguration HOST_DOWN",
+		"update alertConfiguration 3",
+		"delete alertConfiguration 2",
+	}, actions(p))
+}
+
+func TestNewPlanErrors(t *testing.T) {
+	t.Run("missing key", func(t *testing.T) {
+		_, err := NewPlan("project", &Manifest{Clusters: []Resource{{"clusterType": "REPLICASET"}}}, LiveState{})
+		require.ErrorIs(t, err, ErrMissingKeyField)
+	})
+
+	t.Run("duplicated entry", func(t *testing.T) {
+		m := &Manifest{Clusters: []Resource{{"name": "Cluster0"}, {"name": "Cluster0"}}}
+		_, err := NewPlan("project", m, LiveState{ClusterKind: {{"name": "Cluster0"}}})
+		require.Error(t, err)
+	})
+}
+
+func TestAccessListKey(t *testing.T) {
+	tests := []struct {
+		resource Resource
+		expected string
+	}{
+		{resource: Resource{"ipAddress": "10.0.0.1"}, expected: "10.0.0.1/32"},
+		{resource: Resource{"ipAddress": "2001:db8::1"}, expected: "2001:db8::1/128"},
+		{resource: Resource{"cidrBlock": "10.0.0.0/8", "ipAddress": "10.0.0.1"}, expected: "10.0.0.0/8"},
+		{resource: Resource{"awsSecurityGroup": "sg-1"}, expected: "sg-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			key, err := accessListKey(tt.resource)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+
+	_, err := accessListKey(Resource{"comment": "missing"})
+	require.ErrorIs(t, err, ErrMissingKeyField)
+}
@@ -86,8 +86,8 @@ func watcherAWS(peer *atlasv2.BaseNetworkPeeringConnectionSettings) bool {
 	return peer.GetStatusName() == pendingAcceptance || peer.GetStatusName() == failed || peer.GetStatusName() == available
 }
 
-func (opts *WatchOpts) Run() error {
-	if _, err := opts.Watch(opts.watcher); err != nil {
+func (opts *WatchOpts) Run(ctx context.Context) error {
+	if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 		return err
 	}
 
@@ -120,9 +120,9 @@ You can interrupt the command's polling at any time with CTRL-C.
 				opts.InitOutput(cmd.OutOrStdout(), watchTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -65,7 +65,7 @@ func TestWatchOpts_Run(t *testing.T) {
 				Return(expected, nil).
 				Times(1)
 
-			if err := describeOpts.Run(); err != nil {
+			if err := describeOpts.Run(t.Context()); err != nil {
 				t.Fatalf("Run() unexpected error: %v", err)
 			}
 		})
@@ -28,12 +28,12 @@ type OrgOpts struct {
 }
 
 // ConfigOrgID returns the organization id.
-// If the id is empty, it caches it after querying config.
+// If the id is empty, it caches it after querying the environment, the directory context file and config.
 func (opts *OrgOpts) ConfigOrgID() string {
 	if opts.OrgID != "" {
 		return opts.OrgID
 	}
-	opts.OrgID = config.OrgID()
+	opts.OrgID = ContextOrgID(config.OrgID)
 	return opts.OrgID
 }
 
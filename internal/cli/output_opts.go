@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -28,9 +29,11 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/jsonpathwriter"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/jsonwriter"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tabularwriter"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/templatewriter"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/terminal"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/yamlwriter"
 	"github.com/spf13/cobra"
 )
 
@@ -40,14 +43,19 @@ const (
 	jsonPath        = "json-path"
 	goTemplate      = "go-template"
 	goTemplateFile  = "go-template-file"
+	yamlFormat      = "yaml"
 )
 
-var templateFormats = []string{goTemplate, goTemplateFile, jsonPath}
+var (
+	templateFormats = []string{goTemplate, goTemplateFile, jsonPath}
+	errColumns      = errors.New("--columns can only be used with the csv, tsv and table output formats")
+)
 
 type OutputOpts struct {
 	Template  string
 	OutWriter io.Writer
 	Output    string
+	Columns   []string
 }
 
 // InitOutput allow to init the OutputOpts in a functional way.
@@ -61,17 +69,18 @@ func (opts *OutputOpts) InitOutput(w io.Writer, t string) func() error {
 
 func (*OutputOpts) AutoCompleteOutputFlag() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
-		return []string{"json", "json-path", "go-template", "go-template-file"}, cobra.ShellCompDirectiveDefault
+		formats := []string{jsonFormat, jsonPath, goTemplate, goTemplateFile, yamlFormat}
+		return append(formats, tabularwriter.Formats()...), cobra.ShellCompDirectiveDefault
 	}
 }
 
 // ConfigOutput returns the output format.
-// If the format is empty, it caches it after querying config.
+// If the format is empty, it caches it after querying the environment, the directory context file and config.
 func (opts *OutputOpts) ConfigOutput() string {
 	if opts.Output != "" {
 		return opts.Output
 	}
-	opts.Output = config.Output()
+	opts.Output = ContextOutput(config.Output)
 	return opts.Output
 }
 
@@ -87,8 +96,17 @@ func (opts *OutputOpts) IsJSONPathOutput() bool {
 	return opts.ConfigOutput() == jsonPath
 }
 
+// IsTabularOutput returns true for the csv, tsv and table formats.
+func (opts *OutputOpts) IsTabularOutput() bool {
+	return slices.Contains(tabularwriter.Formats(), opts.ConfigOutput())
+}
+
+func (opts *OutputOpts) IsYAMLOutput() bool {
+	return opts.ConfigOutput() == yamlFormat
+}
+
 func (opts *OutputOpts) IsPlainOutput() bool {
-	return !opts.IsJSONOutput() && !opts.IsGoTemplate() && !opts.IsJSONPathOutput()
+	return !opts.IsJSONOutput() && !opts.IsGoTemplate() && !opts.IsJSONPathOutput() && !opts.IsTabularOutput() && !opts.IsYAMLOutput()
 }
 
 // ConfigWriter returns the io.Writer.
@@ -147,10 +165,18 @@ func isOrPtrToSliceOrArray(o any) bool {
 
 // Print will evaluate the defined format and try to parse it accordingly outputting to the set writer.
 func (opts *OutputOpts) Print(o any) error {
+	if err := opts.validateColumns(); err != nil {
+		return err
+	}
+
 	if opts.ConfigOutput() == jsonFormat {
 		return jsonwriter.Print(opts.ConfigWriter(), o)
 	}
 
+	if ok, err := opts.printStructured(o); ok {
+		return err
+	}
+
 	outputType, val := opts.outputTypeAndValue()
 	if outputType == jsonPath {
 		return jsonpathwriter.Print(opts.ConfigWriter(), val, o)
@@ -176,6 +202,10 @@ func (opts *OutputOpts) Print(o any) error {
 }
 
 func (opts *OutputOpts) PrintForCompactResultsResponse(o any) error {
+	if err := opts.validateColumns(); err != nil {
+		return err
+	}
+
 	if opts.ConfigOutput() == jsonFormat {
 		compactResponse, err := mapReduceResults(o)
 		if err == nil {
@@ -183,6 +213,16 @@ func (opts *OutputOpts) PrintForCompactResultsResponse(o any) error {
 		}
 	}
 
+	if opts.IsYAMLOutput() {
+		if compactResponse, err := mapReduceResults(o); err == nil {
+			o = compactResponse
+		}
+	}
+
+	if ok, err := opts.printStructured(o); ok {
+		return err
+	}
+
 	outputType, val := opts.outputTypeAndValue()
 	if outputType == jsonPath {
 		compactResponse, err := mapReduceResults(o)
@@ -203,6 +243,27 @@ func (opts *OutputOpts) PrintForCompactResultsResponse(o any) error {
 	return err
 }
 
+// validateColumns rejects --columns with the formats that print every field, like json and yaml.
+func (opts *OutputOpts) validateColumns() error {
+	if len(opts.Columns) > 0 && !opts.IsTabularOutput() {
+		return errColumns
+	}
+	return nil
+}
+
+// printStructured prints o with the csv, tsv, table and yaml formats, it returns false for any other format.
+// Lists and the results of paginated responses are printed with one row per element by the tabular formats.
+func (opts *OutputOpts) printStructured(o any) (bool, error) {
+	switch {
+	case opts.IsTabularOutput():
+		return true, tabularwriter.Print(opts.ConfigWriter(), opts.ConfigOutput(), opts.Columns, o)
+	case opts.IsYAMLOutput():
+		return true, yamlwriter.Print(opts.ConfigWriter(), o)
+	default:
+		return false, nil
+	}
+}
+
 func mapReduceResults(o any) (any, error) {
 	jsonString, err := json.Marshal(o)
 	if err != nil {
@@ -251,8 +312,9 @@ func template(outputType, value string) (string, error) {
 	return value, nil
 }
 
-// AddOutputOptFlags adds the output flag to the command and registers its auto-completion function.
+// AddOutputOptFlags adds the output and columns flags to the command and registers the output auto-completion function.
 func (opts *OutputOpts) AddOutputOptFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&opts.Output, flag.Output, flag.OutputShort, "", usage.FormatOut)
 	_ = cmd.RegisterFlagCompletionFunc(flag.Output, opts.AutoCompleteOutputFlag())
+	cmd.Flags().StringSliceVar(&opts.Columns, flag.Columns, nil, usage.Columns)
 }
@@ -15,6 +15,8 @@
 package cli
 
 import (
+	"bytes"
+	"errors"
 	"io"
 	"reflect"
 	"testing"
@@ -95,3 +97,85 @@ func TestOutputOpts_mapReduceResults(t *testing.T) {
 		}
 	})
 }
+
+func TestOutputOpts_Print(t *testing.T) {
+	teams := atlasv2.NewPaginatedTeam([]atlasv2.TeamResponse{
+		{
+			Id:   pointer.Get("1"),
+			Name: pointer.Get("Team A"),
+		},
+		{
+			Id:   pointer.Get("2"),
+			Name: pointer.Get("Team, B"),
+		},
+	})
+
+	tests := []struct {
+		name    string
+		output  string
+		columns []string
+		want    string
+	}{
+		{
+			name:    "csv",
+			output:  "csv",
+			columns: []string{"id", "name"},
+			want:    "id,name\n1,Team A\n2,\"Team, B\"\n",
+		},
+		{
+			name:    "tsv",
+			output:  "tsv",
+			columns: []string{"name"},
+			want:    "name\nTeam A\nTeam, B\n",
+		},
+		{
+			name:    "table",
+			output:  "table",
+			columns: []string{"$.id", "name"},
+			want:    "$.ID   NAME\n1      Team A\n2      Team, B\n",
+		},
+		{
+			name:   "yaml",
+			output: "yaml",
+			want:   "results:\n  - id: \"1\"\n    name: Team A\n  - id: \"2\"\n    name: Team, B\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			opts := &OutputOpts{
+				OutWriter: buf,
+				Output:    tt.output,
+				Columns:   tt.columns,
+				Template:  "ID\tNAME\n",
+			}
+			if err := opts.Print(teams); err != nil {
+				t.Fatalf("Print() unexpected error: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Print() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, output := range []string{"yaml", "json", "json-path=$.id"} {
+		t.Run("columns with "+output, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			opts := &OutputOpts{
+				OutWriter: buf,
+				Output:    output,
+				Columns:   []string{"id"},
+			}
+			if err := opts.Print(teams); !errors.Is(err, errColumns) {
+				t.Errorf("Print() got = %v, want %v", err, errColumns)
+			}
+			if err := opts.PrintForCompactResultsResponse(teams); !errors.Is(err, errColumns) {
+				t.Errorf("PrintForCompactResultsResponse() got = %v, want %v", err, errColumns)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("Print() wrote %q", buf.String())
+			}
+		})
+	}
+}
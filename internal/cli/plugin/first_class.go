@@ -126,11 +126,13 @@ func (fcp *FirstClassPlugin) installPlugin(cmd *cobra.Command, plugins *plugin.V
 		Opts: Opts{
 			plugins: plugins,
 		},
-		ghClient: NewAuthenticatedGithubClient(),
 	}
-	installOpts.githubAsset = &GithubAsset{
-		owner: fcp.Github.Owner,
-		name:  fcp.Github.Name,
+	installOpts.source = &githubSource{
+		asset: &GithubAsset{
+			owner: fcp.Github.Owner,
+			name:  fcp.Github.Name,
+		},
+		ghClient: NewAuthenticatedGithubClient(),
 	}
 	installOpts.Print("Installing first class plugin " + fcp.Name)
 
@@ -16,8 +16,10 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/google/go-github/v61/github"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
@@ -34,100 +36,125 @@ type InstallOpts struct {
 	cli.OutputOpts
 	Opts
 	ghClient                  *github.Client
-	githubAsset               *GithubAsset
+	source                    pluginSource
+	checksum                  string
 	skipSignatureVerification bool
 }
 
+var errPluginAlreadyInstalled = errors.New("the plugin is already installed")
+
+// installTempDirectoryPattern is the pattern of the directory the archive is extracted to before it's validated,
+// because the directory name of plugins that aren't installed from GitHub comes from their manifest.
+const installTempDirectoryPattern = "plugin.install-*"
+
 func (opts *InstallOpts) checkForDuplicatePlugins() error {
-	_, err := opts.findPluginWithGithubValues(opts.githubAsset.owner, opts.githubAsset.name)
+	source, ok := opts.source.(*githubSource)
+	if !ok {
+		return nil
+	}
+
+	_, err := opts.findPluginWithGithubValues(source.asset.owner, source.asset.name)
 	if err != nil {
 		return nil
 	}
 
-	return fmt.Errorf("a plugin from the repository %s is already installed.\nTo update the plugin run: \n\tatlas plugin update %s", opts.githubAsset.repository(), opts.githubAsset.repository())
+	return fmt.Errorf("a plugin from the repository %s is already installed.\nTo update the plugin run: \n\tatlas plugin update %s", source.asset.repository(), source.asset.repository())
+}
+
+func (opts *InstallOpts) validateChecksum() error {
+	if opts.checksum != "" {
+		_, err := normalizeChecksum(opts.checksum)
+		return err
+	}
+	if _, ok := opts.source.(*githubSource); !ok {
+		return errChecksumRequired
+	}
+	return nil
 }
 
 // checks if the plugin manifest is valid and that the plugin
 // doesn't contain any commands that conflict with existing CLI commands.
-func (opts *InstallOpts) validatePlugin(pluginDirectoryPath string) error {
+func (opts *InstallOpts) validatePlugin(pluginDirectoryPath string) (*plugin.Manifest, error) {
 	// Get the manifest from the plugin directory
 	manifest, err := plugin.GetManifestFromPluginDirectory(pluginDirectoryPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = validateManifest(manifest)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// check for duplicate plugin names
 	for _, p := range opts.getValidPlugins() {
 		if manifest.Name == p.Name {
-			return fmt.Errorf("a plugin with the name %s already exists", manifest.Name)
+			return nil, fmt.Errorf("a plugin with the name %s already exists", manifest.Name)
 		}
 	}
 
 	// Check for duplicate commands
 	existingCommandsSet := createExistingCommandsSet(opts.existingCommands)
 	if manifest.HasDuplicateCommand(existingCommandsSet) {
-		return fmt.Errorf(`could not load plugin "%s" because it contains a command that already exists in the AtlasCLI or another plugin`, opts.githubAsset.repository())
+		return nil, fmt.Errorf(`could not load plugin "%s" because it contains a command that already exists in the AtlasCLI or another plugin`, opts.source)
 	}
 
-	return nil
+	return manifest, nil
 }
 
-func (opts *InstallOpts) Run(ctx context.Context) error {
-	// get all plugin assets info from github repository
-	// getReleaseAssets may fall back to an unauthenticated client; reuse the
-	// returned client for the subsequent asset downloads.
-	assets, ghClient, err := opts.githubAsset.getReleaseAssets(opts.ghClient)
+// install validates the plugin extracted to the temporary directory and moves it to its plugin directory.
+func (opts *InstallOpts) install(tempPluginDirectoryPath string, source LockedPlugin) error {
+	manifest, err := opts.validatePlugin(tempPluginDirectoryPath)
 	if err != nil {
 		return err
 	}
-	opts.ghClient = ghClient
 
-	// find correct assetID, signatureID and pubKeyID using system requirements
-	assetID, signatureID, pubKeyID, err := opts.githubAsset.getIDs(assets)
-	if err != nil {
+	// record where the plugin comes from, so plugin sync knows it's up to date
+	if err := writeInstalledSource(tempPluginDirectoryPath, source); err != nil {
 		return err
 	}
 
-	// When signatureID and pubKeyID are 0, the signature check is skipped.
-	if opts.skipSignatureVerification {
-		signatureID = 0
-		pubKeyID = 0
+	directoryName, err := pluginDirectoryName(opts.source, manifest)
+	if err != nil {
+		return err
 	}
 
-	// download plugin asset archive file and save it as ReadCloser
-	rc, err := opts.githubAsset.getPluginAssetsAsReadCloser(opts.ghClient, assetID, signatureID, pubKeyID)
-	if err != nil {
+	return movePluginDirectory(tempPluginDirectoryPath, directoryName)
+}
+
+// movePluginDirectory renames the extracted plugin to its directory in the plugin directory,
+// an existing directory is never replaced, it belongs to a plugin that is already installed.
+func movePluginDirectory(tempPluginDirectoryPath, directoryName string) error {
+	pluginDirectoryPath := filepath.Join(filepath.Dir(tempPluginDirectoryPath), directoryName)
+	if _, err := os.Stat(pluginDirectoryPath); err == nil {
+		return fmt.Errorf("%w in %s.\nTo update the plugin run: \n\tatlas plugin update or atlas plugin sync", errPluginAlreadyInstalled, pluginDirectoryPath)
+	} else if !errors.Is(err, os.ErrNotExist) {
 		return err
 	}
-	defer rc.Close()
 
-	// use the ReadCloser to save the asset archive file in the default plugin directory
-	pluginArchiveFilePath, err := saveReadCloserToPluginAssetArchiveFile(rc)
+	return os.Rename(tempPluginDirectoryPath, pluginDirectoryPath)
+}
+
+func (opts *InstallOpts) Run(ctx context.Context) error {
+	// download the plugin archive file to the default plugin directory and check its checksum
+	pluginArchiveFilePath, digest, err := downloadPluginArchive(ctx, opts.source, opts.checksum)
 	if err != nil {
 		return err
 	}
 	defer os.Remove(pluginArchiveFilePath) // delete archive file after install command finishes
 
-	// try to extract content of plugin archive file and save it in default plugin directory
-	pluginDirectoryPath, err := extractPluginAssetArchiveFile(ctx, pluginArchiveFilePath, opts.githubAsset.getPluginDirectoryName())
+	// try to extract content of plugin archive file and save it in a temporary directory
+	tempPluginDirectoryPath, err := extractPluginArchiveToTempDirectory(ctx, pluginArchiveFilePath, installTempDirectoryPattern)
 	if err != nil {
 		return err
 	}
+	defer os.RemoveAll(tempPluginDirectoryPath) // delete the extracted files when the plugin is invalid
 
-	// validate the extracted plugin files
-	// if plugin is invalid, delete all of its files
-	err = opts.validatePlugin(pluginDirectoryPath)
-	if err != nil {
-		os.RemoveAll(pluginDirectoryPath)
+	if err := opts.install(tempPluginDirectoryPath, LockedPlugin{Source: opts.source.String(), SHA256: digest}); err != nil {
 		return err
 	}
 
-	return opts.Print(fmt.Sprintf("Plugin %s successfully installed", opts.githubAsset.repository()))
+	return opts.Print(fmt.Sprintf("Plugin %s successfully installed, SHA-256 checksum: %s", opts.source, digest))
 }
 
 func InstallBuilder(pluginOpts *Opts) *cobra.Command {
@@ -138,17 +165,22 @@ func InstallBuilder(pluginOpts *Opts) *cobra.Command {
 
 	const use = "install"
 	cmd := &cobra.Command{
-		Use:     use + " [<github-owner>/<github-repository-name>]",
+		Use:     use + " [<github-owner>/<github-repository-name>|<archive-path>|<archive-url>|oci://<registry>/<repository>]",
 		Aliases: cli.GenerateAliases(use),
 		Annotations: map[string]string{
-			"<github-owner>/<github-repository-name>Desc": "Repository identifier.",
+			"<github-owner>/<github-repository-name>|<archive-path>|<archive-url>|oci://<registry>/<repository>Desc": "Repository identifier, path or URL of the plugin archive, or OCI reference of the plugin artifact.",
 		},
-		Short: "Install Atlas CLI plugin from a GitHub repository.",
-		Long: `Install an Atlas CLI plugin from a GitHub repository.
+		Short: "Install Atlas CLI plugin from a GitHub repository, a local archive, a URL or an OCI registry.",
+		Long: `Install an Atlas CLI plugin from a GitHub repository, a local archive, a URL or an OCI registry.
 You can specify a GitHub repository using either the "<github-owner>/<github-repository-name>" format or a full URL.
 When you install the plugin, its latest release on GitHub is used by default.
 To install a specific version of the plugin, append the version number directly to the plugin name using the @ symbol.
 
+To install a plugin from a mirror, specify the path or the HTTP(S) URL of the plugin archive for your platform, or the "oci://<registry>/<repository>[:<tag>|@<digest>]" reference of an OCI artifact with the plugin archive as a layer.
+These sources require the SHA-256 checksum of the archive with the --sha256 flag. To authenticate to an OCI registry, set the ATLAS_CLI_OCI_USERNAME and ATLAS_CLI_OCI_PASSWORD environment variables.
+
+To install the plugins pinned in an atlas-plugins.lock file, use the plugin sync command.
+
 MongoDB provides an example plugin: https://github.com/mongodb/atlas-cli-plugin-example
 `,
 		Args: require.ExactArgs(1),
@@ -158,15 +190,19 @@ MongoDB provides an example plugin: https://github.com/mongodb/atlas-cli-plugin-
   
   # Install a specific version of the plugin:
   atlas plugin install mongodb/atlas-cli-plugin-example@1.0.4
-  atlas plugin install https://github.com/mongodb/atlas-cli-plugin-example/@v1.2.3`,
+  atlas plugin install https://github.com/mongodb/atlas-cli-plugin-example/@v1.2.3
+
+  # Install the plugin from an internal mirror:
+  atlas plugin install https://mirror.example.com/atlas-cli-plugin-example_1.0.4_linux_x86_64.tar.gz --sha256 3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817
+  atlas plugin install oci://registry.example.com/atlas-cli-plugin-example:1.0.4 --sha256 3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817`,
 		PreRunE: func(_ *cobra.Command, args []string) error {
-			githubAssetRelease, err := parseGithubReleaseValues(args[0])
+			source, err := parsePluginSource(args[0], opts.ghClient, opts.skipSignatureVerification)
 			if err != nil {
 				return err
 			}
-			opts.githubAsset = githubAssetRelease
+			opts.source = source
 
-			return opts.PreRunE(opts.checkForDuplicatePlugins)
+			return opts.PreRunE(opts.validateChecksum, opts.checkForDuplicatePlugins)
 		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return opts.Run(cmd.Context())
@@ -174,6 +210,7 @@ MongoDB provides an example plugin: https://github.com/mongodb/atlas-cli-plugin-
 	}
 
 	cmd.Flags().BoolVar(&opts.skipSignatureVerification, flag.SkipSignatureVerification, false, usage.SkipSignatureVerification)
+	cmd.Flags().StringVar(&opts.checksum, flag.SHA256, "", usage.PluginSHA256)
 
 	return cmd
 }
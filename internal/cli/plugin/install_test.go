@@ -15,6 +15,8 @@
 package plugin
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/Masterminds/semver/v3"
@@ -63,12 +65,57 @@ func Test_checkForDuplicatePlugins(t *testing.T) {
 		},
 	}
 
-	opts.githubAsset = &GithubAsset{name: github1.Name, owner: github1.Owner}
+	asset := &GithubAsset{name: github1.Name, owner: github1.Owner}
+	opts.source = &githubSource{asset: asset}
 	err = opts.checkForDuplicatePlugins()
 	require.Error(t, err)
 
-	opts.githubAsset.name = github2.Name
-	opts.githubAsset.owner = "differentOwner"
+	asset.name = github2.Name
+	asset.owner = "differentOwner"
+	err = opts.checkForDuplicatePlugins()
+	require.NoError(t, err)
+
+	opts.source = &urlSource{url: "https://mirror.example.com/plugin.tar.gz"}
 	err = opts.checkForDuplicatePlugins()
 	assert.NoError(t, err)
 }
+
+func Test_validateChecksum(t *testing.T) {
+	const checksum = "3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817"
+
+	tests := []struct {
+		name     string
+		source   pluginSource
+		checksum string
+		wantErr  error
+	}{
+		{name: "github without checksum", source: &githubSource{asset: &GithubAsset{owner: "mongodb", name: "atlas-cli-plugin-example"}}},
+		{name: "url with checksum", source: &urlSource{url: "https://mirror.example.com/plugin.tar.gz"}, checksum: checksum},
+		{name: "oci with prefixed checksum", source: &ociSource{ref: "oci://registry.example.com/plugin:1.0.0"}, checksum: "sha256:" + checksum},
+		{name: "url without checksum", source: &urlSource{url: "https://mirror.example.com/plugin.tar.gz"}, wantErr: errChecksumRequired},
+		{name: "file without checksum", source: &fileSource{path: "plugin.tar.gz"}, wantErr: errChecksumRequired},
+		{name: "invalid checksum", source: &fileSource{path: "plugin.tar.gz"}, checksum: "abc", wantErr: errChecksumInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &InstallOpts{source: tt.source, checksum: tt.checksum}
+			assert.ErrorIs(t, opts.validateChecksum(), tt.wantErr)
+		})
+	}
+}
+
+func Test_movePluginDirectory(t *testing.T) {
+	dir := t.TempDir()
+	tempPluginDirectoryPath := filepath.Join(dir, "plugin.install-1")
+	require.NoError(t, os.Mkdir(tempPluginDirectoryPath, 0o700))
+
+	require.NoError(t, movePluginDirectory(tempPluginDirectoryPath, "atlas-cli-plugin-example"))
+	assert.DirExists(t, filepath.Join(dir, "atlas-cli-plugin-example"))
+	assert.NoDirExists(t, tempPluginDirectoryPath)
+
+	tempPluginDirectoryPath = filepath.Join(dir, "plugin.install-2")
+	require.NoError(t, os.Mkdir(tempPluginDirectoryPath, 0o700))
+	require.ErrorIs(t, movePluginDirectory(tempPluginDirectoryPath, "atlas-cli-plugin-example"), errPluginAlreadyInstalled)
+	assert.DirExists(t, tempPluginDirectoryPath)
+}
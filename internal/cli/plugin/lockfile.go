@@ -0,0 +1,113 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultLockfileName     = "atlas-plugins.lock"
+	installedSourceFileName = ".source.yaml"
+)
+
+// Lockfile pins the plugins of a repository, so every environment installs the same archives.
+//
+//	plugins:
+//	  - source: https://mirror.example.com/atlas-cli-plugin-example_1.0.4_linux_x86_64.tar.gz
+//	    sha256: 3b1f6e0b9d5c...
+//	    platform: linux/amd64
+type Lockfile struct {
+	Plugins []LockedPlugin `yaml:"plugins"`
+}
+
+// LockedPlugin is the source and the SHA-256 checksum of a plugin archive.
+// Archives that only support one OS and architecture set the platform, and are skipped on the other platforms.
+type LockedPlugin struct {
+	Source   string `yaml:"source"`
+	SHA256   string `yaml:"sha256"`
+	Platform string `yaml:"platform,omitempty"`
+}
+
+func readLockfile(path string) (*Lockfile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the lockfile: %w", err)
+	}
+
+	var lockfile Lockfile
+	if err := yaml.Unmarshal(content, &lockfile); err != nil {
+		return nil, fmt.Errorf("could not parse the lockfile %s: %w", path, err)
+	}
+
+	if err := lockfile.validate(); err != nil {
+		return nil, fmt.Errorf("the lockfile %s is invalid: %w", path, err)
+	}
+	return &lockfile, nil
+}
+
+func (l *Lockfile) validate() error {
+	var errs []error
+	for i := range l.Plugins {
+		p := &l.Plugins[i]
+		if p.Source == "" {
+			errs = append(errs, fmt.Errorf("plugin %d: source is required", i+1))
+			continue
+		}
+		checksum, err := normalizeChecksum(p.SHA256)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", p.Source, err))
+			continue
+		}
+		p.SHA256 = checksum
+		if goos, goarch, ok := strings.Cut(p.Platform, "/"); p.Platform != "" && (!ok || goos == "" || goarch == "") {
+			errs = append(errs, fmt.Errorf(`plugin %s: platform needs to have the format "<os>/<arch>", like linux/amd64`, p.Source))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *LockedPlugin) matchesPlatform(goos, goarch string) bool {
+	return p.Platform == "" || p.Platform == goos+"/"+goarch
+}
+
+// readInstalledSource returns the source and checksum that the plugin of the directory was installed from,
+// plugins installed before the source was recorded return an error.
+func readInstalledSource(pluginDirectoryPath string) (*LockedPlugin, error) {
+	content, err := os.ReadFile(filepath.Join(pluginDirectoryPath, installedSourceFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var source LockedPlugin
+	if err := yaml.Unmarshal(content, &source); err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+func writeInstalledSource(pluginDirectoryPath string, source LockedPlugin) error {
+	content, err := yaml.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(pluginDirectoryPath, installedSourceFileName), content, 0o600)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lockedChecksum = "3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817"
+
+func Test_readLockfile(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), defaultLockfileName)
+		require.NoError(t, os.WriteFile(path, []byte(`plugins:
+  - source: mongodb/atlas-cli-plugin-example@1.0.4
+    sha256: sha256:3B1F6E0B9D5C4A2F8E7D6C5B4A39281706F5E4D3C2B1A09F8E7D6C5B4A392817
+    platform: linux/amd64
+  - source: oci://registry.example.com/atlas-cli-plugin-example:1.0.4
+    sha256: 3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817
+`), 0o600))
+
+		got, err := readLockfile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []LockedPlugin{
+			{Source: "mongodb/atlas-cli-plugin-example@1.0.4", SHA256: lockedChecksum, Platform: "linux/amd64"},
+			{Source: "oci://registry.example.com/atlas-cli-plugin-example:1.0.4", SHA256: lockedChecksum},
+		}, got.Plugins)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), defaultLockfileName)
+		require.NoError(t, os.WriteFile(path, []byte(`plugins:
+  - sha256: 3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817
+  - source: https://mirror.example.com/plugin.tar.gz
+  - source: https://mirror.example.com/plugin.tar.gz
+    sha256: 3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817
+    platform: linux
+`), 0o600))
+
+		_, err := readLockfile(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "plugin 1: source is required")
+		assert.Contains(t, err.Error(), errChecksumInvalid.Error())
+		assert.Contains(t, err.Error(), "platform needs to have the format")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := readLockfile(filepath.Join(t.TempDir(), defaultLockfileName))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func Test_matchesPlatform(t *testing.T) {
+	assert.True(t, (&LockedPlugin{}).matchesPlatform("linux", "amd64"))
+	assert.True(t, (&LockedPlugin{Platform: "linux/amd64"}).matchesPlatform("linux", "amd64"))
+	assert.False(t, (&LockedPlugin{Platform: "darwin/arm64"}).matchesPlatform("linux", "amd64"))
+}
+
+func Test_installedSource(t *testing.T) {
+	dir := t.TempDir()
+	_, err := readInstalledSource(dir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	want := LockedPlugin{Source: "https://mirror.example.com/plugin.tar.gz", SHA256: lockedChecksum}
+	require.NoError(t, writeInstalledSource(dir, want))
+
+	got, err := readInstalledSource(dir)
+	require.NoError(t, err)
+	assert.Equal(t, want, *got)
+}
@@ -178,6 +178,7 @@ func Builder(plugins *plugin.ValidatedPlugins, existingCommands []*cobra.Command
 		InstallBuilder(pluginOpts),
 		UninstallBuilder(pluginOpts),
 		UpdateBuilder(pluginOpts),
+		SyncBuilder(pluginOpts),
 	)
 
 	return cmd
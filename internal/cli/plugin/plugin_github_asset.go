@@ -402,6 +402,30 @@ func extractPluginAssetArchiveFile(ctx context.Context, pluginArchivePath string
 	return pluginDirectoryPath, nil
 }
 
+// extractPluginArchiveToTempDirectory extracts the archive to a new temporary directory of the plugins directory,
+// so it can be renamed to its plugin directory once it's validated.
+func extractPluginArchiveToTempDirectory(ctx context.Context, pluginArchivePath string, pattern string) (string, error) {
+	pluginsDefaultDirectory, err := plugin.GetDefaultPluginDirectory()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(pluginsDefaultDirectory, os.ModePerm); err != nil {
+		return "", errCreateDirToExtractAssetFiles
+	}
+	pluginDirectoryPath, err := os.MkdirTemp(pluginsDefaultDirectory, pattern)
+	if err != nil {
+		return "", errCreateDirToExtractAssetFiles
+	}
+
+	if err = extractArchive(ctx, pluginArchivePath, pluginDirectoryPath); err != nil {
+		os.RemoveAll(pluginDirectoryPath)
+		return "", err
+	}
+
+	return pluginDirectoryPath, nil
+}
+
 func extractArchive(ctx context.Context, pluginArchivePath string, pluginDirectoryName string) error {
 	// Strip prefix
 	prefix, err := getArchivePrefix(ctx, pluginArchivePath)
@@ -0,0 +1,487 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-github/v61/github"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/plugin"
+)
+
+var (
+	errChecksumRequired    = errors.New(`a SHA-256 checksum is required to install a plugin from a local archive, a URL or an OCI registry, use the "--sha256" flag`)
+	errChecksumInvalid     = errors.New("the SHA-256 checksum must have 64 hexadecimal characters")
+	errChecksumMismatch    = errors.New("the SHA-256 checksum of the plugin archive doesn't match the expected checksum")
+	errOCIReferenceInvalid = errors.New(`OCI reference is invalid. It needs to have the format "oci://<registry>/<repository>[:<tag>|@<digest>]"`)
+	errPluginNameInvalid   = errors.New("the plugin name of the manifest must be a valid directory name")
+)
+
+const (
+	ociScheme            = "oci://"
+	sha256Prefix         = "sha256:"
+	ociUsernameEnvKey    = "ATLAS_CLI_OCI_USERNAME"
+	ociPasswordEnvKey    = "ATLAS_CLI_OCI_PASSWORD" //nolint:gosec // name of the environment variable, not a credential
+	ociTitleAnnotation   = "org.opencontainers.image.title"
+	ociIndexMediaType    = "application/vnd.oci.image.index.v1+json"
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	dockerListMediaType  = "application/vnd.docker.distribution.manifest.list.v2+json"
+	dockerManifestType   = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// pluginSource is where the archive of a plugin is downloaded from.
+type pluginSource interface {
+	// String returns the source in the format the user specified it.
+	String() string
+	download(ctx context.Context) (io.ReadCloser, error)
+}
+
+// parsePluginSource returns the source of the install argument:
+// an existing local archive, an oci:// reference, an HTTP(S) URL of an archive or a GitHub repository.
+func parsePluginSource(arg string, ghClient *github.Client, skipSignatureVerification bool) (pluginSource, error) {
+	if info, err := os.Stat(arg); err == nil && info.Mode().IsRegular() {
+		return &fileSource{path: arg}, nil
+	}
+
+	if strings.HasPrefix(arg, ociScheme) {
+		return parseOCISource(arg)
+	}
+
+	githubAsset, err := parseGithubReleaseValues(arg)
+	if err == nil {
+		return &githubSource{asset: githubAsset, ghClient: ghClient, skipSignatureVerification: skipSignatureVerification}, nil
+	}
+
+	if u, urlErr := url.Parse(arg); urlErr == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return &urlSource{url: arg, client: http.DefaultClient}, nil
+	}
+
+	return nil, err
+}
+
+// fileSource is a plugin archive on the local file system.
+type fileSource struct {
+	path string
+}
+
+func (s *fileSource) String() string {
+	return s.path
+}
+
+func (s *fileSource) download(_ context.Context) (io.ReadCloser, error) {
+	return os.Open(s.path)
+}
+
+// urlSource is a plugin archive served over HTTP(S), like an internal mirror of the GitHub releases.
+type urlSource struct {
+	url    string
+	client *http.Client
+}
+
+func (s *urlSource) String() string {
+	return s.url
+}
+
+func (s *urlSource) download(ctx context.Context) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not download %s: %w", s.url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("could not download %s: %s", s.url, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// githubSource is the release asset of a GitHub repository for the current OS and architecture.
+type githubSource struct {
+	asset                     *GithubAsset
+	ghClient                  *github.Client
+	skipSignatureVerification bool
+}
+
+func (s *githubSource) String() string {
+	return s.asset.repository()
+}
+
+func (s *githubSource) download(_ context.Context) (io.ReadCloser, error) {
+	// getReleaseAssets may fall back to an unauthenticated client; reuse the
+	// returned client for the subsequent asset downloads.
+	assets, ghClient, err := s.asset.getReleaseAssets(s.ghClient)
+	if err != nil {
+		return nil, err
+	}
+	s.ghClient = ghClient
+
+	// find correct assetID, signatureID and pubKeyID using system requirements
+	assetID, signatureID, pubKeyID, err := s.asset.getIDs(assets)
+	if err != nil {
+		return nil, err
+	}
+
+	// When signatureID and pubKeyID are 0, the signature check is skipped.
+	if s.skipSignatureVerification {
+		signatureID = 0
+		pubKeyID = 0
+	}
+
+	return s.asset.getPluginAssetsAsReadCloser(s.ghClient, assetID, signatureID, pubKeyID)
+}
+
+// ociSource is a plugin archive stored as a layer of an OCI artifact,
+// for example pushed with "oras push <registry>/<repository>:<tag> plugin_linux_x86_64.tar.gz".
+// Image indexes select the manifest of the current platform,
+// and manifests with several layers select the layer whose title contains the current OS and architecture.
+type ociSource struct {
+	ref        string
+	registry   string
+	repository string
+	reference  string
+	client     *http.Client
+	token      string
+}
+
+func parseOCISource(ref string) (*ociSource, error) {
+	registry, name, ok := strings.Cut(strings.TrimPrefix(ref, ociScheme), "/")
+	if !ok || registry == "" || name == "" {
+		return nil, errOCIReferenceInvalid
+	}
+
+	repository, reference := name, "latest"
+	if i := strings.Index(name, "@"); i != -1 {
+		repository, reference = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		repository, reference = name[:i], name[i+1:]
+	}
+	if repository == "" || reference == "" {
+		return nil, errOCIReferenceInvalid
+	}
+
+	return &ociSource{
+		ref:        ref,
+		registry:   registry,
+		repository: repository,
+		reference:  reference,
+		client:     http.DefaultClient,
+	}, nil
+}
+
+type ociPlatform struct {
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *ociPlatform      `json:"platform,omitempty"`
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Manifests []ociDescriptor `json:"manifests,omitempty"`
+	Layers    []ociDescriptor `json:"layers,omitempty"`
+}
+
+func (s *ociSource) String() string {
+	return s.ref
+}
+
+func (s *ociSource) download(ctx context.Context) (io.ReadCloser, error) {
+	manifest, err := s.manifest(ctx, s.reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifest.Manifests) > 0 {
+		descriptor, err := selectOCIManifest(manifest.Manifests, runtime.GOOS, runtime.GOARCH)
+		if err != nil {
+			return nil, fmt.Errorf("%w in %s", err, s.ref)
+		}
+		if manifest, err = s.manifest(ctx, descriptor.Digest); err != nil {
+			return nil, err
+		}
+	}
+
+	layer, err := selectOCILayer(manifest.Layers, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return nil, fmt.Errorf("%w in %s", err, s.ref)
+	}
+
+	return s.blob(ctx, layer.Digest)
+}
+
+func (s *ociSource) manifest(ctx context.Context, reference string) (*ociManifest, error) {
+	resp, err := s.get(ctx, "manifests/"+reference, ociIndexMediaType, ociManifestMediaType, dockerListMediaType, dockerManifestType)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var manifest ociManifest
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("could not read the manifest %s of %s: %w", reference, s.ref, err)
+	}
+	return &manifest, nil
+}
+
+// blob returns the content of the blob after checking it matches its digest,
+// because the registry or a proxy could serve a different content.
+func (s *ociSource) blob(ctx context.Context, digest string) (io.ReadCloser, error) {
+	resp, err := s.get(ctx, "blobs/"+digest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not download the blob %s of %s: %w", digest, s.ref, err)
+	}
+
+	sum := sha256.Sum256(content)
+	if got := sha256Prefix + hex.EncodeToString(sum[:]); got != digest {
+		return nil, fmt.Errorf("the blob %s of %s has the digest %s", digest, s.ref, got)
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *ociSource) url(path string) string {
+	scheme := "https"
+	if host := strings.Split(s.registry, ":")[0]; host == "localhost" || host == "127.0.0.1" {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s/v2/%s/%s", scheme, s.registry, s.repository, path)
+}
+
+// get requests the path of the repository, and authenticates with the token service of the registry
+// when the registry challenges the request.
+func (s *ociSource) get(ctx context.Context, path string, accept ...string) (*http.Response, error) {
+	resp, err := s.do(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && s.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if s.token, err = s.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = s.do(ctx, path, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("could not download %s of %s: %s", path, s.ref, resp.Status)
+	}
+	return resp, nil
+}
+
+func (s *ociSource) do(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	} else if username := os.Getenv(ociUsernameEnvKey); username != "" {
+		req.SetBasicAuth(username, os.Getenv(ociPasswordEnvKey))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not download %s of %s: %w", path, s.ref, err)
+	}
+	return resp, nil
+}
+
+var challengeParamReg = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// authenticate requests a token to the token service of a Bearer challenge,
+// see https://distribution.github.io/distribution/spec/auth/token/.
+func (s *ociSource) authenticate(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("could not authenticate to %s, set the %s and %s environment variables", s.registry, ociUsernameEnvKey, ociPasswordEnvKey)
+	}
+
+	params := map[string]string{}
+	for _, match := range challengeParamReg.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("could not authenticate to %s: invalid realm %q", s.registry, params["realm"])
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := params[key]; ok {
+			query.Set(key, value)
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if username := os.Getenv(ociUsernameEnvKey); username != "" {
+		req.SetBasicAuth(username, os.Getenv(ociPasswordEnvKey))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not authenticate to %s: %w", s.registry, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not authenticate to %s: %s", s.registry, resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("could not authenticate to %s: %w", s.registry, err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+func archNames(goarch string) []string {
+	return append([]string{goarch}, architectureAliases[goarch]...)
+}
+
+func selectOCIManifest(manifests []ociDescriptor, goos, goarch string) (*ociDescriptor, error) {
+	for _, m := range manifests {
+		if m.Platform != nil && m.Platform.OS == goos && m.Platform.Architecture == goarch {
+			return &m, nil
+		}
+	}
+	return nil, fmt.Errorf("no manifest found for OS=%s, arch=%s", goos, goarch)
+}
+
+func selectOCILayer(layers []ociDescriptor, goos, goarch string) (*ociDescriptor, error) {
+	if len(layers) == 1 {
+		return &layers[0], nil
+	}
+
+	for _, layer := range layers {
+		title := strings.ToLower(layer.Annotations[ociTitleAnnotation])
+		if !strings.Contains(title, goos) {
+			continue
+		}
+		for _, arch := range archNames(goarch) {
+			if strings.Contains(title, arch) {
+				return &layer, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no layer found for OS=%s, arch=%s", goos, goarch)
+}
+
+// normalizeChecksum returns the lowercase hexadecimal SHA-256 checksum, without the optional "sha256:" prefix.
+func normalizeChecksum(checksum string) (string, error) {
+	checksum = strings.ToLower(strings.TrimPrefix(checksum, sha256Prefix))
+	if b, err := hex.DecodeString(checksum); err != nil || len(b) != sha256.Size {
+		return "", errChecksumInvalid
+	}
+	return checksum, nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// downloadPluginArchive saves the plugin archive of the source in the default plugin directory
+// and returns its path and SHA-256 checksum. When checksum isn't empty, the archive must match it.
+func downloadPluginArchive(ctx context.Context, source pluginSource, checksum string) (string, string, error) {
+	rc, err := source.download(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	pluginArchiveFilePath, err := saveReadCloserToPluginAssetArchiveFile(rc)
+	if err != nil {
+		return "", "", err
+	}
+
+	digest, err := fileChecksum(pluginArchiveFilePath)
+	if err == nil && checksum != "" {
+		var want string
+		if want, err = normalizeChecksum(checksum); err == nil && want != digest {
+			err = fmt.Errorf("%w: expected %s, got %s", errChecksumMismatch, want, digest)
+		}
+	}
+	if err != nil {
+		os.Remove(pluginArchiveFilePath)
+		return "", "", err
+	}
+
+	return pluginArchiveFilePath, digest, nil
+}
+
+// pluginDirectoryName returns the name of the directory of an installed plugin,
+// GitHub plugins keep the "<owner>@<name>" directory that update expects.
+// Other plugins use the name of their manifest, which must be a single path element of the plugins directory.
+func pluginDirectoryName(source pluginSource, manifest *plugin.Manifest) (string, error) {
+	if s, ok := source.(*githubSource); ok {
+		return s.asset.getPluginDirectoryName(), nil
+	}
+
+	name := manifest.Name
+	if name == "" || name == "." || !filepath.IsLocal(name) || filepath.Base(name) != name || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("%w: %q", errPluginNameInvalid, name)
+	}
+	return name, nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parsePluginSource(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "plugin.tar.gz")
+	require.NoError(t, os.WriteFile(archivePath, []byte("archive"), 0o600))
+
+	tests := []struct {
+		name       string
+		arg        string
+		want       pluginSource
+		wantString string
+	}{
+		{name: "local archive", arg: archivePath, want: &fileSource{}, wantString: archivePath},
+		{name: "github values", arg: "mongodb/atlas-cli-plugin-example@1.0.4", want: &githubSource{}, wantString: "mongodb/atlas-cli-plugin-example"},
+		{name: "github url", arg: "https://github.com/mongodb/atlas-cli-plugin-example", want: &githubSource{}, wantString: "mongodb/atlas-cli-plugin-example"},
+		{name: "archive url", arg: "https://mirror.example.com/plugins/plugin_linux_x86_64.tar.gz", want: &urlSource{}, wantString: "https://mirror.example.com/plugins/plugin_linux_x86_64.tar.gz"},
+		{name: "oci reference", arg: "oci://registry.example.com/plugins/atlas-cli-plugin-example:1.0.4", want: &ociSource{}, wantString: "oci://registry.example.com/plugins/atlas-cli-plugin-example:1.0.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePluginSource(tt.arg, nil, false)
+			require.NoError(t, err)
+			assert.IsType(t, tt.want, got)
+			assert.Equal(t, tt.wantString, got.String())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parsePluginSource("invalid", nil, false)
+		require.ErrorIs(t, err, errGithubParametersInvalid)
+	})
+}
+
+func Test_parseOCISource(t *testing.T) {
+	tests := []struct {
+		ref            string
+		wantRegistry   string
+		wantRepository string
+		wantReference  string
+		wantErr        bool
+	}{
+		{ref: "oci://registry.example.com/plugins/atlas-cli-plugin-example:1.0.4", wantRegistry: "registry.example.com", wantRepository: "plugins/atlas-cli-plugin-example", wantReference: "1.0.4"},
+		{ref: "oci://localhost:5000/atlas-cli-plugin-example", wantRegistry: "localhost:5000", wantRepository: "atlas-cli-plugin-example", wantReference: "latest"},
+		{ref: "oci://registry.example.com/atlas-cli-plugin-example@sha256:3b1f", wantRegistry: "registry.example.com", wantRepository: "atlas-cli-plugin-example", wantReference: "sha256:3b1f"},
+		{ref: "oci://registry.example.com", wantErr: true},
+		{ref: "oci://registry.example.com/atlas-cli-plugin-example:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := parseOCISource(tt.ref)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errOCIReferenceInvalid)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRegistry, got.registry)
+			assert.Equal(t, tt.wantRepository, got.repository)
+			assert.Equal(t, tt.wantReference, got.reference)
+		})
+	}
+}
+
+func Test_urlSource_download(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/plugin.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("archive"))
+	}))
+	defer srv.Close()
+
+	source := &urlSource{url: srv.URL + "/plugin.tar.gz", client: srv.Client()}
+	rc, err := source.download(t.Context())
+	require.NoError(t, err)
+	defer rc.Close()
+	content, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(content))
+
+	source.url = srv.URL + "/missing.tar.gz"
+	_, err = source.download(t.Context())
+	require.Error(t, err)
+}
+
+func digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return sha256Prefix + hex.EncodeToString(sum[:])
+}
+
+// newRegistry returns a registry that requires a token and serves an image index
+// with the manifest of the current platform, which has a layer per platform.
+func newRegistry(t *testing.T, archive []byte, blob []byte) *httptest.Server {
+	t.Helper()
+
+	other := []byte("other archive")
+	manifest, err := json.Marshal(ociManifest{
+		MediaType: ociManifestMediaType,
+		Layers: []ociDescriptor{
+			{Digest: digest(other), Annotations: map[string]string{ociTitleAnnotation: "plugin_plan9_mips.tar.gz"}},
+			{Digest: digest(archive), Annotations: map[string]string{ociTitleAnnotation: "plugin_" + runtime.GOOS + "_" + runtime.GOARCH + ".tar.gz"}},
+		},
+	})
+	require.NoError(t, err)
+	index, err := json.Marshal(ociManifest{
+		MediaType: ociIndexMediaType,
+		Manifests: []ociDescriptor{
+			{Digest: "sha256:other", Platform: &ociPlatform{OS: "plan9", Architecture: "mips"}},
+			{Digest: digest(manifest), Platform: &ociPlatform{OS: runtime.GOOS, Architecture: runtime.GOARCH}},
+		},
+	})
+	require.NoError(t, err)
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			assert.Equal(t, "repository:plugin:pull", r.URL.Query().Get("scope"))
+			_, _ = w.Write([]byte(`{"token":"token"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="registry",scope="repository:plugin:pull"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/plugin/manifests/1.0.4":
+			_, _ = w.Write(index)
+		case "/v2/plugin/manifests/" + digest(manifest):
+			_, _ = w.Write(manifest)
+		case "/v2/plugin/blobs/" + digest(archive):
+			_, _ = w.Write(blob)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return srv
+}
+
+func Test_ociSource_download(t *testing.T) {
+	archive := []byte("archive")
+
+	t.Run("valid", func(t *testing.T) {
+		srv := newRegistry(t, archive, archive)
+		defer srv.Close()
+
+		source, err := parseOCISource("oci://" + srv.Listener.Addr().String() + "/plugin:1.0.4")
+		require.NoError(t, err)
+		rc, err := source.download(t.Context())
+		require.NoError(t, err)
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, archive, content)
+	})
+
+	t.Run("blob doesn't match its digest", func(t *testing.T) {
+		srv := newRegistry(t, archive, []byte("tampered"))
+		defer srv.Close()
+
+		source, err := parseOCISource("oci://" + srv.Listener.Addr().String() + "/plugin:1.0.4")
+		require.NoError(t, err)
+		_, err = source.download(t.Context())
+		require.Error(t, err)
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		srv := newRegistry(t, archive, archive)
+		defer srv.Close()
+
+		source, err := parseOCISource("oci://" + srv.Listener.Addr().String() + "/plugin:2.0.0")
+		require.NoError(t, err)
+		_, err = source.download(t.Context())
+		require.Error(t, err)
+	})
+}
+
+func Test_selectOCILayer(t *testing.T) {
+	layers := []ociDescriptor{
+		{Digest: "sha256:1", Annotations: map[string]string{ociTitleAnnotation: "plugin_darwin_arm64.tar.gz"}},
+		{Digest: "sha256:2", Annotations: map[string]string{ociTitleAnnotation: "plugin_linux_x86_64.tar.gz"}},
+	}
+
+	got, err := selectOCILayer(layers, "linux", "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:2", got.Digest)
+
+	_, err = selectOCILayer(layers, "windows", "amd64")
+	require.Error(t, err)
+
+	got, err = selectOCILayer(layers[:1], "windows", "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1", got.Digest)
+}
+
+func Test_normalizeChecksum(t *testing.T) {
+	const checksum = "3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817"
+
+	got, err := normalizeChecksum("sha256:" + checksum)
+	require.NoError(t, err)
+	assert.Equal(t, checksum, got)
+
+	got, err = normalizeChecksum("3B1F6E0B9D5C4A2F8E7D6C5B4A39281706F5E4D3C2B1A09F8E7D6C5B4A392817")
+	require.NoError(t, err)
+	assert.Equal(t, checksum, got)
+
+	_, err = normalizeChecksum(checksum[:10])
+	require.ErrorIs(t, err, errChecksumInvalid)
+}
+
+func Test_pluginDirectoryName(t *testing.T) {
+	source := &fileSource{}
+
+	for _, name := range []string{"atlas-cli-plugin-example", "plugin.v1"} {
+		got, err := pluginDirectoryName(source, &plugin.Manifest{Name: name})
+		require.NoError(t, err)
+		assert.Equal(t, name, got)
+	}
+
+	for _, name := range []string{"", ".", "..", "../../x", "a/b", `a\b`, "/tmp/x"} {
+		t.Run(name, func(t *testing.T) {
+			_, err := pluginDirectoryName(source, &plugin.Manifest{Name: name})
+			require.ErrorIs(t, err, errPluginNameInvalid)
+		})
+	}
+}
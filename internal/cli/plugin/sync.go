@@ -0,0 +1,179 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-github/v61/github"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/plugin"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/cobra"
+)
+
+const syncTempDirectoryPattern = "plugin.sync-*"
+
+type SyncOpts struct {
+	cli.OutputOpts
+	Opts
+	file     string
+	ghClient *github.Client
+}
+
+// isUpToDate returns true when an installed plugin was installed from the source and checksum of the locked plugin.
+func (opts *SyncOpts) isUpToDate(locked LockedPlugin) bool {
+	for _, p := range opts.getValidPlugins() {
+		source, err := readInstalledSource(p.PluginDirectoryPath)
+		if err == nil && source.Source == locked.Source && source.SHA256 == locked.SHA256 {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveSource resolves the relative archive paths of the lockfile against the directory of the lockfile,
+// so sync doesn't depend on the working directory. Other sources are returned unchanged.
+func (opts *SyncOpts) resolveSource(source string) string {
+	if filepath.IsAbs(source) || strings.Contains(source, "://") {
+		return source
+	}
+
+	path := filepath.Join(filepath.Dir(opts.file), source)
+	if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+		return path
+	}
+	return source
+}
+
+// syncPlugin installs the locked plugin, or replaces the installed plugin with the same name.
+func (opts *SyncOpts) syncPlugin(ctx context.Context, locked LockedPlugin) error {
+	source, err := parsePluginSource(opts.resolveSource(locked.Source), opts.ghClient, false)
+	if err != nil {
+		return err
+	}
+
+	// the checksum is mandatory in the lockfile, so every source is checked
+	pluginArchiveFilePath, _, err := downloadPluginArchive(ctx, source, locked.SHA256)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(pluginArchiveFilePath)
+
+	tempPluginDirectoryPath, err := extractPluginArchiveToTempDirectory(ctx, pluginArchiveFilePath, syncTempDirectoryPattern)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDirectoryPath)
+
+	manifest, err := plugin.GetManifestFromPluginDirectory(tempPluginDirectoryPath)
+	if err != nil {
+		return err
+	}
+
+	existingPlugin, err := opts.findPluginWithName(manifest.Name)
+	if err != nil {
+		installOpts := &InstallOpts{Opts: opts.Opts, source: source}
+		return installOpts.install(tempPluginDirectoryPath, locked)
+	}
+
+	updateOpts := &UpdateOpts{Opts: opts.Opts}
+	if err := updateOpts.validatePlugin(tempPluginDirectoryPath); err != nil {
+		return err
+	}
+	if err := writeInstalledSource(tempPluginDirectoryPath, locked); err != nil {
+		return err
+	}
+	directoryName, err := pluginDirectoryName(source, manifest)
+	if err != nil {
+		return err
+	}
+	return replacePluginDirectory(existingPlugin.PluginDirectoryPath, tempPluginDirectoryPath, directoryName)
+}
+
+func (opts *SyncOpts) Run(ctx context.Context) error {
+	lockfile, err := readLockfile(opts.file)
+	if err != nil {
+		return err
+	}
+
+	for _, locked := range lockfile.Plugins {
+		if !locked.matchesPlatform(runtime.GOOS, runtime.GOARCH) {
+			continue
+		}
+
+		if opts.isUpToDate(locked) {
+			opts.Print(fmt.Sprintf("Plugin %s is up to date", locked.Source))
+			continue
+		}
+
+		if err := opts.syncPlugin(ctx, locked); err != nil {
+			return fmt.Errorf("could not install plugin %s: %w", locked.Source, err)
+		}
+		opts.Print(fmt.Sprintf("Plugin %s successfully installed", locked.Source))
+	}
+
+	return nil
+}
+
+func SyncBuilder(pluginOpts *Opts) *cobra.Command {
+	opts := &SyncOpts{
+		ghClient: NewAuthenticatedGithubClient(),
+	}
+	opts.Opts = *pluginOpts
+
+	const use = "sync"
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: cli.GenerateAliases(use),
+		Short:   "Install the Atlas CLI plugins pinned in a lockfile.",
+		Long: `Install the exact Atlas CLI plugin archives pinned in the atlas-plugins.lock file of the current directory, so every environment of a repository, like CI, uses the same plugins.
+Each plugin of the lockfile has a source, which is a GitHub repository with a version, the path or URL of a plugin archive, or an OCI reference, and the SHA-256 checksum of the archive.
+Relative archive paths are resolved against the directory of the lockfile.
+Plugins with a platform, like linux/amd64, are only installed on that platform.
+Plugins already installed from the same source and checksum are skipped, and installed plugins with the same name are replaced.
+
+The lockfile has the following format:
+
+  plugins:
+    - source: mongodb/atlas-cli-plugin-example@1.0.4
+      sha256: 3b1f6e0b9d5c4a2f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a392817
+      platform: linux/amd64
+    - source: oci://registry.example.com/atlas-cli-plugin-kubernetes:1.1.7
+      sha256: 0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d
+`,
+		Args: require.NoArgs,
+		Example: `  # Install the plugins pinned in the atlas-plugins.lock file of the current directory:
+  atlas plugin sync
+
+  # Install the plugins pinned in another lockfile:
+  atlas plugin sync --file ci/atlas-plugins.lock`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, flag.File, flag.FileShort, defaultLockfileName, usage.PluginLockfile)
+	_ = cmd.MarkFlagFilename(flag.File)
+
+	return cmd
+}
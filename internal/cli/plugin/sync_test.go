@@ -0,0 +1,60 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isUpToDate(t *testing.T) {
+	dir := t.TempDir()
+	installed := LockedPlugin{Source: "https://mirror.example.com/plugin_1.0.4.tar.gz", SHA256: lockedChecksum}
+	require.NoError(t, writeInstalledSource(dir, installed))
+
+	opts := &SyncOpts{
+		Opts: Opts{
+			plugins: &plugin.ValidatedPlugins{
+				ValidPlugins: []*plugin.Plugin{
+					{Name: "manual", PluginDirectoryPath: t.TempDir()},
+					{Name: "plugin", PluginDirectoryPath: dir},
+				},
+			},
+		},
+	}
+
+	assert.True(t, opts.isUpToDate(installed))
+	assert.False(t, opts.isUpToDate(LockedPlugin{Source: installed.Source, SHA256: "0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d"}))
+	assert.False(t, opts.isUpToDate(LockedPlugin{Source: "https://mirror.example.com/plugin_1.0.5.tar.gz", SHA256: lockedChecksum}))
+}
+
+func Test_resolveSource(t *testing.T) {
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "plugins", "plugin.tar.gz")
+	require.NoError(t, os.MkdirAll(filepath.Dir(archivePath), 0o700))
+	require.NoError(t, os.WriteFile(archivePath, []byte("archive"), 0o600))
+
+	opts := &SyncOpts{file: filepath.Join(dir, defaultLockfileName)}
+
+	assert.Equal(t, archivePath, opts.resolveSource(filepath.Join("plugins", "plugin.tar.gz")))
+	assert.Equal(t, archivePath, opts.resolveSource(archivePath))
+	assert.Equal(t, "mongodb/atlas-cli-plugin-example@1.0.4", opts.resolveSource("mongodb/atlas-cli-plugin-example@1.0.4"))
+	assert.Equal(t, "https://mirror.example.com/plugin.tar.gz", opts.resolveSource("https://mirror.example.com/plugin.tar.gz"))
+}
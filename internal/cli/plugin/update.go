@@ -187,9 +187,14 @@ func (opts *UpdateOpts) updatePlugin(ctx context.Context, githubAssetRelease *Gi
 		return err
 	}
 
+	return replacePluginDirectory(existingPlugin.PluginDirectoryPath, tempPluginDirectoryPath, githubAssetRelease.getPluginDirectoryName())
+}
+
+// replacePluginDirectory replaces the directory of an existing plugin with the directory of its new version.
+func replacePluginDirectory(existingPluginPath, newPluginPath, newDirectoryName string) error {
 	// rename old plugin directory to <plugin-directory>_old so we can rollback in case something goes wrong
-	oldPluginDirectoryPath := existingPlugin.PluginDirectoryPath + "_old"
-	err = os.Rename(existingPlugin.PluginDirectoryPath, oldPluginDirectoryPath)
+	oldPluginDirectoryPath := existingPluginPath + "_old"
+	err := os.Rename(existingPluginPath, oldPluginDirectoryPath)
 	if err != nil {
 		return err
 	}
@@ -197,10 +202,10 @@ func (opts *UpdateOpts) updatePlugin(ctx context.Context, githubAssetRelease *Gi
 
 	// rename temp plugin directory to actual name in the same parent directory as the existing plugin,
 	// so plugins installed in ATLAS_CLI_EXTRA_PLUGIN_DIRECTORY are updated in place.
-	pluginDirectoryPath := pluginTargetDirectory(existingPlugin.PluginDirectoryPath, githubAssetRelease.getPluginDirectoryName())
-	err = os.Rename(tempPluginDirectoryPath, pluginDirectoryPath)
+	pluginDirectoryPath := pluginTargetDirectory(existingPluginPath, newDirectoryName)
+	err = os.Rename(newPluginPath, pluginDirectoryPath)
 	if err != nil {
-		err = os.Rename(oldPluginDirectoryPath, existingPlugin.PluginDirectoryPath)
+		err = os.Rename(oldPluginDirectoryPath, existingPluginPath)
 		return err
 	}
 
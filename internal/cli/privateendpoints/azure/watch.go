@@ -52,8 +52,8 @@ func (opts *WatchOpts) watcher() (any, bool, error) {
 	return nil, result.GetStatus() == "AVAILABLE" || result.GetStatus() == "FAILED", nil
 }
 
-func (opts *WatchOpts) Run() error {
-	if _, err := opts.Watch(opts.watcher); err != nil {
+func (opts *WatchOpts) Run(ctx context.Context) error {
+	if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 		return err
 	}
 
@@ -86,9 +86,9 @@ You can interrupt the command's polling at any time with CTRL-C.
 				opts.InitOutput(cmd.OutOrStdout(), watchTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 	}
 
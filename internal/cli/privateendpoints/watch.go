@@ -52,8 +52,8 @@ func (opts *WatchOpts) watcher() (any, bool, error) {
 	return nil, result.Status == "WAITING_FOR_USER" || result.Status == "FAILED", nil
 }
 
-func (opts *WatchOpts) Run() error {
-	if _, err := opts.Watch(opts.watcher); err != nil {
+func (opts *WatchOpts) Run(ctx context.Context) error {
+	if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 		return err
 	}
 
@@ -82,9 +82,9 @@ You can interrupt the command's polling at any time with CTRL-C.`,
 			"output": watchTemplate,
 		},
 		Example: `  atlas privateEndpoint watch vpce-abcdefg0123456789`,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.id = args[0]
-			return opts.Run()
+			return opts.Run(cmd.Context())
 		},
 		Deprecated: "Please use atlas privateEndpoints aws watch [--projectId projectId]",
 	}
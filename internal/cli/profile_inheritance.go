@@ -0,0 +1,148 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const ExtendsProperty = "extends"
+
+var (
+	// InheritedProperties are the properties that a profile inherits from the profile it extends when it doesn't set them.
+	InheritedProperties = []string{
+		"output",
+		"service",
+		"ops_manager_url",
+		"api_version",
+		"auth_type",
+		"public_api_key",
+		"private_api_key",
+		"access_token",
+		"refresh_token",
+		"client_id",
+		"client_secret",
+	}
+
+	errProfileNotFound = errors.New("profile not found")
+	errExtendsCycle    = errors.New("profile inheritance cycle")
+
+	// inherited are the properties that InheritProfile exposed for the current profile.
+	inherited map[string]InheritedValue
+)
+
+// ProfileReader reads the properties of any profile, config.Store implements it.
+type ProfileReader interface {
+	GetProfileNames() []string
+	GetProfileValue(profileName string, propertyName string) any
+	GetProfileStringMap(profileName string) map[string]string
+}
+
+// InheritedValue is a property value that a profile inherits.
+type InheritedValue struct {
+	Value any
+	From  string
+}
+
+func isSet(v any) bool {
+	if v == nil {
+		return false
+	}
+	s, ok := v.(string)
+	return !ok || s != ""
+}
+
+func extendedProfile(r ProfileReader, name string) string {
+	base, _ := r.GetProfileValue(name, ExtendsProperty).(string)
+	return strings.TrimSpace(base)
+}
+
+// ExtendsChain returns the profiles that name extends, the closest first.
+func ExtendsChain(r ProfileReader, name string) ([]string, error) {
+	base := extendedProfile(r, name)
+	if base == "" {
+		return nil, nil
+	}
+
+	names := r.GetProfileNames()
+	visited := []string{name}
+	var chain []string
+	for ; base != ""; base = extendedProfile(r, base) {
+		if slices.Contains(visited, base) {
+			return nil, fmt.Errorf("%w: %s", errExtendsCycle, strings.Join(append(visited, base), " -> "))
+		}
+		if !slices.Contains(names, base) {
+			return nil, fmt.Errorf("%w: %q, extended by %q", errProfileNotFound, base, visited[len(visited)-1])
+		}
+		visited = append(visited, base)
+		chain = append(chain, base)
+	}
+	return chain, nil
+}
+
+// InheritedValues returns the properties that name doesn't set and inherits from the profiles it extends.
+func InheritedValues(r ProfileReader, name string) (map[string]InheritedValue, error) {
+	chain, err := ExtendsChain(r, name)
+	if err != nil || len(chain) == 0 {
+		return nil, err
+	}
+
+	values := map[string]InheritedValue{}
+	for _, property := range InheritedProperties {
+		if isSet(r.GetProfileValue(name, property)) {
+			continue
+		}
+		for _, base := range chain {
+			if v := r.GetProfileValue(base, property); isSet(v) {
+				values[property] = InheritedValue{Value: v, From: base}
+				break
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// InheritProfile exposes the properties that name inherits as MONGODB_ATLAS_ environment variables, unless they are
+// already set. The environment is a read-only layer of the configuration: saving the profile only writes its own
+// properties, never the inherited ones.
+func InheritProfile(r ProfileReader, name string) error {
+	values, err := InheritedValues(r, name)
+	if err != nil {
+		return err
+	}
+
+	inherited = map[string]InheritedValue{}
+	for property, v := range values {
+		if IsSetInEnv(property) {
+			continue
+		}
+		if err := os.Setenv(EnvName(property), fmt.Sprint(v.Value)); err != nil {
+			return err
+		}
+		inherited[property] = v
+	}
+	return nil
+}
+
+// InheritedFrom returns the profile that the current profile inherits property from, if any.
+func InheritedFrom(property string) (string, bool) {
+	v, ok := inherited[property]
+	return v.From, ok
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mongodb/atlas-cli-core/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newProfileStore(t *testing.T, profiles map[string]map[string]any) *mocks.MockStore {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockStore := mocks.NewMockStore(ctrl)
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	mockStore.EXPECT().GetProfileNames().Return(names).AnyTimes()
+	mockStore.EXPECT().GetProfileValue(gomock.Any(), gomock.Any()).DoAndReturn(func(name, property string) any {
+		return profiles[name][property]
+	}).AnyTimes()
+
+	return mockStore
+}
+
+func TestExtendsChain(t *testing.T) {
+	tests := []struct {
+		name     string
+		profiles map[string]map[string]any
+		want     []string
+		wantErr  error
+	}{
+		{
+			name:     "no extends",
+			profiles: map[string]map[string]any{"prod": {}},
+		},
+		{
+			name: "chain",
+			profiles: map[string]map[string]any{
+				"prod":   {ExtendsProperty: "region"},
+				"region": {ExtendsProperty: "base"},
+				"base":   {},
+			},
+			want: []string{"region", "base"},
+		},
+		{
+			name:     "missing base",
+			profiles: map[string]map[string]any{"prod": {ExtendsProperty: "base"}},
+			wantErr:  errProfileNotFound,
+		},
+		{
+			name: "cycle",
+			profiles: map[string]map[string]any{
+				"prod": {ExtendsProperty: "base"},
+				"base": {ExtendsProperty: "prod"},
+			},
+			wantErr: errExtendsCycle,
+		},
+		{
+			name:     "self",
+			profiles: map[string]map[string]any{"prod": {ExtendsProperty: "prod"}},
+			wantErr:  errExtendsCycle,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtendsChain(newProfileStore(t, tt.profiles), "prod")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInheritedValues(t *testing.T) {
+	s := newProfileStore(t, map[string]map[string]any{
+		"prod":   {ExtendsProperty: "region", "project_id": "proj123", "output": "plaintext"},
+		"region": {ExtendsProperty: "base", "ops_manager_url": "https://cloud.mongodbgov.com/", "output": "json"},
+		"base":   {"service": "cloud", "client_id": "id", "client_secret": "secret", "org_id": "org123", "api_version": ""},
+	})
+
+	got, err := InheritedValues(s, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]InheritedValue{
+		"ops_manager_url": {Value: "https://cloud.mongodbgov.com/", From: "region"},
+		"service":         {Value: "cloud", From: "base"},
+		"client_id":       {Value: "id", From: "base"},
+		"client_secret":   {Value: "secret", From: "base"},
+	}, got)
+}
+
+func TestInheritProfile(t *testing.T) {
+	s := newProfileStore(t, map[string]map[string]any{
+		"prod": {ExtendsProperty: "base"},
+		"base": {"service": "cloud", "output": "json", "refresh_token": "token"},
+	})
+
+	// output is set with an environment variable
+	t.Setenv(EnvName("output"), "plaintext")
+	t.Setenv(EnvName("service"), "")
+	t.Setenv(EnvName("refresh_token"), "")
+
+	require.NoError(t, InheritProfile(s, "prod"))
+	assert.Equal(t, "plaintext", os.Getenv(EnvName("output")))
+	assert.Equal(t, "cloud", os.Getenv(EnvName("service")))
+	assert.Equal(t, "token", os.Getenv(EnvName("refresh_token")))
+
+	from, ok := InheritedFrom("refresh_token")
+	assert.True(t, ok)
+	assert.Equal(t, "base", from)
+	_, ok = InheritedFrom("output")
+	assert.False(t, ok)
+}
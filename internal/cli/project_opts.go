@@ -28,12 +28,12 @@ type ProjectOpts struct {
 }
 
 // ConfigProjectID returns the project id.
-// If the id is empty, it caches it after querying config.
+// If the id is empty, it caches it after querying the environment, the directory context file and config.
 func (opts *ProjectOpts) ConfigProjectID() string {
 	if opts.ProjectID != "" {
 		return opts.ProjectID
 	}
-	opts.ProjectID = config.ProjectID()
+	opts.ProjectID = ContextProjectID(config.ProjectID)
 	return opts.ProjectID
 }
 
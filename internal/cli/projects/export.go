@@ -0,0 +1,283 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projects
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/manifest"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/file"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	atlasClustersPinned "go.mongodb.org/atlas-sdk/v20240530005/admin"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+const (
+	exportTemplate = "Exported {{len .Files}} file(s) from project '{{.ProjectID}}' to '{{.Path}}'.\n"
+	manifestFile   = "manifest.yaml"
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=export_mock_test.go -package=projects -source=export.go
+
+type ProjectExporter interface {
+	manifest.Exporter
+	DescribeSchedule(string, string) (*atlasClustersPinned.DiskBackupSnapshotSchedule, error)
+	DescribeCompliancePolicy(string) (*atlasv2.DataProtectionSettings20231001, error)
+	Integrations(string) (*atlasv2.PaginatedIntegration, error)
+}
+
+// ExportResult lists the files written by the export command, relative to Path.
+type ExportResult struct {
+	ProjectID string   `json:"projectId"`
+	Path      string   `json:"path"`
+	Files     []string `json:"files"`
+}
+
+type ExportOpts struct {
+	cli.ProjectOpts
+	cli.OutputOpts
+	out   string
+	fs    afero.Fs
+	store ProjectExporter
+}
+
+func (opts *ExportOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *ExportOpts) Run() error {
+	projectID := opts.ConfigProjectID()
+	result := &ExportResult{
+		ProjectID: projectID,
+		Path:      opts.out,
+		Files:     []string{},
+	}
+
+	m, err := manifest.Export(opts.store, projectID)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.save(result, manifestFile, m); err != nil {
+		return err
+	}
+
+	if err := opts.exportResources(result, m); err != nil {
+		return err
+	}
+
+	if err := opts.exportBackupSettings(result, m.Clusters); err != nil {
+		return err
+	}
+
+	if err := opts.exportIntegrations(result); err != nil {
+		return err
+	}
+
+	return opts.Print(result)
+}
+
+// exportResources writes every resource of the manifest to its own file,
+// using the format expected by the --file flag of the matching create command.
+func (opts *ExportOpts) exportResources(result *ExportResult, m *manifest.Manifest) error {
+	for _, c := range m.Clusters {
+		if err := opts.save(result, filepath.Join("clusters", filename(c.String("name"))+".json"), c); err != nil {
+			return err
+		}
+	}
+
+	for _, index := range m.SearchIndexes {
+		clusterName := index.String("clusterName")
+		name := filename(index.String("database") + "." + index.String("collectionName") + "." + index.String("name"))
+
+		// the cluster is provided with --clusterName
+		body := make(manifest.Resource, len(index))
+		for k, v := range index {
+			if k != "clusterName" {
+				body[k] = v
+			}
+		}
+
+		if err := opts.save(result, filepath.Join("searchIndexes", filename(clusterName), name+".json"), body); err != nil {
+			return err
+		}
+	}
+
+	for i, alert := range m.AlertConfigurations {
+		name := strconv.Itoa(i+1) + "_" + filename(alert.String("eventTypeName"))
+		if err := opts.save(result, filepath.Join("alertConfigurations", name+".json"), alert); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range m.DatabaseUsers {
+		name := filename(user.String("databaseName") + "_" + user.String("username"))
+		if err := opts.save(result, filepath.Join("databaseUsers", name+".json"), user); err != nil {
+			return err
+		}
+	}
+
+	for _, role := range m.CustomDBRoles {
+		if err := opts.save(result, filepath.Join("customDbRoles", filename(role.String("roleName"))+".json"), role); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range m.AccessList {
+		name := entry.String("awsSecurityGroup") + entry.String("cidrBlock") + entry.String("ipAddress")
+		if err := opts.save(result, filepath.Join("accessList", filename(name)+".json"), entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportBackupSettings writes the compliance policy and the backup schedule of clusters with backups enabled.
+func (opts *ExportOpts) exportBackupSettings(result *ExportResult, clusters []manifest.Resource) error {
+	projectID := opts.ConfigProjectID()
+	policy, err := opts.store.DescribeCompliancePolicy(projectID)
+	if err != nil {
+		return err
+	}
+
+	if policy != nil {
+		// read only fields, the project is provided with --projectId
+		policy.ProjectId = nil
+		policy.State = nil
+		policy.UpdatedDate = nil
+		policy.UpdatedUser = nil
+		if err := opts.save(result, "compliancePolicy.json", policy); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range clusters {
+		if backupEnabled, _ := c["backupEnabled"].(bool); !backupEnabled {
+			continue
+		}
+
+		clusterName := c.String("name")
+		schedule, err := opts.store.DescribeSchedule(projectID, clusterName)
+		if err != nil {
+			return err
+		}
+
+		// read only fields, the cluster is provided with --clusterName
+		schedule.ClusterId = nil
+		schedule.ClusterName = nil
+		schedule.NextSnapshot = nil
+		schedule.Links = nil
+
+		if err := opts.save(result, filepath.Join("backupSchedules", filename(clusterName)+".json"), schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportIntegrations writes the third party integrations, Atlas redacts their credentials.
+func (opts *ExportOpts) exportIntegrations(result *ExportResult) error {
+	integrations, err := opts.store.Integrations(opts.ConfigProjectID())
+	if err != nil {
+		return err
+	}
+
+	for _, integration := range integrations.GetResults() {
+		if err := opts.save(result, filepath.Join("integrations", filename(integration.GetType())+".json"), integration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (opts *ExportOpts) save(result *ExportResult, name string, data any) error {
+	if err := file.Save(opts.fs, filepath.Join(opts.out, name), data); err != nil {
+		return fmt.Errorf("failed to save %s: %w", name, err)
+	}
+
+	result.Files = append(result.Files, filepath.ToSlash(name))
+	return nil
+}
+
+// filename replaces characters that can't be safely used in a file name, like the slashes of AWS IAM ARNs.
+func filename(name string) string {
+	return unsafeFilenameChars.ReplaceAllString(name, "_")
+}
+
+// atlas projects export --out <dir> [--projectId projectId].
+func ExportBuilder() *cobra.Command {
+	opts := &ExportOpts{
+		fs: afero.NewOsFs(),
+	}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write the configuration of a project to a directory.",
+		Long: `The directory contains a manifest.yaml file that you can use with the apply and diff commands, and one JSON file per resource that you can use with the --file flag of the matching command:
+  clusters/<clusterName>.json: atlas clusters create --file
+  searchIndexes/<clusterName>/<database>.<collection>.<indexName>.json: atlas clusters search indexes create --clusterName <clusterName> --file
+  alertConfigurations/<n>_<eventTypeName>.json: atlas alerts settings create --file
+  backupSchedules/<clusterName>.json: atlas backups schedule update --clusterName <clusterName> --file
+  compliancePolicy.json: atlas backups compliancepolicy setup --file
+Database users, custom database roles, IP access list entries and third-party integrations are written using the Atlas Administration API request body format.
+Passwords and integration credentials are not returned by Atlas and are not exported.
+
+` + fmt.Sprintf(usage.RequiredRole, "Project Read Only"),
+		Args: require.NoArgs,
+		Example: `  # Export the configuration of the project with the ID 5e2211c17a3e5a48f5497de3 to the my-project directory:
+  atlas projects export --projectId 5e2211c17a3e5a48f5497de3 --out my-project`,
+		Annotations: map[string]string{
+			"output": exportTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.PreRunE(
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), exportTemplate),
+			)
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.out, flag.Out, "", usage.ExportOut)
+	_ = cmd.MarkFlagDirname(flag.Out)
+	_ = cmd.MarkFlagRequired(flag.Out)
+
+	opts.AddProjectOptsFlags(cmd)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,394 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=export_mock_test.go -package=projects -source=export.go
+//
+
+// Package projects is a generated GoMock package.
+package projects
+
+import (
+	reflect "reflect"
+
+	store "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	admin "go.mongodb.org/atlas-sdk/v20240530005/admin"
+	admin0 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectExporter is a mock of ProjectExporter interface.
+type MockProjectExporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectExporterMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectExporterMockRecorder is the mock recorder for MockProjectExporter.
+type MockProjectExporterMockRecorder struct {
+	mock *MockProjectExporter
+}
+
+// NewMockProjectExporter creates a new mock instance.
+func NewMockProjectExporter(ctrl *gomock.Controller) *MockProjectExporter {
+	mock := &MockProjectExporter{ctrl: ctrl}
+	mock.recorder = &MockProjectExporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectExporter) EXPECT() *MockProjectExporterMockRecorder {
+	return m.recorder
+}
+
+// AlertConfigurations mocks base method.
+func (m *MockProjectExporter) AlertConfigurations(arg0 *admin0.ListAlertConfigsApiParams) (*admin0.PaginatedAlertConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlertConfigurations", arg0)
+	ret0, _ := ret[0].(*admin0.PaginatedAlertConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlertConfigurations indicates an expected call of AlertConfigurations.
+func (mr *MockProjectExporterMockRecorder) AlertConfigurations(arg0 any) *MockProjectExporterAlertConfigurationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlertConfigurations", reflect.TypeOf((*MockProjectExporter)(nil).AlertConfigurations), arg0)
+	return &MockProjectExporterAlertConfigurationsCall{Call: call}
+}
+
+// MockProjectExporterAlertConfigurationsCall wrap *gomock.Call
+type MockProjectExporterAlertConfigurationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterAlertConfigurationsCall) Return(arg0 *admin0.PaginatedAlertConfig, arg1 error) *MockProjectExporterAlertConfigurationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterAlertConfigurationsCall) Do(f func(*admin0.ListAlertConfigsApiParams) (*admin0.PaginatedAlertConfig, error)) *MockProjectExporterAlertConfigurationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterAlertConfigurationsCall) DoAndReturn(f func(*admin0.ListAlertConfigsApiParams) (*admin0.PaginatedAlertConfig, error)) *MockProjectExporterAlertConfigurationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClusterSearchIndexes mocks base method.
+func (m *MockProjectExporter) ClusterSearchIndexes(arg0, arg1 string) ([]admin0.SearchIndexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterSearchIndexes", arg0, arg1)
+	ret0, _ := ret[0].([]admin0.SearchIndexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterSearchIndexes indicates an expected call of ClusterSearchIndexes.
+func (mr *MockProjectExporterMockRecorder) ClusterSearchIndexes(arg0, arg1 any) *MockProjectExporterClusterSearchIndexesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterSearchIndexes", reflect.TypeOf((*MockProjectExporter)(nil).ClusterSearchIndexes), arg0, arg1)
+	return &MockProjectExporterClusterSearchIndexesCall{Call: call}
+}
+
+// MockProjectExporterClusterSearchIndexesCall wrap *gomock.Call
+type MockProjectExporterClusterSearchIndexesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterClusterSearchIndexesCall) Return(arg0 []admin0.SearchIndexResponse, arg1 error) *MockProjectExporterClusterSearchIndexesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterClusterSearchIndexesCall) Do(f func(string, string) ([]admin0.SearchIndexResponse, error)) *MockProjectExporterClusterSearchIndexesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterClusterSearchIndexesCall) DoAndReturn(f func(string, string) ([]admin0.SearchIndexResponse, error)) *MockProjectExporterClusterSearchIndexesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseRoles mocks base method.
+func (m *MockProjectExporter) DatabaseRoles(arg0 string) ([]admin0.UserCustomDBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseRoles", arg0)
+	ret0, _ := ret[0].([]admin0.UserCustomDBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseRoles indicates an expected call of DatabaseRoles.
+func (mr *MockProjectExporterMockRecorder) DatabaseRoles(arg0 any) *MockProjectExporterDatabaseRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseRoles", reflect.TypeOf((*MockProjectExporter)(nil).DatabaseRoles), arg0)
+	return &MockProjectExporterDatabaseRolesCall{Call: call}
+}
+
+// MockProjectExporterDatabaseRolesCall wrap *gomock.Call
+type MockProjectExporterDatabaseRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterDatabaseRolesCall) Return(arg0 []admin0.UserCustomDBRole, arg1 error) *MockProjectExporterDatabaseRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterDatabaseRolesCall) Do(f func(string) ([]admin0.UserCustomDBRole, error)) *MockProjectExporterDatabaseRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterDatabaseRolesCall) DoAndReturn(f func(string) ([]admin0.UserCustomDBRole, error)) *MockProjectExporterDatabaseRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DatabaseUsers mocks base method.
+func (m *MockProjectExporter) DatabaseUsers(arg0 string, arg1 *store.ListOptions) (*admin0.PaginatedApiAtlasDatabaseUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DatabaseUsers", arg0, arg1)
+	ret0, _ := ret[0].(*admin0.PaginatedApiAtlasDatabaseUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DatabaseUsers indicates an expected call of DatabaseUsers.
+func (mr *MockProjectExporterMockRecorder) DatabaseUsers(arg0, arg1 any) *MockProjectExporterDatabaseUsersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DatabaseUsers", reflect.TypeOf((*MockProjectExporter)(nil).DatabaseUsers), arg0, arg1)
+	return &MockProjectExporterDatabaseUsersCall{Call: call}
+}
+
+// MockProjectExporterDatabaseUsersCall wrap *gomock.Call
+type MockProjectExporterDatabaseUsersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterDatabaseUsersCall) Return(arg0 *admin0.PaginatedApiAtlasDatabaseUser, arg1 error) *MockProjectExporterDatabaseUsersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterDatabaseUsersCall) Do(f func(string, *store.ListOptions) (*admin0.PaginatedApiAtlasDatabaseUser, error)) *MockProjectExporterDatabaseUsersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterDatabaseUsersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin0.PaginatedApiAtlasDatabaseUser, error)) *MockProjectExporterDatabaseUsersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DescribeCompliancePolicy mocks base method.
+func (m *MockProjectExporter) DescribeCompliancePolicy(arg0 string) (*admin0.DataProtectionSettings20231001, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCompliancePolicy", arg0)
+	ret0, _ := ret[0].(*admin0.DataProtectionSettings20231001)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCompliancePolicy indicates an expected call of DescribeCompliancePolicy.
+func (mr *MockProjectExporterMockRecorder) DescribeCompliancePolicy(arg0 any) *MockProjectExporterDescribeCompliancePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCompliancePolicy", reflect.TypeOf((*MockProjectExporter)(nil).DescribeCompliancePolicy), arg0)
+	return &MockProjectExporterDescribeCompliancePolicyCall{Call: call}
+}
+
+// MockProjectExporterDescribeCompliancePolicyCall wrap *gomock.Call
+type MockProjectExporterDescribeCompliancePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterDescribeCompliancePolicyCall) Return(arg0 *admin0.DataProtectionSettings20231001, arg1 error) *MockProjectExporterDescribeCompliancePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterDescribeCompliancePolicyCall) Do(f func(string) (*admin0.DataProtectionSettings20231001, error)) *MockProjectExporterDescribeCompliancePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterDescribeCompliancePolicyCall) DoAndReturn(f func(string) (*admin0.DataProtectionSettings20231001, error)) *MockProjectExporterDescribeCompliancePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockProjectExporter) DescribeSchedule(arg0, arg1 string) (*admin.DiskBackupSnapshotSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*admin.DiskBackupSnapshotSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockProjectExporterMockRecorder) DescribeSchedule(arg0, arg1 any) *MockProjectExporterDescribeScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockProjectExporter)(nil).DescribeSchedule), arg0, arg1)
+	return &MockProjectExporterDescribeScheduleCall{Call: call}
+}
+
+// MockProjectExporterDescribeScheduleCall wrap *gomock.Call
+type MockProjectExporterDescribeScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterDescribeScheduleCall) Return(arg0 *admin.DiskBackupSnapshotSchedule, arg1 error) *MockProjectExporterDescribeScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterDescribeScheduleCall) Do(f func(string, string) (*admin.DiskBackupSnapshotSchedule, error)) *MockProjectExporterDescribeScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterDescribeScheduleCall) DoAndReturn(f func(string, string) (*admin.DiskBackupSnapshotSchedule, error)) *MockProjectExporterDescribeScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Integrations mocks base method.
+func (m *MockProjectExporter) Integrations(arg0 string) (*admin0.PaginatedIntegration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Integrations", arg0)
+	ret0, _ := ret[0].(*admin0.PaginatedIntegration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Integrations indicates an expected call of Integrations.
+func (mr *MockProjectExporterMockRecorder) Integrations(arg0 any) *MockProjectExporterIntegrationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Integrations", reflect.TypeOf((*MockProjectExporter)(nil).Integrations), arg0)
+	return &MockProjectExporterIntegrationsCall{Call: call}
+}
+
+// MockProjectExporterIntegrationsCall wrap *gomock.Call
+type MockProjectExporterIntegrationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterIntegrationsCall) Return(arg0 *admin0.PaginatedIntegration, arg1 error) *MockProjectExporterIntegrationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterIntegrationsCall) Do(f func(string) (*admin0.PaginatedIntegration, error)) *MockProjectExporterIntegrationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterIntegrationsCall) DoAndReturn(f func(string) (*admin0.PaginatedIntegration, error)) *MockProjectExporterIntegrationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LatestProjectClusters mocks base method.
+func (m *MockProjectExporter) LatestProjectClusters(arg0 string, arg1 *store.ListOptions) (*admin0.PaginatedClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestProjectClusters", arg0, arg1)
+	ret0, _ := ret[0].(*admin0.PaginatedClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestProjectClusters indicates an expected call of LatestProjectClusters.
+func (mr *MockProjectExporterMockRecorder) LatestProjectClusters(arg0, arg1 any) *MockProjectExporterLatestProjectClustersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestProjectClusters", reflect.TypeOf((*MockProjectExporter)(nil).LatestProjectClusters), arg0, arg1)
+	return &MockProjectExporterLatestProjectClustersCall{Call: call}
+}
+
+// MockProjectExporterLatestProjectClustersCall wrap *gomock.Call
+type MockProjectExporterLatestProjectClustersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterLatestProjectClustersCall) Return(arg0 *admin0.PaginatedClusterDescription20240805, arg1 error) *MockProjectExporterLatestProjectClustersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterLatestProjectClustersCall) Do(f func(string, *store.ListOptions) (*admin0.PaginatedClusterDescription20240805, error)) *MockProjectExporterLatestProjectClustersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterLatestProjectClustersCall) DoAndReturn(f func(string, *store.ListOptions) (*admin0.PaginatedClusterDescription20240805, error)) *MockProjectExporterLatestProjectClustersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectIPAccessLists mocks base method.
+func (m *MockProjectExporter) ProjectIPAccessLists(arg0 string, arg1 *store.ListOptions) (*admin0.PaginatedNetworkAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectIPAccessLists", arg0, arg1)
+	ret0, _ := ret[0].(*admin0.PaginatedNetworkAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectIPAccessLists indicates an expected call of ProjectIPAccessLists.
+func (mr *MockProjectExporterMockRecorder) ProjectIPAccessLists(arg0, arg1 any) *MockProjectExporterProjectIPAccessListsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectIPAccessLists", reflect.TypeOf((*MockProjectExporter)(nil).ProjectIPAccessLists), arg0, arg1)
+	return &MockProjectExporterProjectIPAccessListsCall{Call: call}
+}
+
+// MockProjectExporterProjectIPAccessListsCall wrap *gomock.Call
+type MockProjectExporterProjectIPAccessListsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectExporterProjectIPAccessListsCall) Return(arg0 *admin0.PaginatedNetworkAccess, arg1 error) *MockProjectExporterProjectIPAccessListsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectExporterProjectIPAccessListsCall) Do(f func(string, *store.ListOptions) (*admin0.PaginatedNetworkAccess, error)) *MockProjectExporterProjectIPAccessListsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectExporterProjectIPAccessListsCall) DoAndReturn(f func(string, *store.ListOptions) (*admin0.PaginatedNetworkAccess, error)) *MockProjectExporterProjectIPAccessListsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
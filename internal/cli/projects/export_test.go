@@ -0,0 +1,130 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projects
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasClustersPinned "go.mongodb.org/atlas-sdk/v20240530005/admin"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExport_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockProjectExporter(ctrl)
+
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	mockStore.
+		EXPECT().
+		LatestProjectClusters(projectID, gomock.Any()).
+		Return(&atlasv2.PaginatedClusterDescription20240805{
+			Results: &[]atlasv2.ClusterDescription20240805{
+				{Name: pointer.Get("Cluster0"), BackupEnabled: pointer.Get(true)},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DatabaseUsers(projectID, gomock.Any()).
+		Return(&atlasv2.PaginatedApiAtlasDatabaseUser{
+			Results: &[]atlasv2.CloudDatabaseUser{
+				{DatabaseName: "$external", Username: "arn:aws:iam::123456789012:role/app", AwsIAMType: pointer.Get("ROLE")},
+			},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ProjectIPAccessLists(projectID, gomock.Any()).
+		Return(&atlasv2.PaginatedNetworkAccess{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DatabaseRoles(projectID).
+		Return(nil, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		AlertConfigurations(gomock.Any()).
+		Return(&atlasv2.PaginatedAlertConfig{}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		ClusterSearchIndexes(projectID, "Cluster0").
+		Return([]atlasv2.SearchIndexResponse{
+			{Name: pointer.Get("default"), Database: pointer.Get("db"), CollectionName: pointer.Get("coll")},
+		}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DescribeCompliancePolicy(projectID).
+		Return(&atlasv2.DataProtectionSettings20231001{ProjectId: pointer.Get(projectID), State: pointer.Get("ACTIVE")}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		DescribeSchedule(projectID, "Cluster0").
+		Return(&atlasClustersPinned.DiskBackupSnapshotSchedule{ClusterName: pointer.Get("Cluster0"), ReferenceHourOfDay: pointer.Get(2)}, nil).
+		Times(1)
+	mockStore.
+		EXPECT().
+		Integrations(projectID).
+		Return(&atlasv2.PaginatedIntegration{
+			Results: &[]atlasv2.ThirdPartyIntegration{{Type: pointer.Get("SLACK")}},
+		}, nil).
+		Times(1)
+
+	fs := afero.NewMemMapFs()
+	buf := new(bytes.Buffer)
+	opts := &ExportOpts{
+		ProjectOpts: cli.ProjectOpts{ProjectID: projectID},
+		OutputOpts: cli.OutputOpts{
+			Template:  exportTemplate,
+			OutWriter: buf,
+		},
+		out:   "export",
+		fs:    fs,
+		store: mockStore,
+	}
+
+	require.NoError(t, opts.Run())
+	assert.Equal(t, "Exported 7 file(s) from project '5e2211c17a3e5a48f5497de3' to 'export'.\n", buf.String())
+
+	index, err := afero.ReadFile(fs, "export/searchIndexes/Cluster0/db.coll.default.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "default", "database": "db", "collectionName": "coll"}`, string(index))
+
+	schedule, err := afero.ReadFile(fs, "export/backupSchedules/Cluster0.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"referenceHourOfDay": 2}`, string(schedule))
+
+	for _, name := range []string{
+		"export/manifest.yaml",
+		"export/clusters/Cluster0.json",
+		"export/databaseUsers/_external_arn_aws_iam_123456789012_role_app.json",
+		"export/compliancePolicy.json",
+		"export/integrations/SLACK.json",
+	} {
+		exists, err := afero.Exists(fs, name)
+		require.NoError(t, err)
+		assert.True(t, exists, name)
+	}
+}
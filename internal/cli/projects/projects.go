@@ -38,6 +38,7 @@ func Builder() *cobra.Command {
 		UpdateBuilder(),
 		DeleteBuilder(),
 		DescribeBuilder(),
+		ExportBuilder(),
 		apikeys.Builder(),
 		users.Builder(),
 		teams.Builder(),
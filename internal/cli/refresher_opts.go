@@ -16,17 +16,28 @@ package cli
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-core/transport"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/version"
 	atlasauth "go.mongodb.org/atlas/auth"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var TokenRefreshed bool
 
+var ErrInheritedTokenExpired = errors.New("the access token has expired")
+
+const (
+	accessTokenProperty  = "access_token"
+	refreshTokenProperty = "refresh_token"
+)
+
 type RefresherOpts struct {
 	flow Refresher
 }
@@ -54,7 +65,10 @@ func (opts *RefresherOpts) WithFlow(f Refresher) {
 	opts.flow = f
 }
 
-func (opts *RefresherOpts) RefreshAccessToken(ctx context.Context) error {
+func (opts *RefresherOpts) RefreshAccessToken(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "auth refresh token")
+	defer func() { tracing.End(span, err) }()
+
 	current, err := config.Token()
 	if current == nil {
 		return err
@@ -63,6 +77,13 @@ func (opts *RefresherOpts) RefreshAccessToken(ctx context.Context) error {
 		TokenRefreshed = true
 		return nil
 	}
+	span.SetAttributes(attribute.Bool("auth.token_expired", true))
+	// refreshing an inherited token would save it in this profile and revoke the refresh token of the profile it comes from
+	for _, property := range []string{accessTokenProperty, refreshTokenProperty} {
+		if from, ok := InheritedFrom(property); ok {
+			return fmt.Errorf("%w: it is inherited from the %q profile, run 'atlas auth login -P %s'", ErrInheritedTokenExpired, from, from)
+		}
+	}
 	t, _, err := opts.flow.RefreshToken(ctx, config.RefreshToken())
 	if err != nil {
 		return err
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/accesslists"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/accesslogs"
@@ -48,6 +49,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/livemigrations"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/logs"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/maintenance"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/manifest"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/metrics"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/networking"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/organizations"
@@ -61,18 +63,24 @@ import (
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/streams"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/teams"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/users"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/wait"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dirconfig"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/homebrew"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/latestrelease"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/prerun"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/retry"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/sighandle"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/telemetry"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/terminal"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/version"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const atlas = "atlas"
@@ -99,6 +107,8 @@ func Builder() *cobra.Command {
 	var (
 		profile    string
 		debugLevel bool
+		dryRun     bool
+		retryFlags retryOpts
 	)
 	opts := &cli.RefresherOpts{}
 	rootCmd := &cobra.Command{
@@ -122,10 +132,41 @@ Use the --help flag with any command for more info on that command.`,
 				log.SetLevel(log.DebugLevel)
 			}
 
-			if err := config.InitProfile(profile); err != nil {
+			if err := dirconfig.Load(); err != nil {
 				return err
 			}
 
+			if err := config.InitProfile(contextProfile(profile)); err != nil {
+				return err
+			}
+
+			if err := setContextClusterName(cmd, dirconfig.Current().ClusterName); err != nil {
+				return err
+			}
+
+			if shouldInheritProfile(cmd) {
+				if err := inheritProfile(); err != nil {
+					return err
+				}
+			}
+
+			if err := retryFlags.configure(cmd, config.GetString); err != nil {
+				return err
+			}
+
+			if dryRun {
+				if !supportsDryRun(cmd) {
+					return fmt.Errorf("--%s can't be used with %s, it changes the configuration file", flag.DryRun, cmd.CommandPath())
+				}
+				dryrun.Enable(cmd.OutOrStdout())
+			}
+
+			if err := configureAudit(cmd); err != nil {
+				return err
+			}
+
+			configureTracing(cmd)
+
 			telemetry.StartTrackingCommand(cmd, args)
 
 			handleSignal()
@@ -212,6 +253,9 @@ Use the --help flag with any command for more info on that command.`,
 		deployments.Builder(),
 		federatedauthentication.Builder(),
 		apiCmd.Builder(),
+		manifest.DiffBuilder(),
+		manifest.ApplyBuilder(),
+		wait.Builder(),
 	)
 
 	pluginCmd.RegisterCommands(rootCmd)
@@ -219,6 +263,25 @@ Use the --help flag with any command for more info on that command.`,
 	rootCmd.PersistentFlags().StringVarP(&profile, flag.Profile, flag.ProfileShort, "", usage.ProfileAtlasCLI)
 	rootCmd.PersistentFlags().BoolVarP(&debugLevel, flag.Debug, flag.DebugShort, false, usage.Debug)
 	_ = rootCmd.PersistentFlags().MarkHidden(flag.Debug)
+	rootCmd.PersistentFlags().IntVar(&retryFlags.maxRetries, flag.MaxRetries, retry.DefaultMaxRetries, usage.MaxRetries)
+	rootCmd.PersistentFlags().DurationVar(&retryFlags.maxWait, flag.RetryMaxWait, retry.DefaultMaxWait, usage.RetryMaxWait)
+	rootCmd.PersistentFlags().BoolVar(&retryFlags.allMethods, flag.RetryAllMethods, false, usage.RetryAllMethods)
+	rootCmd.PersistentFlags().BoolVar(&dryRun, flag.DryRun, false, usage.DryRun)
+	rootCmd.SetGlobalNormalizationFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		switch name {
+		case flag.DryRunAlias:
+			name = flag.DryRun
+		case flag.FromSnapshotAlias:
+			name = flag.FromSnapshot
+		case flag.VerifyOnlyAlias:
+			name = flag.VerifyOnly
+		case flag.AllHostsAlias:
+			name = flag.AllHosts
+		case flag.ForPluginAlias:
+			name = flag.ForPlugin
+		}
+		return pflag.NormalizedName(name)
+	})
 
 	_ = rootCmd.RegisterFlagCompletionFunc(flag.Profile, func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return config.List(), cobra.ShellCompDirectiveDefault
@@ -226,6 +289,89 @@ Use the --help flag with any command for more info on that command.`,
 	return rootCmd
 }
 
+type retryOpts struct {
+	maxRetries int
+	maxWait    time.Duration
+	allMethods bool
+}
+
+// configure sets the retry configuration from the profile, flags take precedence over the profile settings.
+// Config commands use the default settings when the profile settings are invalid, so they can fix them.
+func (opts *retryOpts) configure(cmd *cobra.Command, get func(string) string) error {
+	c, err := retry.ProfileConfig(get)
+	if err != nil {
+		if !strings.HasPrefix(cmd.CommandPath(), fmt.Sprintf("%s %s", atlas, "config")) {
+			return err
+		}
+		_, _ = log.Warningf("Warning: %v, using the default retry settings\n", err)
+		c = retry.DefaultConfig()
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed(flag.MaxRetries) {
+		if opts.maxRetries < 0 {
+			return fmt.Errorf("invalid --%s: %d, expected a positive number", flag.MaxRetries, opts.maxRetries)
+		}
+		c.MaxRetries = opts.maxRetries
+	}
+	if flags.Changed(flag.RetryMaxWait) {
+		if opts.maxWait <= 0 {
+			return fmt.Errorf("invalid --%s: %s, expected a positive duration", flag.RetryMaxWait, opts.maxWait)
+		}
+		c.MaxWait = opts.maxWait
+	}
+	if flags.Changed(flag.RetryAllMethods) {
+		c.AllMethods = opts.allMethods
+	}
+
+	retry.SetDefault(c)
+	return nil
+}
+
+// configureAudit records the mutating requests of the command when the profile enabled the audit log.
+func configureAudit(cmd *cobra.Command) error {
+	if !audit.IsEnabled(config.GetString) {
+		return nil
+	}
+
+	path, err := audit.Path(config.Name())
+	if err != nil {
+		return err
+	}
+
+	audit.Enable(audit.NewJournal(afero.NewOsFs(), path), audit.NewCommand(cmd, config.Default()))
+	return nil
+}
+
+func configureTracing(cmd *cobra.Command) {
+	s, err := tracing.NewSettings(config.GetString, os.Getenv)
+	if err == nil && s.Enabled() {
+		err = enableTracing(cmd, s)
+	}
+	// tracing is a diagnostic aid, a misconfiguration must not prevent the command from running
+	if err != nil {
+		_, _ = log.Warningf("Warning: unable to configure tracing: %v, proceeding without tracing\n", err)
+	}
+}
+
+func enableTracing(cmd *cobra.Command, s tracing.Settings) error {
+	if s.Exporter == tracing.FileExporter && s.Endpoint == "" {
+		home, err := config.CLIConfigHome()
+		if err != nil {
+			return err
+		}
+		s.Endpoint = tracing.Path(home)
+	}
+
+	exporter, err := tracing.NewExporter(s)
+	if err != nil {
+		return err
+	}
+	tracing.Enable(exporter)
+	cmd.SetContext(tracing.StartCommand(cmd.Context(), cmd))
+	return nil
+}
+
 const verTemplate = `atlascli version: %s
 git version: %s
 Go version: %s
@@ -234,6 +380,95 @@ Go version: %s
    compiler: %s
 `
 
+// shouldInheritProfile returns false for the commands that manage the properties and credentials of the profile itself.
+func shouldInheritProfile(cmd *cobra.Command) bool {
+	for _, prefix := range []string{"config", "auth login", "auth logout", "auth register"} {
+		if strings.HasPrefix(cmd.CommandPath(), fmt.Sprintf("%s %s", atlas, prefix)) {
+			return false
+		}
+	}
+	return true
+}
+
+// supportsDryRun returns false for the commands that save the configuration file, the dry run mode only skips
+// the requests and container operations that change something.
+func supportsDryRun(cmd *cobra.Command) bool {
+	for _, prefix := range []string{"config delete", "config edit", "config init", "config rename", "config set", "auth login", "auth logout", "auth register"} {
+		if strings.HasPrefix(cmd.CommandPath(), fmt.Sprintf("%s %s", atlas, prefix)) {
+			return false
+		}
+	}
+	return true
+}
+
+// inheritProfile exposes the properties that the profile inherits from the profile it extends, without setting them.
+func inheritProfile() error {
+	if config.GetString(cli.ExtendsProperty) == "" {
+		return nil
+	}
+
+	configStore, err := config.NewStoreWithEnvOption(false)
+	if err != nil {
+		return fmt.Errorf("could not create config store: %w", err)
+	}
+
+	return cli.InheritProfile(configStore, config.Name())
+}
+
+// contextProfile returns the profile of the directory context file unless a flag or an environment variable sets it.
+func contextProfile(profile string) string {
+	if profile != "" || cli.IsSetInEnv(cli.ProfileProperty) {
+		return profile
+	}
+	return dirconfig.Current().Profile
+}
+
+const (
+	requiredTogetherAnnotation  = "cobra_annotation_required_if_others_set"
+	oneRequiredAnnotation       = "cobra_annotation_one_required"
+	mutuallyExclusiveAnnotation = "cobra_annotation_mutually_exclusive"
+)
+
+// isFlagGroupSet returns true when another flag of a group of the flag with the annotation is set.
+func isFlagGroupSet(flags *pflag.FlagSet, f *pflag.Flag, annotation string) bool {
+	for _, group := range f.Annotations[annotation] {
+		for _, name := range strings.Fields(group) {
+			if name != f.Name && flags.Changed(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setContextClusterName defaults the cluster name flag to the one of the directory context file.
+// The cluster name isn't used when another flag of its mutually exclusive or one required groups is set,
+// and it isn't marked as changed when it's required together with other flags, so it doesn't require them.
+func setContextClusterName(cmd *cobra.Command, clusterName string) error {
+	if clusterName == "" {
+		return nil
+	}
+
+	f := cmd.Flags().Lookup(flag.ClusterName)
+	if f == nil || f.Changed {
+		return nil
+	}
+
+	if isFlagGroupSet(cmd.Flags(), f, mutuallyExclusiveAnnotation) || isFlagGroupSet(cmd.Flags(), f, oneRequiredAnnotation) {
+		return nil
+	}
+
+	if _, ok := f.Annotations[requiredTogetherAnnotation]; ok {
+		if err := f.Value.Set(clusterName); err != nil {
+			return err
+		}
+		f.DefValue = clusterName
+		return nil
+	}
+
+	return cmd.Flags().Set(flag.ClusterName, clusterName)
+}
+
 func shouldSetService(cmd *cobra.Command) bool {
 	if config.Service() != "" {
 		return false
@@ -17,11 +17,14 @@ package root
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-github/v61/github"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/latestrelease"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/mocks"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/retry"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/version"
 	"github.com/spf13/afero"
 	"go.uber.org/mock/gomock"
@@ -114,3 +117,127 @@ To disable this alert, run "atlas config set skip_update_check true"
 		})
 	}
 }
+
+func TestShouldInheritProfile(t *testing.T) {
+	root := Builder()
+	tests := map[string]bool{
+		"clusters list":     true,
+		"auth token":        true,
+		"auth whoami":       true,
+		"auth login":        false,
+		"auth logout":       false,
+		"config set":        false,
+		"config describe":   false,
+		"deployments setup": true,
+	}
+	for path, want := range tests {
+		t.Run(path, func(t *testing.T) {
+			cmd, _, err := root.Find(strings.Fields(path))
+			if err != nil {
+				t.Fatalf("Find(%q) error = %v", path, err)
+			}
+			if got := shouldInheritProfile(cmd); got != want {
+				t.Errorf("shouldInheritProfile(%q) = %v, want %v", path, got, want)
+			}
+		})
+	}
+}
+
+func TestSetContextClusterName(t *testing.T) {
+	tests := []struct {
+		name            string
+		path            string
+		args            []string
+		wantClusterName string
+	}{
+		{name: "defaults a required flag", path: "search nodes list", wantClusterName: "contextCluster"},
+		{name: "keeps the cluster name flag", path: "search nodes list", args: []string{"--clusterName", "flagCluster"}, wantClusterName: "flagCluster"},
+		{name: "defaults a one required flag", path: "accesslogs list", wantClusterName: "contextCluster"},
+		{name: "skips a mutually exclusive flag", path: "accesslogs list", args: []string{"--hostname", "host"}, wantClusterName: ""},
+		{name: "doesn't require the flags required together", path: "logs download", wantClusterName: "contextCluster"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _, err := Builder().Find(strings.Fields(tt.path))
+			if err != nil {
+				t.Fatalf("Find(%q) error = %v", tt.path, err)
+			}
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("ParseFlags() error = %v", err)
+			}
+
+			if err := setContextClusterName(cmd, "contextCluster"); err != nil {
+				t.Fatalf("setContextClusterName() error = %v", err)
+			}
+			if err := cmd.ValidateRequiredFlags(); err != nil {
+				t.Errorf("ValidateRequiredFlags() error = %v", err)
+			}
+			if err := cmd.ValidateFlagGroups(); err != nil {
+				t.Errorf("ValidateFlagGroups() error = %v", err)
+			}
+			if got := cmd.Flags().Lookup(flag.ClusterName).Value.String(); got != tt.wantClusterName {
+				t.Errorf("cluster name = %q, want %q", got, tt.wantClusterName)
+			}
+		})
+	}
+}
+
+func TestRetryOpts_configure(t *testing.T) {
+	invalidProfile := func(name string) string {
+		if name == retry.MaxRetriesProperty {
+			return "abc"
+		}
+		return ""
+	}
+
+	t.Run("fails other commands", func(t *testing.T) {
+		cmd, _, err := Builder().Find([]string{"clusters", "list"})
+		if err != nil {
+			t.Fatalf("Find() error = %v", err)
+		}
+		opts := &retryOpts{}
+		if err := opts.configure(cmd, invalidProfile); err == nil {
+			t.Fatal("configure() expected an error but got none")
+		}
+	})
+
+	t.Run("lets config set fix the profile", func(t *testing.T) {
+		defer retry.SetDefault(retry.DefaultConfig())
+		retry.SetDefault(retry.Config{})
+
+		cmd, _, err := Builder().Find([]string{"config", "set"})
+		if err != nil {
+			t.Fatalf("Find() error = %v", err)
+		}
+		opts := &retryOpts{}
+		if err := opts.configure(cmd, invalidProfile); err != nil {
+			t.Fatalf("configure() unexpected error = %v", err)
+		}
+		if got := retry.Default(); got != retry.DefaultConfig() {
+			t.Errorf("retry.Default() = %+v, want %+v", got, retry.DefaultConfig())
+		}
+	})
+}
+
+func TestSupportsDryRun(t *testing.T) {
+	root := Builder()
+	tests := map[string]bool{
+		"clusters create":   true,
+		"deployments setup": true,
+		"config describe":   true,
+		"config set":        false,
+		"config init":       false,
+		"auth login":        false,
+	}
+	for path, want := range tests {
+		t.Run(path, func(t *testing.T) {
+			cmd, _, err := root.Find(strings.Fields(path))
+			if err != nil {
+				t.Fatalf("Find(%q) error = %v", path, err)
+			}
+			if got := supportsDryRun(cmd); got != want {
+				t.Errorf("supportsDryRun(%q) = %v, want %v", path, got, want)
+			}
+		})
+	}
+}
@@ -60,7 +60,7 @@ func (opts *CreateOpts) initStore(ctx context.Context) func() error {
 var createTemplate = "Search nodes are being created.\n"
 var createWatchTemplate = "Created new search nodes.\n"
 
-func (opts *CreateOpts) Run() error {
+func (opts *CreateOpts) Run(ctx context.Context) error {
 	spec, err := loadAPISearchDeploymentSpec(opts.fs, opts.filename)
 	if err != nil {
 		return err
@@ -72,7 +72,7 @@ func (opts *CreateOpts) Run() error {
 	}
 
 	if opts.EnableWatch {
-		watchResult, err := opts.Watch(opts.watcher)
+		watchResult, err := opts.WatchContext(ctx, opts.watcher)
 		if err != nil {
 			return err
 		}
@@ -113,8 +113,8 @@ func CreateBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), createTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -64,7 +64,7 @@ func TestCreateOpts_Run(t *testing.T) {
 			CreateSearchNodes(opts.ProjectID, opts.clusterName, &testJSONParsed).Return(expected, nil).
 			Times(1)
 
-		if err := opts.Run(); err != nil {
+		if err := opts.Run(t.Context()); err != nil {
 			t.Fatalf("Run() unexpected error: %v", err)
 		}
 	})
@@ -79,7 +79,7 @@ func TestCreateOpts_Run(t *testing.T) {
 		opts.filename = fileName
 		opts.fs = appFS
 
-		err := opts.Run()
+		err := opts.Run(t.Context())
 		if err == nil {
 			t.Fatalf("Run() unexpected error: %v", err)
 		}
@@ -55,7 +55,7 @@ func (opts *DeleteOpts) initStore(ctx context.Context) func() error {
 var deleteTemplate = "Started deleting search nodes for cluster '{{.}}'.\n"
 var deleteWatchTemplate = "Search nodes for cluster '{{.}}' are deleted.\n"
 
-func (opts *DeleteOpts) Run() error {
+func (opts *DeleteOpts) Run(ctx context.Context) error {
 	if err := opts.Prompt(); err != nil {
 		return err
 	}
@@ -69,7 +69,7 @@ func (opts *DeleteOpts) Run() error {
 	}
 
 	if opts.EnableWatch {
-		if _, err := opts.Watch(opts.watcher); err != nil {
+		if _, err := opts.WatchContext(ctx, opts.watcher); err != nil {
 			return err
 		}
 		opts.Template = deleteWatchTemplate
@@ -109,8 +109,8 @@ func DeleteBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), deleteTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
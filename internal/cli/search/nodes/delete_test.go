@@ -44,7 +44,7 @@ func TestDelete_Run(t *testing.T) {
 		Return(nil).
 		Times(1)
 
-	if err := deleteOpts.Run(); err != nil {
+	if err := deleteOpts.Run(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 	test.VerifyOutputTemplate(t, deleteTemplate, expected)
@@ -56,7 +56,7 @@ func (opts *UpdateOpts) initStore(ctx context.Context) func() error {
 var updateTemplate = "Started updating search nodes.\n"
 var updateWatchTemplate = "Search nodes are updated.\n"
 
-func (opts *UpdateOpts) Run() error {
+func (opts *UpdateOpts) Run(ctx context.Context) error {
 	spec, err := loadAPISearchDeploymentSpec(opts.fs, opts.filename)
 	if err != nil {
 		return err
@@ -68,7 +68,7 @@ func (opts *UpdateOpts) Run() error {
 	}
 
 	if opts.EnableWatch {
-		watchResult, err := opts.Watch(opts.watcher)
+		watchResult, err := opts.WatchContext(ctx, opts.watcher)
 		if err != nil {
 			return err
 		}
@@ -109,8 +109,8 @@ func UpdateBuilder() *cobra.Command {
 				opts.InitOutput(cmd.OutOrStdout(), updateTemplate),
 			)
 		},
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return opts.Run()
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
 		},
 	}
 
@@ -53,7 +53,7 @@ func TestUpdateOpts_Run(t *testing.T) {
 			UpdateSearchNodes(opts.ProjectID, opts.clusterName, &testJSONParsed).Return(expected, nil).
 			Times(1)
 
-		if err := opts.Run(); err != nil {
+		if err := opts.Run(t.Context()); err != nil {
 			t.Fatalf("Run() unexpected error: %v", err)
 		}
 	})
@@ -68,7 +68,7 @@ func TestUpdateOpts_Run(t *testing.T) {
 		opts.filename = fileName
 		opts.fs = appFS
 
-		err := opts.Run()
+		err := opts.Run(t.Context())
 		if err == nil {
 			t.Fatalf("Run() unexpected error: %v", err)
 		}
@@ -282,7 +282,7 @@ func (opts *Opts) sampleDataWatcher() (any, bool, error) {
 	return nil, result.GetState() == "COMPLETED", nil
 }
 
-func (opts *Opts) loadSampleData() error {
+func (opts *Opts) loadSampleData(ctx context.Context) error {
 	if opts.SkipSampleData {
 		return nil
 	}
@@ -298,7 +298,7 @@ Loading sample data into your cluster... [It's safe to 'Ctrl + C']
 
 	opts.SampleDataJobID = sampleDataJob.GetId()
 
-	_, err = opts.Watch(opts.sampleDataWatcher)
+	_, err = opts.WatchContext(ctx, opts.sampleDataWatcher)
 	return err
 }
 
@@ -427,7 +427,7 @@ If you wish to register a new account, run: 'atlas auth register'.
 		return fmt.Errorf("%w: %s", errNeedsProject, config.Default().Name())
 	}
 
-	return opts.setupCluster()
+	return opts.setupCluster(ctx)
 }
 
 func (opts *Opts) clusterPreRun(ctx context.Context, outWriter io.Writer) error {
@@ -442,7 +442,7 @@ func (opts *Opts) clusterPreRun(ctx context.Context, outWriter io.Writer) error
 	)
 }
 
-func (opts *Opts) setupCluster() error {
+func (opts *Opts) setupCluster(ctx context.Context) error {
 	const base10 = 10
 	opts.defaultName = "Cluster" + strconv.FormatInt(time.Now().Unix(), base10)[5:]
 	opts.providerAndRegionToConstant()
@@ -497,7 +497,7 @@ func (opts *Opts) setupCluster() error {
 	fmt.Print(setupTemplateCluster)
 
 	// Watch cluster creation
-	if _, er := opts.Watch(opts.clusterCreationWatcher); er != nil {
+	if _, er := opts.WatchContext(ctx, opts.clusterCreationWatcher); er != nil {
 		return er
 	}
 
@@ -513,7 +513,7 @@ func (opts *Opts) setupCluster() error {
 
 	fmt.Printf("Your connection string: %v\n", opts.connectionString)
 
-	if err := opts.loadSampleData(); err != nil {
+	if err := opts.loadSampleData(ctx); err != nil {
 		return err
 	}
 
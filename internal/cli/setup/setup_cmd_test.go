@@ -134,7 +134,7 @@ func TestCluster_Run(t *testing.T) {
 		CreateDatabaseUser(opts.newDatabaseUser()).Return(expectedDBUser, nil).
 		Times(1)
 
-	if err := opts.setupCluster(); err != nil {
+	if err := opts.setupCluster(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
@@ -194,7 +194,7 @@ func TestCluster_Run_LatestAPI(t *testing.T) {
 		CreateDatabaseUser(opts.newDatabaseUser()).Return(expectedDBUser, nil).
 		Times(1)
 
-	if err := opts.setupCluster(); err != nil {
+	if err := opts.setupCluster(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 }
@@ -270,7 +270,7 @@ func TestCluster_Run_CheckFlagsSet(t *testing.T) {
 
 	opts.flags = cmd.Flags()
 
-	if err := opts.setupCluster(); err != nil {
+	if err := opts.setupCluster(t.Context()); err != nil {
 		t.Fatalf("Run() unexpected error: %v", err)
 	}
 
@@ -0,0 +1,181 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=resource_mock_test.go -package=wait -source=resource.go
+
+// Describer fetches the resources that atlas wait can wait for.
+type Describer interface {
+	LatestAtlasCluster(string, string) (*atlasv2.ClusterDescription20240805, error)
+	PrivateEndpoint(string, string, string) (*atlasv2.EndpointService, error)
+	RestoreJob(string, string, string) (*atlasv2.DiskBackupSnapshotRestoreJob, error)
+	OnlineArchive(string, string, string) (*atlasv2.BackupOnlineArchive, error)
+}
+
+const (
+	clusterKind         = "cluster"
+	privateEndpointKind = "privateEndpoint"
+	restoreJobKind      = "restoreJob"
+	onlineArchiveKind   = "onlineArchive"
+
+	defaultProvider = "AWS"
+
+	idleState      = "IDLE"
+	availableState = "AVAILABLE"
+	failedState    = "FAILED"
+	pendingState   = "PENDING"
+	pausingState   = "PAUSING"
+	completedState = "COMPLETED"
+	expiredState   = "EXPIRED"
+	cancelledState = "CANCELLED"
+	runningState   = "IN_PROGRESS"
+
+	downloadDeliveryType = "download"
+)
+
+var (
+	errInvalidResource = errors.New("invalid resource")
+	errFailedState     = errors.New("resource reached a failed state")
+)
+
+// resource is a single Atlas resource to wait for, written as <kind>:<id>[=<targetState>].
+type resource struct {
+	kind   string
+	parent string
+	id     string
+	target string
+}
+
+func (r *resource) String() string {
+	if r.parent == "" {
+		return r.kind + ":" + r.id
+	}
+	return r.kind + ":" + r.parent + "/" + r.id
+}
+
+func parseResource(arg string) (*resource, error) {
+	kind, ref, ok := strings.Cut(arg, ":")
+	if !ok || ref == "" {
+		return nil, fmt.Errorf("%w %q, expected <kind>:<id>", errInvalidResource, arg)
+	}
+	ref, target, _ := strings.Cut(ref, "=")
+	r := &resource{kind: kind, target: strings.ToUpper(target)}
+
+	parent, id, hasParent := strings.Cut(ref, "/")
+	switch kind {
+	case clusterKind:
+		if hasParent {
+			return nil, fmt.Errorf("%w %q, expected %s:<clusterName>", errInvalidResource, arg, clusterKind)
+		}
+		r.id = ref
+	case privateEndpointKind:
+		r.parent, r.id = defaultProvider, ref
+		if hasParent {
+			r.parent, r.id = strings.ToUpper(parent), id
+		}
+	case restoreJobKind, onlineArchiveKind:
+		if !hasParent || parent == "" {
+			return nil, fmt.Errorf("%w %q, expected %s:<clusterName>/<id>", errInvalidResource, arg, kind)
+		}
+		if target != "" && kind == restoreJobKind {
+			return nil, fmt.Errorf("%w %q, restore jobs don't support a target state", errInvalidResource, arg)
+		}
+		r.parent, r.id = parent, id
+	default:
+		return nil, fmt.Errorf("%w %q, supported kinds are %s, %s, %s and %s",
+			errInvalidResource, arg, clusterKind, privateEndpointKind, restoreJobKind, onlineArchiveKind)
+	}
+
+	if r.id == "" {
+		return nil, fmt.Errorf("%w %q, missing id", errInvalidResource, arg)
+	}
+
+	return r, nil
+}
+
+// poll returns the current state of the resource and whether it reached its target state.
+func (r *resource) poll(s Describer, projectID string) (string, bool, error) {
+	switch r.kind {
+	case clusterKind:
+		c, err := s.LatestAtlasCluster(projectID, r.id)
+		if err != nil {
+			return "", false, err
+		}
+		return r.reached(c.GetStateName(), idleState)
+	case privateEndpointKind:
+		e, err := s.PrivateEndpoint(projectID, r.parent, r.id)
+		if err != nil {
+			return "", false, err
+		}
+		return r.reached(e.GetStatus(), availableState)
+	case restoreJobKind:
+		j, err := s.RestoreJob(projectID, r.parent, r.id)
+		if err != nil {
+			return "", false, err
+		}
+		return restoreJobState(j)
+	case onlineArchiveKind:
+		a, err := s.OnlineArchive(projectID, r.parent, r.id)
+		if err != nil {
+			return "", false, err
+		}
+		state := a.GetState()
+		if r.target != "" {
+			return r.reached(state, r.target)
+		}
+		return state, state != pendingState && state != pausingState, nil
+	default:
+		return "", false, fmt.Errorf("%w %q", errInvalidResource, r.kind)
+	}
+}
+
+func (r *resource) reached(state, defaultTarget string) (string, bool, error) {
+	target := defaultTarget
+	if r.target != "" {
+		target = r.target
+	}
+	if state == target {
+		return state, true, nil
+	}
+	if state == failedState {
+		return state, false, fmt.Errorf("%w: %s", errFailedState, state)
+	}
+	return state, false, nil
+}
+
+func restoreJobState(j *atlasv2.DiskBackupSnapshotRestoreJob) (string, bool, error) {
+	switch {
+	case j.GetFailed():
+		return failedState, false, fmt.Errorf("%w: %s", errFailedState, failedState)
+	case j.GetExpired():
+		return expiredState, false, fmt.Errorf("%w: %s", errFailedState, expiredState)
+	case j.GetCancelled():
+		return cancelledState, false, fmt.Errorf("%w: %s", errFailedState, cancelledState)
+	case j.HasFinishedAt():
+		return completedState, true, nil
+	case strings.EqualFold(j.GetDeliveryType(), downloadDeliveryType) && len(j.GetDeliveryUrl()) > 0:
+		return completedState, true, nil
+	default:
+		return runningState, false, nil
+	}
+}
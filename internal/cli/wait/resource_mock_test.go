@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: resource.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=resource_mock_test.go -package=wait -source=resource.go
+//
+
+// Package wait is a generated GoMock package.
+package wait
+
+import (
+	reflect "reflect"
+
+	admin "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDescriber is a mock of Describer interface.
+type MockDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockDescriberMockRecorder
+	isgomock struct{}
+}
+
+// MockDescriberMockRecorder is the mock recorder for MockDescriber.
+type MockDescriberMockRecorder struct {
+	mock *MockDescriber
+}
+
+// NewMockDescriber creates a new mock instance.
+func NewMockDescriber(ctrl *gomock.Controller) *MockDescriber {
+	mock := &MockDescriber{ctrl: ctrl}
+	mock.recorder = &MockDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDescriber) EXPECT() *MockDescriberMockRecorder {
+	return m.recorder
+}
+
+// LatestAtlasCluster mocks base method.
+func (m *MockDescriber) LatestAtlasCluster(arg0, arg1 string) (*admin.ClusterDescription20240805, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestAtlasCluster", arg0, arg1)
+	ret0, _ := ret[0].(*admin.ClusterDescription20240805)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestAtlasCluster indicates an expected call of LatestAtlasCluster.
+func (mr *MockDescriberMockRecorder) LatestAtlasCluster(arg0, arg1 any) *MockDescriberLatestAtlasClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAtlasCluster", reflect.TypeOf((*MockDescriber)(nil).LatestAtlasCluster), arg0, arg1)
+	return &MockDescriberLatestAtlasClusterCall{Call: call}
+}
+
+// MockDescriberLatestAtlasClusterCall wrap *gomock.Call
+type MockDescriberLatestAtlasClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDescriberLatestAtlasClusterCall) Return(arg0 *admin.ClusterDescription20240805, arg1 error) *MockDescriberLatestAtlasClusterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDescriberLatestAtlasClusterCall) Do(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockDescriberLatestAtlasClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDescriberLatestAtlasClusterCall) DoAndReturn(f func(string, string) (*admin.ClusterDescription20240805, error)) *MockDescriberLatestAtlasClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OnlineArchive mocks base method.
+func (m *MockDescriber) OnlineArchive(arg0, arg1, arg2 string) (*admin.BackupOnlineArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnlineArchive", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.BackupOnlineArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OnlineArchive indicates an expected call of OnlineArchive.
+func (mr *MockDescriberMockRecorder) OnlineArchive(arg0, arg1, arg2 any) *MockDescriberOnlineArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnlineArchive", reflect.TypeOf((*MockDescriber)(nil).OnlineArchive), arg0, arg1, arg2)
+	return &MockDescriberOnlineArchiveCall{Call: call}
+}
+
+// MockDescriberOnlineArchiveCall wrap *gomock.Call
+type MockDescriberOnlineArchiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDescriberOnlineArchiveCall) Return(arg0 *admin.BackupOnlineArchive, arg1 error) *MockDescriberOnlineArchiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDescriberOnlineArchiveCall) Do(f func(string, string, string) (*admin.BackupOnlineArchive, error)) *MockDescriberOnlineArchiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDescriberOnlineArchiveCall) DoAndReturn(f func(string, string, string) (*admin.BackupOnlineArchive, error)) *MockDescriberOnlineArchiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PrivateEndpoint mocks base method.
+func (m *MockDescriber) PrivateEndpoint(arg0, arg1, arg2 string) (*admin.EndpointService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateEndpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.EndpointService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateEndpoint indicates an expected call of PrivateEndpoint.
+func (mr *MockDescriberMockRecorder) PrivateEndpoint(arg0, arg1, arg2 any) *MockDescriberPrivateEndpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateEndpoint", reflect.TypeOf((*MockDescriber)(nil).PrivateEndpoint), arg0, arg1, arg2)
+	return &MockDescriberPrivateEndpointCall{Call: call}
+}
+
+// MockDescriberPrivateEndpointCall wrap *gomock.Call
+type MockDescriberPrivateEndpointCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDescriberPrivateEndpointCall) Return(arg0 *admin.EndpointService, arg1 error) *MockDescriberPrivateEndpointCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDescriberPrivateEndpointCall) Do(f func(string, string, string) (*admin.EndpointService, error)) *MockDescriberPrivateEndpointCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDescriberPrivateEndpointCall) DoAndReturn(f func(string, string, string) (*admin.EndpointService, error)) *MockDescriberPrivateEndpointCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RestoreJob mocks base method.
+func (m *MockDescriber) RestoreJob(arg0, arg1, arg2 string) (*admin.DiskBackupSnapshotRestoreJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*admin.DiskBackupSnapshotRestoreJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreJob indicates an expected call of RestoreJob.
+func (mr *MockDescriberMockRecorder) RestoreJob(arg0, arg1, arg2 any) *MockDescriberRestoreJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreJob", reflect.TypeOf((*MockDescriber)(nil).RestoreJob), arg0, arg1, arg2)
+	return &MockDescriberRestoreJobCall{Call: call}
+}
+
+// MockDescriberRestoreJobCall wrap *gomock.Call
+type MockDescriberRestoreJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDescriberRestoreJobCall) Return(arg0 *admin.DiskBackupSnapshotRestoreJob, arg1 error) *MockDescriberRestoreJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDescriberRestoreJobCall) Do(f func(string, string, string) (*admin.DiskBackupSnapshotRestoreJob, error)) *MockDescriberRestoreJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDescriberRestoreJobCall) DoAndReturn(f func(string, string, string) (*admin.DiskBackupSnapshotRestoreJob, error)) *MockDescriberRestoreJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
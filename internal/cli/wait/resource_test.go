@@ -0,0 +1,122 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    *resource
+		wantErr bool
+	}{
+		{arg: "cluster:Cluster0", want: &resource{kind: clusterKind, id: "Cluster0"}},
+		{arg: "cluster:Cluster0=idle", want: &resource{kind: clusterKind, id: "Cluster0", target: "IDLE"}},
+		{arg: "privateEndpoint:5f4fc14da2b47835a58c63a2", want: &resource{kind: privateEndpointKind, parent: "AWS", id: "5f4fc14da2b47835a58c63a2"}},
+		{arg: "privateEndpoint:azure/5f4fc14da2b47835a58c63a2", want: &resource{kind: privateEndpointKind, parent: "AZURE", id: "5f4fc14da2b47835a58c63a2"}},
+		{arg: "restoreJob:Cluster0/1", want: &resource{kind: restoreJobKind, parent: "Cluster0", id: "1"}},
+		{arg: "onlineArchive:Cluster0/1=ACTIVE", want: &resource{kind: onlineArchiveKind, parent: "Cluster0", id: "1", target: "ACTIVE"}},
+		{arg: "Cluster0", wantErr: true},
+		{arg: "cluster:", wantErr: true},
+		{arg: "cluster:a/b", wantErr: true},
+		{arg: "restoreJob:1", wantErr: true},
+		{arg: "restoreJob:Cluster0/1=COMPLETED", wantErr: true},
+		{arg: "onlineArchive:Cluster0/", wantErr: true},
+		{arg: "database:db", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := parseResource(tt.arg)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errInvalidResource)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResource_String(t *testing.T) {
+	assert.Equal(t, "cluster:Cluster0", (&resource{kind: clusterKind, id: "Cluster0"}).String())
+	assert.Equal(t, "restoreJob:Cluster0/1", (&resource{kind: restoreJobKind, parent: "Cluster0", id: "1"}).String())
+}
+
+func TestResource_Poll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockDescriber(ctrl)
+	const projectID = "5e2211c17a3e5a48f5497de3"
+
+	mockStore.EXPECT().LatestAtlasCluster(projectID, "Cluster0").
+		Return(&atlasv2.ClusterDescription20240805{StateName: pointer.Get("CREATING")}, nil)
+	state, done, err := (&resource{kind: clusterKind, id: "Cluster0"}).poll(mockStore, projectID)
+	require.NoError(t, err)
+	assert.Equal(t, "CREATING", state)
+	assert.False(t, done)
+
+	mockStore.EXPECT().PrivateEndpoint(projectID, "AWS", "1").
+		Return(&atlasv2.EndpointService{Status: pointer.Get("FAILED")}, nil)
+	_, done, err = (&resource{kind: privateEndpointKind, parent: "AWS", id: "1"}).poll(mockStore, projectID)
+	require.ErrorIs(t, err, errFailedState)
+	assert.False(t, done)
+
+	mockStore.EXPECT().RestoreJob(projectID, "Cluster0", "1").
+		Return(&atlasv2.DiskBackupSnapshotRestoreJob{FinishedAt: pointer.Get(time.Now())}, nil)
+	state, done, err = (&resource{kind: restoreJobKind, parent: "Cluster0", id: "1"}).poll(mockStore, projectID)
+	require.NoError(t, err)
+	assert.Equal(t, completedState, state)
+	assert.True(t, done)
+
+	mockStore.EXPECT().OnlineArchive(projectID, "Cluster0", "1").
+		Return(&atlasv2.BackupOnlineArchive{State: pointer.Get("PAUSED")}, nil)
+	state, done, err = (&resource{kind: onlineArchiveKind, parent: "Cluster0", id: "1"}).poll(mockStore, projectID)
+	require.NoError(t, err)
+	assert.Equal(t, "PAUSED", state)
+	assert.True(t, done)
+}
+
+func TestRestoreJobState(t *testing.T) {
+	tests := []struct {
+		name     string
+		job      *atlasv2.DiskBackupSnapshotRestoreJob
+		want     string
+		wantDone bool
+		wantErr  bool
+	}{
+		{name: "running", job: &atlasv2.DiskBackupSnapshotRestoreJob{}, want: runningState},
+		{name: "finished", job: &atlasv2.DiskBackupSnapshotRestoreJob{FinishedAt: pointer.Get(time.Now())}, want: completedState, wantDone: true},
+		{name: "download ready", job: &atlasv2.DiskBackupSnapshotRestoreJob{DeliveryType: "download", DeliveryUrl: &[]string{"https://example.com"}}, want: completedState, wantDone: true},
+		{name: "failed", job: &atlasv2.DiskBackupSnapshotRestoreJob{Failed: pointer.Get(true)}, want: failedState, wantErr: true},
+		{name: "expired", job: &atlasv2.DiskBackupSnapshotRestoreJob{Expired: pointer.Get(true)}, want: expiredState, wantErr: true},
+		{name: "cancelled", job: &atlasv2.DiskBackupSnapshotRestoreJob{Cancelled: pointer.Get(true)}, want: cancelledState, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, done, err := restoreJobState(tt.job)
+			assert.Equal(t, tt.want, state)
+			assert.Equal(t, tt.wantDone, done)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli/require"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/usage"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/watchers"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const waitTemplate = `RESOURCE	STATE{{range .}}
+{{.Resource}}	{{.State}}{{end}}
+`
+
+var errAborted = errors.New("another resource failed")
+
+// Result is the final state of a resource.
+type Result struct {
+	Resource string `json:"resource"`
+	State    string `json:"state"`
+}
+
+type WaitOpts struct {
+	cli.ProjectOpts
+	cli.WatchOpts
+	resources []*resource
+	store     Describer
+	mu        sync.Mutex
+}
+
+func (opts *WaitOpts) initStore(ctx context.Context) func() error {
+	return func() error {
+		var err error
+		opts.store, err = store.New(store.AuthenticatedPreset(config.Default()), store.WithContext(ctx))
+		return err
+	}
+}
+
+func (opts *WaitOpts) parseResources(args []string) error {
+	opts.resources = make([]*resource, 0, len(args))
+	for _, arg := range args {
+		r, err := parseResource(arg)
+		if err != nil {
+			return err
+		}
+		opts.resources = append(opts.resources, r)
+	}
+	return nil
+}
+
+// report prints a state transition, as a JSON event when using JSON output.
+func (opts *WaitOpts) report(r *resource, state string) error {
+	opts.mu.Lock()
+	defer opts.mu.Unlock()
+
+	if opts.IsJSONOutput() {
+		return opts.PrintEvent(watchers.NewEvent(r.String(), state))
+	}
+	_, err := fmt.Fprintf(opts.ConfigWriter(), "%s is %s\n", r, state)
+	return err
+}
+
+func (opts *WaitOpts) wait(ctx context.Context, r *resource) (string, error) {
+	lastState := ""
+	for {
+		_, span := tracing.Start(ctx, "watcher poll", trace.WithAttributes(attribute.String("watcher.resource", r.String())))
+		state, done, err := r.poll(opts.store, opts.ConfigProjectID())
+		span.SetAttributes(attribute.String("watcher.state", state), attribute.Bool("watcher.done", done))
+		tracing.End(span, err)
+		if state != "" && state != lastState {
+			lastState = state
+			if reportErr := opts.report(r, state); reportErr != nil {
+				return state, reportErr
+			}
+		}
+		if err != nil || done {
+			return state, err
+		}
+		if err := watchers.Sleep(ctx, opts.GetDefaultWait()); err != nil {
+			return state, err
+		}
+	}
+}
+
+func (opts *WaitOpts) Run(ctx context.Context) error {
+	ctx, cancelTimeout := watchers.WithTimeout(ctx, opts.GetTimeout())
+	defer cancelTimeout()
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	results := make([]Result, len(opts.resources))
+	errs := make([]error, len(opts.resources))
+
+	var wg sync.WaitGroup
+	for i, r := range opts.resources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			state, err := opts.wait(ctx, r)
+			results[i] = Result{Resource: r.String(), State: state}
+			if err == nil {
+				return
+			}
+			if errors.Is(context.Cause(ctx), errAborted) {
+				// another resource failed first
+				return
+			}
+			errs[i] = fmt.Errorf("%s: %w", r, watchers.TimeoutError(err, opts.GetTimeout()))
+			cancel(errAborted)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	return opts.Print(results)
+}
+
+// Builder builds a cobra.Command that can run as:
+// atlas wait <kind>:<id>... [--watchTimeout seconds] [--projectId projectId].
+func Builder() *cobra.Command {
+	opts := &WaitOpts{}
+	cmd := &cobra.Command{
+		Use:   "wait <resource>...",
+		Short: "Wait until one or more resources in your project reach their target state.",
+		Long: `This command checks the state of every resource concurrently until all of them reach their target state.
+Resources are written as <kind>:<id>, optionally followed by =<targetState>:
+  cluster:<clusterName>                              waits for IDLE
+  privateEndpoint:[<provider>/]<privateEndpointId>   waits for AVAILABLE, the provider defaults to AWS
+  restoreJob:<clusterName>/<restoreJobId>            waits for the restore job to complete
+  onlineArchive:<clusterName>/<archiveId>            waits for a state other than PENDING or PAUSING
+
+The command fails as soon as any resource reaches a failed state.
+If the watch timeout expires, the command exits with code 124.
+State transitions are printed as they happen, as JSON lines on stderr when using JSON output.
+
+` + fmt.Sprintf(usage.RequiredRole, "Project Read Only"),
+		Example: `  # Wait for two clusters and a private endpoint to become available, for up to 30 minutes:
+  atlas wait cluster:Cluster0 cluster:Cluster1 privateEndpoint:AWS/5f4fc14da2b47835a58c63a2 --watchTimeout 1800
+
+  # Wait for an online archive to become active:
+  atlas wait onlineArchive:Cluster0/5f4fc14da2b47835a58c63a3=ACTIVE`,
+		Args: require.MinimumNArgs(1),
+		Annotations: map[string]string{
+			"resourceDesc": "Resource to wait for, in the <kind>:<id>[=<targetState>] format.",
+			"output":       waitTemplate,
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.PreRunE(
+				func() error { return opts.parseResources(args) },
+				opts.ValidateProjectID,
+				opts.initStore(cmd.Context()),
+				opts.InitOutput(cmd.OutOrStdout(), waitTemplate),
+			)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().Int64Var(&opts.Timeout, flag.WatchTimeout, 0, usage.WatchTimeout)
+
+	opts.AddProjectOptsFlags(cmd)
+	opts.AddOutputOptFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/watchers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.uber.org/mock/gomock"
+)
+
+const projectID = "5e2211c17a3e5a48f5497de3"
+
+func newTestOpts(t *testing.T, s Describer, args ...string) (*WaitOpts, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	out := new(bytes.Buffer)
+	progress := new(bytes.Buffer)
+	opts := &WaitOpts{
+		ProjectOpts: cli.ProjectOpts{ProjectID: projectID},
+		WatchOpts: cli.WatchOpts{
+			OutputOpts:     cli.OutputOpts{Template: waitTemplate, OutWriter: out},
+			DefaultWait:    time.Millisecond,
+			ProgressWriter: progress,
+		},
+		store: s,
+	}
+	require.NoError(t, opts.parseResources(args))
+	return opts, out, progress
+}
+
+func TestWaitOpts_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockDescriber(ctrl)
+
+	gomock.InOrder(
+		mockStore.EXPECT().LatestAtlasCluster(projectID, "Cluster0").
+			Return(&atlasv2.ClusterDescription20240805{StateName: pointer.Get("CREATING")}, nil),
+		mockStore.EXPECT().LatestAtlasCluster(projectID, "Cluster0").
+			Return(&atlasv2.ClusterDescription20240805{StateName: pointer.Get("IDLE")}, nil),
+	)
+	mockStore.EXPECT().PrivateEndpoint(projectID, "AWS", "1").
+		Return(&atlasv2.EndpointService{Status: pointer.Get("AVAILABLE")}, nil)
+
+	opts, out, _ := newTestOpts(t, mockStore, "cluster:Cluster0", "privateEndpoint:1")
+	require.NoError(t, opts.Run(t.Context()))
+
+	assert.Contains(t, out.String(), "cluster:Cluster0 is CREATING\n")
+	assert.Contains(t, out.String(), "cluster:Cluster0 is IDLE\n")
+	assert.True(t, strings.HasSuffix(out.String(), `RESOURCE	STATE
+cluster:Cluster0	IDLE
+privateEndpoint:AWS/1	AVAILABLE
+`))
+}
+
+func TestWaitOpts_Run_JSONEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockDescriber(ctrl)
+
+	mockStore.EXPECT().OnlineArchive(projectID, "Cluster0", "1").
+		Return(&atlasv2.BackupOnlineArchive{State: pointer.Get("ACTIVE")}, nil)
+
+	opts, out, progress := newTestOpts(t, mockStore, "onlineArchive:Cluster0/1")
+	opts.Output = "json"
+	require.NoError(t, opts.Run(t.Context()))
+
+	var e watchers.Event
+	require.NoError(t, json.Unmarshal(progress.Bytes(), &e))
+	assert.Equal(t, "onlineArchive:Cluster0/1", e.Resource)
+	assert.Equal(t, "ACTIVE", e.State)
+
+	var results []Result
+	require.NoError(t, json.Unmarshal(out.Bytes(), &results))
+	assert.Equal(t, []Result{{Resource: "onlineArchive:Cluster0/1", State: "ACTIVE"}}, results)
+}
+
+func TestWaitOpts_Run_FailFast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockDescriber(ctrl)
+
+	mockStore.EXPECT().LatestAtlasCluster(projectID, "Cluster0").
+		Return(&atlasv2.ClusterDescription20240805{StateName: pointer.Get("CREATING")}, nil).
+		AnyTimes()
+	mockStore.EXPECT().PrivateEndpoint(projectID, "AWS", "1").
+		Return(&atlasv2.EndpointService{Status: pointer.Get("FAILED")}, nil)
+
+	opts, _, _ := newTestOpts(t, mockStore, "cluster:Cluster0", "privateEndpoint:1")
+	opts.DefaultWait = time.Hour
+
+	err := opts.Run(t.Context())
+	require.ErrorIs(t, err, errFailedState)
+	assert.ErrorContains(t, err, "privateEndpoint:AWS/1")
+	assert.NotErrorIs(t, err, context.Canceled)
+}
+
+func TestWaitOpts_Run_Timeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockDescriber(ctrl)
+
+	mockStore.EXPECT().LatestAtlasCluster(projectID, "Cluster0").
+		Return(&atlasv2.ClusterDescription20240805{StateName: pointer.Get("CREATING")}, nil).
+		AnyTimes()
+
+	opts, _, _ := newTestOpts(t, mockStore, "cluster:Cluster0")
+	opts.DefaultWait = time.Hour
+	opts.Timeout = 1
+
+	err := opts.Run(t.Context())
+	require.ErrorIs(t, err, watchers.ErrTimeout)
+	assert.ErrorContains(t, err, "cluster:Cluster0")
+}
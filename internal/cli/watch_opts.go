@@ -16,8 +16,12 @@ package cli
 
 import (
 	"cmp"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -31,39 +35,92 @@ type WatchOpts struct {
 	DefaultWait    time.Duration
 	Timeout        int64
 	IsRetryableErr func(err error) bool
+	ProgressWriter io.Writer
 }
 
 const (
-	defaultWait = 4 * time.Second
-	speed       = 100 * time.Millisecond
+	defaultWait   = 4 * time.Second
+	speed         = 100 * time.Millisecond
+	waitingState  = "WAITING"
+	finishedState = "DONE"
 )
 
 type Watcher func() (any, bool, error)
 
-// Watch allow to init the OutputOpts in a functional way.
-func (opts *WatchOpts) Watch(f Watcher) (any, error) {
+// WatchContext polls f until it's done, the watch timeout expires or ctx is done.
+// Progress is reported as dots, or as JSON events when using JSON output.
+func (opts *WatchOpts) WatchContext(ctx context.Context, f Watcher) (any, error) {
 	if f == nil {
 		return nil, errors.New("no watcher provided")
 	}
+	ctx, cancel := watchers.WithTimeout(ctx, opts.GetTimeout())
+	defer cancel()
+
 	opts.start()
+	defer opts.stop()
+
+	lastState := ""
 	for {
-		value, done, err := opts.exponentialBackoff(f)
-		if err != nil || done {
-			opts.stop()
-			return value, err
+		value, done, err := opts.exponentialBackoff(ctx, f)
+		if err != nil {
+			return value, watchers.TimeoutError(err, opts.GetTimeout())
+		}
+		if err = opts.progress(value, done, &lastState); err != nil {
+			return nil, err
+		}
+		if done {
+			return value, nil
 		}
-		if !opts.IsTerminal() {
-			if _, err = fmt.Fprint(opts.ConfigWriter(), "."); err != nil {
-				return nil, err
-			}
+		if err = watchers.Sleep(ctx, opts.GetDefaultWait()); err != nil {
+			return nil, watchers.TimeoutError(err, opts.GetTimeout())
+		}
+	}
+}
+
+func (opts *WatchOpts) progress(value any, done bool, lastState *string) error {
+	if !opts.IsJSONOutput() {
+		if done || opts.IsTerminal() {
+			return nil
 		}
-		time.Sleep(opts.GetDefaultWait())
+		_, err := fmt.Fprint(opts.ConfigWriter(), ".")
+		return err
+	}
+
+	state, ok := watchers.StateOf(value)
+	if !ok || state == "" {
+		state = waitingState
+		if done {
+			state = finishedState
+		}
+	}
+	if state == *lastState {
+		return nil
+	}
+	*lastState = state
+
+	return opts.PrintEvent(watchers.NewEvent("", state))
+}
+
+// PrintEvent writes e as a JSON line to the progress writer.
+func (opts *WatchOpts) PrintEvent(e watchers.Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(opts.progressWriter(), string(b))
+	return err
+}
+
+func (opts *WatchOpts) progressWriter() io.Writer {
+	if opts.ProgressWriter == nil {
+		return os.Stderr
 	}
+	return opts.ProgressWriter
 }
 
 var backoffCoefficients = []float32{0.5, 1, 2}
 
-func (opts *WatchOpts) exponentialBackoff(f Watcher) (any, bool, error) {
+func (opts *WatchOpts) exponentialBackoff(ctx context.Context, f Watcher) (any, bool, error) {
 	if opts.IsRetryableErr == nil {
 		return f()
 	}
@@ -72,36 +129,53 @@ func (opts *WatchOpts) exponentialBackoff(f Watcher) (any, bool, error) {
 		if value, done, err := f(); err == nil || !opts.IsRetryableErr(err) {
 			return value, done, err
 		}
-		time.Sleep(time.Duration(coefficient) * opts.GetDefaultWait())
+		if err := watchers.Sleep(ctx, time.Duration(coefficient)*opts.GetDefaultWait()); err != nil {
+			return nil, false, err
+		}
 	}
 	// Should only happen after trying three times (>14 seconds)
 	return f()
 }
 
-func (opts *WatchOpts) WatchWatcher(w *watchers.Watcher) error {
-	if opts.EnableWatch {
-		opts.start()
-		err := w.Watch()
-		opts.stop()
-		return err
+// WatchWatcherContext runs w with the watch timeout, reporting state transitions as JSON events when using JSON output.
+func (opts *WatchOpts) WatchWatcherContext(ctx context.Context, w *watchers.Watcher) error {
+	if !opts.EnableWatch {
+		return nil
 	}
 
-	return nil
+	if w.Timeout == 0 {
+		w.Timeout = opts.GetTimeout()
+	}
+	if opts.IsJSONOutput() && w.OnStateChange == nil {
+		w.OnStateChange = func(e watchers.Event) {
+			_ = opts.PrintEvent(e)
+		}
+	}
+
+	opts.start()
+	defer opts.stop()
+	return w.WatchContext(ctx)
 }
 
 func (opts *WatchOpts) start() {
-	if opts.IsTerminal() {
+	if opts.IsTerminal() && !opts.IsJSONOutput() {
 		opts.s = spinner.New(spinner.CharSets[9], speed)
 		opts.s.Start()
 	}
 }
 
 func (opts *WatchOpts) stop() {
-	if opts.IsTerminal() {
+	if opts.s != nil {
 		opts.s.Stop()
+		opts.s = nil
 	}
 }
 
 func (opts *WatchOpts) GetDefaultWait() time.Duration {
 	return cmp.Or(opts.DefaultWait, defaultWait)
 }
+
+// GetTimeout returns the watch timeout, zero means no timeout.
+func (opts *WatchOpts) GetTimeout() time.Duration {
+	return time.Duration(opts.Timeout) * time.Second
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/watchers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stateResult struct {
+	state string
+}
+
+func (r *stateResult) GetStateName() string {
+	return r.state
+}
+
+func TestWatchOpts_WatchContext_JSONEvents(t *testing.T) {
+	progress := new(bytes.Buffer)
+	opts := &WatchOpts{
+		OutputOpts:     OutputOpts{Output: "json", OutWriter: new(bytes.Buffer)},
+		DefaultWait:    time.Millisecond,
+		ProgressWriter: progress,
+	}
+
+	states := []string{"CREATING", "CREATING", "IDLE"}
+	calls := 0
+	result, err := opts.WatchContext(t.Context(), func() (any, bool, error) {
+		state := states[calls]
+		calls++
+		return &stateResult{state: state}, state == "IDLE", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &stateResult{state: "IDLE"}, result)
+
+	lines := strings.Split(strings.TrimSpace(progress.String()), "\n")
+	require.Len(t, lines, 2)
+	for i, want := range []string{"CREATING", "IDLE"} {
+		var e watchers.Event
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &e))
+		assert.Equal(t, want, e.State)
+		assert.False(t, e.Time.IsZero())
+	}
+}
+
+func TestWatchOpts_WatchContext_Timeout(t *testing.T) {
+	opts := &WatchOpts{
+		OutputOpts:  OutputOpts{OutWriter: new(bytes.Buffer)},
+		DefaultWait: time.Hour,
+		Timeout:     1,
+	}
+
+	start := time.Now()
+	_, err := opts.WatchContext(t.Context(), func() (any, bool, error) {
+		return nil, false, nil
+	})
+	require.ErrorIs(t, err, watchers.ErrTimeout)
+	assert.Less(t, time.Since(start), time.Minute)
+}
+
+func TestWatchOpts_WatchContext_Cancelled(t *testing.T) {
+	opts := &WatchOpts{
+		OutputOpts:  OutputOpts{OutWriter: new(bytes.Buffer)},
+		DefaultWait: time.Hour,
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	_, err := opts.WatchContext(ctx, func() (any, bool, error) {
+		cancel()
+		return nil, false, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, watchers.ErrTimeout)
+}
+
+func TestWatchOpts_WatchContext_Error(t *testing.T) {
+	opts := &WatchOpts{OutputOpts: OutputOpts{OutWriter: new(bytes.Buffer)}}
+	errWatch := errors.New("watch failed")
+
+	_, err := opts.WatchContext(t.Context(), func() (any, bool, error) {
+		return nil, false, errWatch
+	})
+	require.ErrorIs(t, err, errWatch)
+}
@@ -23,10 +23,15 @@ import (
 )
 
 var GlobalFlagsToArgs = map[string]string{
-	flag.Profile:      "1",
-	flag.ProfileShort: "1",
-	flag.Debug:        "0",
-	flag.DebugShort:   "0",
+	flag.Profile:         "1",
+	flag.ProfileShort:    "1",
+	flag.Debug:           "0",
+	flag.DebugShort:      "0",
+	flag.MaxRetries:      "1",
+	flag.RetryMaxWait:    "1",
+	flag.RetryAllMethods: "0",
+	flag.DryRun:          "0",
+	flag.DryRunAlias:     "0",
 }
 
 func shouldRemoveFlagAndArgs(flags map[string]string, arg string) (int, error) {
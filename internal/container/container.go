@@ -17,6 +17,7 @@ package container
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 )
 
@@ -39,7 +40,9 @@ type RunFlags struct {
 	Hostname          *string
 	Ports             []PortMapping
 	Volumes           []VolumeMapping
+	VolumesFrom       *string
 	Env               map[string]string
+	Labels            map[string]string
 	Cmd               *string
 	Args              []string
 	Network           *string
@@ -60,6 +63,7 @@ type Engine interface {
 	Ready() error
 	VerifyVersion(context.Context) error
 	ContainerLogs(context.Context, string) ([]string, error)
+	ContainerLogsFollow(context.Context, string) (io.ReadCloser, error)
 	ContainerRun(context.Context, string, *RunFlags) (string, error)
 	ContainerList(context.Context, ...string) ([]Container, error)
 	ContainerRm(context.Context, ...string) error
@@ -68,6 +72,11 @@ type Engine interface {
 	ContainerUnpause(context.Context, ...string) error
 	ContainerInspect(context.Context, ...string) ([]*InspectData, error)
 	ContainerHealthStatus(context.Context, string) (DockerHealthcheckStatus, error)
+	NetworkCreate(context.Context, string) error
+	NetworkRm(context.Context, ...string) error
+	VolumeCreate(context.Context, string, map[string]string) error
+	VolumeList(context.Context, ...string) ([]Volume, error)
+	VolumeRm(context.Context, ...string) error
 	ImageList(context.Context, ...string) ([]Image, error)
 	ImagePull(context.Context, string) error
 	ImageHealthCheck(context.Context, string) (*ImageHealthCheck, error)
@@ -98,8 +107,14 @@ type Container struct {
 	Labels map[string]string
 }
 
+type Volume struct {
+	Name   string
+	Labels map[string]string
+}
+
 type InspectDataConfig struct {
-	Labels map[string]string `json:"Labels"`
+	Hostname string            `json:"Hostname"`
+	Labels   map[string]string `json:"Labels"`
 }
 
 type InspectData struct {
@@ -21,5 +21,5 @@ import "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
 
 func New() Engine {
 	_, _ = log.Debug("Using Docker engine")
-	return newDockerEngine()
+	return withDryRun(withTracing(newDockerEngine()))
 }
@@ -23,9 +23,9 @@ func New() Engine {
 	docker := newDockerEngine()
 	if err := docker.Ready(); err != nil {
 		_, _ = log.Debug("Using Podman engine")
-		return newPodmanEngine()
+		return withDryRun(withTracing(newPodmanEngine()))
 	}
 
 	_, _ = log.Debug("Using Docker engine")
-	return docker
+	return withDryRun(withTracing(docker))
 }
@@ -21,7 +21,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os/exec"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +39,7 @@ var errListContainer = errors.New("container listing failed")
 var errParsingContainer = errors.New("container parsing failed")
 var errDecodingJSON = errors.New("container decoding failed")
 var errParsingPorts = errors.New("parsing ports failed")
+var errListVolume = errors.New("volume listing failed")
 var errConvertHostPort = errors.New("converting host port failed")
 var errConvertContainerPort = errors.New("converting container port failed")
 var minDockerVersion = semver.New(27, 0, 0, "", "") //nolint:mnd
@@ -99,6 +102,10 @@ func (e *dockerImpl) ContainerLogs(ctx context.Context, name string) ([]string,
 	return strings.Split(string(buf), "\n"), nil
 }
 
+func (*dockerImpl) ContainerLogsFollow(ctx context.Context, name string) (io.ReadCloser, error) {
+	return startCommandStream(exec.CommandContext(ctx, "docker", "container", "logs", "--follow", name))
+}
+
 func parsePortMapping(s string) ([]PortMapping, error) {
 	if s == "" {
 		return nil, nil
@@ -229,6 +236,11 @@ func runFlags(flags *RunFlags) []string {
 		}
 	}
 
+	// labels are sorted so the arguments of a container don't depend on the map order
+	for _, key := range slices.Sorted(maps.Keys(flags.Labels)) {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", key, flags.Labels[key]))
+	}
+
 	if flags.Network != nil {
 		args = append(args, "--network", *flags.Network)
 	}
@@ -247,6 +259,10 @@ func runFlags(flags *RunFlags) []string {
 		}
 	}
 
+	if flags.VolumesFrom != nil {
+		args = append(args, "--volumes-from", *flags.VolumesFrom)
+	}
+
 	return args
 }
 
@@ -293,16 +309,7 @@ func parseContainers(buf []byte) ([]Container, error) {
 		}
 		cont.Ports = pm
 
-		labels := c["Labels"].(string)
-		cont.Labels = map[string]string{}
-		for _, label := range strings.Split(labels, ",") {
-			segments := strings.SplitN(label, "=", 2) //nolint //max 2 fields
-			if len(segments) == 2 {                   //nolint //max 2 fields
-				cont.Labels[segments[0]] = segments[1]
-			} else {
-				cont.Labels[segments[0]] = ""
-			}
-		}
+		cont.Labels = parseLabels(c["Labels"].(string))
 
 		result = append(result, cont)
 	}
@@ -310,6 +317,19 @@ func parseContainers(buf []byte) ([]Container, error) {
 	return result, nil
 }
 
+func parseLabels(labels string) map[string]string {
+	result := map[string]string{}
+	for _, label := range strings.Split(labels, ",") {
+		segments := strings.SplitN(label, "=", 2) //nolint //max 2 fields
+		if len(segments) == 2 {                   //nolint //max 2 fields
+			result[segments[0]] = segments[1]
+		} else {
+			result[segments[0]] = ""
+		}
+	}
+	return result
+}
+
 func (e *dockerImpl) ContainerList(ctx context.Context, labels ...string) ([]Container, error) {
 	args := []string{"container", "ls", "--all", "--format", "json"}
 
@@ -362,6 +382,66 @@ func (e *dockerImpl) ContainerUnpause(ctx context.Context, names ...string) erro
 	return err
 }
 
+func (e *dockerImpl) NetworkCreate(ctx context.Context, name string) error {
+	_, err := e.run(ctx, "network", "create", name)
+	return err
+}
+
+func (e *dockerImpl) NetworkRm(ctx context.Context, names ...string) error {
+	args := append([]string{"network", "rm"}, names...)
+
+	_, err := e.run(ctx, args...)
+	return err
+}
+
+func (e *dockerImpl) VolumeCreate(ctx context.Context, name string, labels map[string]string) error {
+	args := []string{"volume", "create"}
+	for key, value := range labels {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", key, value))
+	}
+	args = append(args, name)
+
+	_, err := e.run(ctx, args...)
+	return err
+}
+
+func (e *dockerImpl) VolumeList(ctx context.Context, labels ...string) ([]Volume, error) {
+	args := []string{"volume", "ls", "--format", "json"}
+	for _, label := range labels {
+		args = append(args, "-f", "label="+label)
+	}
+
+	buf, err := e.run(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errListVolume, err)
+	}
+
+	if len(buf) == 0 {
+		return nil, nil
+	}
+
+	volumes, err := readJsonl[struct {
+		Name   string `json:"Name"`
+		Labels string `json:"Labels"`
+	}](bytes.NewBuffer(buf))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errListVolume, err)
+	}
+
+	result := make([]Volume, 0, len(volumes))
+	for _, v := range volumes {
+		result = append(result, Volume{Name: v.Name, Labels: parseLabels(v.Labels)})
+	}
+	return result, nil
+}
+
+func (e *dockerImpl) VolumeRm(ctx context.Context, names ...string) error {
+	args := append([]string{"volume", "rm"}, names...)
+
+	_, err := e.run(ctx, args...)
+	return err
+}
+
 func (e *dockerImpl) ContainerInspect(ctx context.Context, names ...string) ([]*InspectData, error) {
 	args := append([]string{"container", "inspect", "--format", "json"}, names...)
 
@@ -234,3 +234,36 @@ func TestJsonL(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLabels(t *testing.T) {
+	got := parseLabels("mongodb-atlas-local=snapshot,mongodb-atlas-local.snapshot.id=a-1,empty")
+	expected := map[string]string{
+		"mongodb-atlas-local":             "snapshot",
+		"mongodb-atlas-local.snapshot.id": "a-1",
+		"empty":                           "",
+	}
+	if diff := deep.Equal(expected, got); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestRunFlags_Labels(t *testing.T) {
+	flags := &RunFlags{
+		Labels: map[string]string{
+			"mongodb-atlas-local-role":        "shard",
+			"mongodb-atlas-local":             "container",
+			"mongodb-atlas-local-replica-set": "myDeployment-shard-0",
+		},
+	}
+
+	expected := []string{
+		"--label", "mongodb-atlas-local=container",
+		"--label", "mongodb-atlas-local-replica-set=myDeployment-shard-0",
+		"--label", "mongodb-atlas-local-role=shard",
+	}
+	for range 10 {
+		if diff := deep.Equal(runFlags(flags), expected); diff != nil {
+			t.Errorf("runFlags() diff: %+v", diff)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dryrun"
+)
+
+// dryRunEngine prints the operations that change containers, networks, volumes or images instead of running them,
+// like the dry run mode does for the requests that change Atlas resources. Read operations are still run.
+type dryRunEngine struct {
+	Engine
+}
+
+// Operation is the printed form of an operation that was not run.
+type Operation struct {
+	Engine    string            `json:"engine"`
+	Operation string            `json:"operation"`
+	Names     []string          `json:"names,omitempty"`
+	Image     string            `json:"image,omitempty"`
+	Arguments []string          `json:"arguments,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+func withDryRun(e Engine) Engine {
+	if !dryrun.Enabled() {
+		return e
+	}
+	return &dryRunEngine{Engine: e}
+}
+
+func (e *dryRunEngine) skip(operation string, names ...string) error {
+	return dryrun.Skip(&Operation{Engine: e.Name(), Operation: operation, Names: names})
+}
+
+func (e *dryRunEngine) ContainerRun(_ context.Context, image string, flags *RunFlags) (string, error) {
+	return "", dryrun.Skip(&Operation{Engine: e.Name(), Operation: "ContainerRun", Image: image, Arguments: runFlags(flags)})
+}
+
+func (e *dryRunEngine) ContainerRm(_ context.Context, names ...string) error {
+	return e.skip("ContainerRm", names...)
+}
+
+func (e *dryRunEngine) ContainerStart(_ context.Context, names ...string) error {
+	return e.skip("ContainerStart", names...)
+}
+
+func (e *dryRunEngine) ContainerStop(_ context.Context, names ...string) error {
+	return e.skip("ContainerStop", names...)
+}
+
+func (e *dryRunEngine) ContainerUnpause(_ context.Context, names ...string) error {
+	return e.skip("ContainerUnpause", names...)
+}
+
+func (e *dryRunEngine) NetworkCreate(_ context.Context, name string) error {
+	return e.skip("NetworkCreate", name)
+}
+
+func (e *dryRunEngine) NetworkRm(_ context.Context, names ...string) error {
+	return e.skip("NetworkRm", names...)
+}
+
+func (e *dryRunEngine) VolumeCreate(_ context.Context, name string, labels map[string]string) error {
+	return dryrun.Skip(&Operation{Engine: e.Name(), Operation: "VolumeCreate", Names: []string{name}, Labels: labels})
+}
+
+func (e *dryRunEngine) VolumeRm(_ context.Context, names ...string) error {
+	return e.skip("VolumeRm", names...)
+}
+
+func (e *dryRunEngine) ImagePull(_ context.Context, name string) error {
+	return dryrun.Skip(&Operation{Engine: e.Name(), Operation: "ImagePull", Image: name})
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dryrun"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunEngine(t *testing.T) {
+	engine := &fakeEngine{}
+	assert.Same(t, engine, withDryRun(engine))
+
+	buf := new(bytes.Buffer)
+	dryrun.Enable(buf)
+	t.Cleanup(func() { dryrun.Enable(nil) })
+
+	e := withDryRun(engine)
+
+	images, err := e.ImageList(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []Image{{ID: "1"}}, images)
+	assert.Empty(t, buf.String())
+
+	err = e.ContainerRm(t.Context(), "myDeployment")
+	require.ErrorIs(t, err, dryrun.ErrRequestSkipped)
+	assert.JSONEq(t, `{"engine":"fake","operation":"ContainerRm","names":["myDeployment"]}`, buf.String())
+
+	buf.Reset()
+	name := "myDeployment"
+	_, err = e.ContainerRun(t.Context(), "mongodb/mongodb-atlas-local:8", &RunFlags{Name: &name})
+	require.ErrorIs(t, err, dryrun.ErrRequestSkipped)
+	assert.JSONEq(t, `{"engine":"fake","operation":"ContainerRun","image":"mongodb/mongodb-atlas-local:8","arguments":["--name","myDeployment"]}`, buf.String())
+}
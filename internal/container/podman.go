@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -58,6 +59,10 @@ func (e *podmanImpl) ContainerLogs(ctx context.Context, name string) ([]string,
 	return e.client.ContainerLogs(ctx, name)
 }
 
+func (e *podmanImpl) ContainerLogsFollow(ctx context.Context, name string) (io.ReadCloser, error) {
+	return e.client.ContainerLogsFollow(ctx, name)
+}
+
 //nolint:gocyclo
 func (e *podmanImpl) ContainerRun(ctx context.Context, image string, flags *RunFlags) (string, error) {
 	podmanOpts := podman.RunContainerOpts{
@@ -118,8 +123,12 @@ func (e *podmanImpl) ContainerRun(ctx context.Context, image string, flags *RunF
 				podmanOpts.Volumes[volume.HostPath] = volume.ContainerPath
 			}
 		}
+		if flags.VolumesFrom != nil {
+			podmanOpts.VolumesFrom = *flags.VolumesFrom
+		}
 		podmanOpts.Args = flags.Args
 		podmanOpts.EnvVars = flags.Env
+		podmanOpts.Labels = flags.Labels
 	}
 
 	buf, err := e.client.RunContainer(ctx, podmanOpts)
@@ -210,6 +219,41 @@ func (e *podmanImpl) ContainerUnpause(ctx context.Context, names ...string) erro
 	return err
 }
 
+func (e *podmanImpl) NetworkCreate(ctx context.Context, name string) error {
+	_, err := e.client.CreateNetwork(ctx, name)
+	return err
+}
+
+func (e *podmanImpl) NetworkRm(ctx context.Context, names ...string) error {
+	_, err := e.client.RemoveNetworks(ctx, names...)
+	return err
+}
+
+func (e *podmanImpl) VolumeCreate(ctx context.Context, name string, labels map[string]string) error {
+	_, err := e.client.CreateVolume(ctx, name, labels)
+	return err
+}
+
+func (e *podmanImpl) VolumeList(ctx context.Context, labels ...string) ([]Volume, error) {
+	volumes, err := e.client.ListVolumes(ctx, labels...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Volume, 0, len(volumes))
+	for _, v := range volumes {
+		result = append(result, Volume{
+			Name:   v.Name,
+			Labels: v.Labels,
+		})
+	}
+	return result, nil
+}
+
+func (e *podmanImpl) VolumeRm(ctx context.Context, names ...string) error {
+	_, err := e.client.RemoveVolumes(ctx, names...)
+	return err
+}
+
 func (e *podmanImpl) ContainerInspect(ctx context.Context, names ...string) ([]*InspectData, error) {
 	res, err := e.client.ContainerInspect(ctx, names...)
 	if err != nil {
@@ -243,7 +287,8 @@ func (e *podmanImpl) ContainerInspect(ctx context.Context, names ...string) ([]*
 			ID:   data.ID,
 			Name: data.Name,
 			Config: &InspectDataConfig{
-				Labels: data.Config.Labels,
+				Hostname: data.Config.Hostname,
+				Labels:   data.Config.Labels,
 			},
 			HostConfig: &InspectDataHostConfig{
 				PortBindings: portBidings,
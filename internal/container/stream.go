@@ -0,0 +1,77 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+)
+
+// commandStream streams the standard output of a running command, like docker container logs --follow.
+type commandStream struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr bytes.Buffer
+	once   sync.Once
+	err    error
+}
+
+func startCommandStream(cmd *exec.Cmd) (io.ReadCloser, error) {
+	s := &commandStream{cmd: cmd}
+	cmd.Stderr = &s.stderr
+
+	var err error
+	if s.ReadCloser, err = cmd.StdoutPipe(); err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Read returns the error of the command, with its standard error, once the command exits.
+func (s *commandStream) Read(p []byte) (int, error) {
+	n, err := s.ReadCloser.Read(p)
+	if errors.Is(err, io.EOF) {
+		if waitErr := s.wait(); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+// Close stops the command when it's still running.
+func (s *commandStream) Close() error {
+	_ = s.cmd.Process.Kill()
+	_ = s.wait()
+	return nil
+}
+
+func (s *commandStream) wait() error {
+	s.once.Do(func() {
+		err := s.cmd.Wait()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && s.stderr.Len() > 0 {
+			err = fmt.Errorf("%w: %s", exitErr, bytes.TrimSpace(s.stderr.Bytes()))
+		}
+		s.err = err
+	})
+	return s.err
+}
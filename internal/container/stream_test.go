@@ -0,0 +1,72 @@
+//go:build unix
+
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCommandStream(t *testing.T) {
+	s, err := startCommandStream(exec.Command("sh", "-c", "echo a; echo b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	got, err := io.ReadAll(s)
+	if err != nil {
+		t.Fatalf("ReadAll() unexpected error: %v", err)
+	}
+	if string(got) != "a\nb\n" {
+		t.Errorf("ReadAll() = %q", got)
+	}
+}
+
+func TestCommandStream_Error(t *testing.T) {
+	s, err := startCommandStream(exec.Command("sh", "-c", "echo no such container >&2; exit 1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if _, err := io.ReadAll(s); err == nil || !strings.Contains(err.Error(), "no such container") {
+		t.Errorf("ReadAll() expected the standard error of the command, got: %v", err)
+	}
+}
+
+func TestCommandStream_Close(t *testing.T) {
+	s, err := startCommandStream(exec.Command("sleep", "30"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_ = s.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close() didn't stop the command")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"io"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingEngine records a span for every operation of the engine it wraps.
+type tracingEngine struct {
+	Engine
+}
+
+func withTracing(e Engine) Engine {
+	return &tracingEngine{Engine: e}
+}
+
+func traced[T any](ctx context.Context, e Engine, operation string, f func(context.Context) (T, error)) (T, error) {
+	ctx, span := tracing.Start(ctx, "container "+operation, trace.WithAttributes(
+		attribute.String("container.engine", e.Name()),
+		attribute.String("container.operation", operation),
+	))
+	v, err := f(ctx)
+	tracing.End(span, err)
+	return v, err
+}
+
+func tracedErr(ctx context.Context, e Engine, operation string, f func(context.Context) error) error {
+	_, err := traced(ctx, e, operation, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, f(ctx)
+	})
+	return err
+}
+
+func (e *tracingEngine) VerifyVersion(ctx context.Context) error {
+	return tracedErr(ctx, e.Engine, "VerifyVersion", e.Engine.VerifyVersion)
+}
+
+func (e *tracingEngine) ContainerLogs(ctx context.Context, name string) ([]string, error) {
+	return traced(ctx, e.Engine, "ContainerLogs", func(ctx context.Context) ([]string, error) {
+		return e.Engine.ContainerLogs(ctx, name)
+	})
+}
+
+// ContainerLogsFollow only records the start of the stream.
+func (e *tracingEngine) ContainerLogsFollow(ctx context.Context, name string) (io.ReadCloser, error) {
+	return traced(ctx, e.Engine, "ContainerLogsFollow", func(ctx context.Context) (io.ReadCloser, error) {
+		return e.Engine.ContainerLogsFollow(ctx, name)
+	})
+}
+
+func (e *tracingEngine) ContainerRun(ctx context.Context, image string, flags *RunFlags) (string, error) {
+	return traced(ctx, e.Engine, "ContainerRun", func(ctx context.Context) (string, error) {
+		return e.Engine.ContainerRun(ctx, image, flags)
+	})
+}
+
+func (e *tracingEngine) ContainerList(ctx context.Context, names ...string) ([]Container, error) {
+	return traced(ctx, e.Engine, "ContainerList", func(ctx context.Context) ([]Container, error) {
+		return e.Engine.ContainerList(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ContainerRm(ctx context.Context, names ...string) error {
+	return tracedErr(ctx, e.Engine, "ContainerRm", func(ctx context.Context) error {
+		return e.Engine.ContainerRm(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ContainerStart(ctx context.Context, names ...string) error {
+	return tracedErr(ctx, e.Engine, "ContainerStart", func(ctx context.Context) error {
+		return e.Engine.ContainerStart(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ContainerStop(ctx context.Context, names ...string) error {
+	return tracedErr(ctx, e.Engine, "ContainerStop", func(ctx context.Context) error {
+		return e.Engine.ContainerStop(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ContainerUnpause(ctx context.Context, names ...string) error {
+	return tracedErr(ctx, e.Engine, "ContainerUnpause", func(ctx context.Context) error {
+		return e.Engine.ContainerUnpause(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ContainerInspect(ctx context.Context, names ...string) ([]*InspectData, error) {
+	return traced(ctx, e.Engine, "ContainerInspect", func(ctx context.Context) ([]*InspectData, error) {
+		return e.Engine.ContainerInspect(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ContainerHealthStatus(ctx context.Context, name string) (DockerHealthcheckStatus, error) {
+	return traced(ctx, e.Engine, "ContainerHealthStatus", func(ctx context.Context) (DockerHealthcheckStatus, error) {
+		return e.Engine.ContainerHealthStatus(ctx, name)
+	})
+}
+
+func (e *tracingEngine) NetworkCreate(ctx context.Context, name string) error {
+	return tracedErr(ctx, e.Engine, "NetworkCreate", func(ctx context.Context) error {
+		return e.Engine.NetworkCreate(ctx, name)
+	})
+}
+
+func (e *tracingEngine) NetworkRm(ctx context.Context, names ...string) error {
+	return tracedErr(ctx, e.Engine, "NetworkRm", func(ctx context.Context) error {
+		return e.Engine.NetworkRm(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) VolumeCreate(ctx context.Context, name string, labels map[string]string) error {
+	return tracedErr(ctx, e.Engine, "VolumeCreate", func(ctx context.Context) error {
+		return e.Engine.VolumeCreate(ctx, name, labels)
+	})
+}
+
+func (e *tracingEngine) VolumeList(ctx context.Context, labels ...string) ([]Volume, error) {
+	return traced(ctx, e.Engine, "VolumeList", func(ctx context.Context) ([]Volume, error) {
+		return e.Engine.VolumeList(ctx, labels...)
+	})
+}
+
+func (e *tracingEngine) VolumeRm(ctx context.Context, names ...string) error {
+	return tracedErr(ctx, e.Engine, "VolumeRm", func(ctx context.Context) error {
+		return e.Engine.VolumeRm(ctx, names...)
+	})
+}
+
+func (e *tracingEngine) ImageList(ctx context.Context, references ...string) ([]Image, error) {
+	return traced(ctx, e.Engine, "ImageList", func(ctx context.Context) ([]Image, error) {
+		return e.Engine.ImageList(ctx, references...)
+	})
+}
+
+func (e *tracingEngine) ImagePull(ctx context.Context, name string) error {
+	return tracedErr(ctx, e.Engine, "ImagePull", func(ctx context.Context) error {
+		return e.Engine.ImagePull(ctx, name)
+	})
+}
+
+func (e *tracingEngine) ImageHealthCheck(ctx context.Context, name string) (*ImageHealthCheck, error) {
+	return traced(ctx, e.Engine, "ImageHealthCheck", func(ctx context.Context) (*ImageHealthCheck, error) {
+		return e.Engine.ImageHealthCheck(ctx, name)
+	})
+}
+
+func (e *tracingEngine) Version(ctx context.Context) (map[string]any, error) {
+	return traced(ctx, e.Engine, "Version", e.Engine.Version)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type recorder struct {
+	spans tracetest.SpanStubs
+}
+
+func (r *recorder) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	r.spans = append(r.spans, tracetest.SpanStubsFromReadOnlySpans(spans)...)
+	return nil
+}
+
+func (*recorder) Shutdown(context.Context) error {
+	return nil
+}
+
+type fakeEngine struct {
+	Engine
+	err error
+}
+
+func (*fakeEngine) Name() string {
+	return "fake"
+}
+
+func (e *fakeEngine) ContainerRm(context.Context, ...string) error {
+	return e.err
+}
+
+func (*fakeEngine) ImageList(context.Context, ...string) ([]Image, error) {
+	return []Image{{ID: "1"}}, nil
+}
+
+func TestTracingEngine(t *testing.T) {
+	exporter := &recorder{}
+	tracing.Enable(exporter)
+
+	e := withTracing(&fakeEngine{err: errors.New("no such container")})
+	images, err := e.ImageList(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, images, 1)
+	require.Error(t, e.ContainerRm(context.Background(), "local"))
+	require.NoError(t, tracing.Finish(context.Background(), nil))
+
+	require.Len(t, exporter.spans, 2)
+	assert.Equal(t, "container ImageList", exporter.spans[0].Name)
+	assert.Contains(t, exporter.spans[0].Attributes, attribute.String("container.engine", "fake"))
+	assert.Equal(t, "container ContainerRm", exporter.spans[1].Name)
+	assert.Equal(t, codes.Error, exporter.spans[1].Status.Code)
+}
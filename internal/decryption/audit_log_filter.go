@@ -0,0 +1,124 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decryption
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditLogFilter selects the decrypted audit log records to output.
+// A record matches when it matches every set criterion, and any of the values of a criterion.
+type AuditLogFilter struct {
+	ATypes     []string
+	Users      []string
+	Namespaces []string
+	Since      time.Time
+	Until      time.Time
+}
+
+func (f *AuditLogFilter) isEmpty() bool {
+	return f == nil || (len(f.ATypes) == 0 && len(f.Users) == 0 && len(f.Namespaces) == 0 && f.Since.IsZero() && f.Until.IsZero())
+}
+
+// Match reports whether the decoded audit log record matches the filter.
+func (f *AuditLogFilter) Match(record map[string]any) bool {
+	if f.isEmpty() {
+		return true
+	}
+
+	if len(f.ATypes) > 0 && !slices.Contains(f.ATypes, recordString(record, "atype")) {
+		return false
+	}
+
+	if len(f.Users) > 0 && !slices.ContainsFunc(recordUsers(record), func(u string) bool { return slices.Contains(f.Users, u) }) {
+		return false
+	}
+
+	if len(f.Namespaces) > 0 && !slices.ContainsFunc(f.Namespaces, func(ns string) bool { return matchNamespace(ns, recordString(record, "param", "ns")) }) {
+		return false
+	}
+
+	if f.Since.IsZero() && f.Until.IsZero() {
+		return true
+	}
+
+	ts, ok := recordTime(record)
+	if !ok {
+		return false
+	}
+	return (f.Since.IsZero() || !ts.Before(f.Since)) && (f.Until.IsZero() || ts.Before(f.Until))
+}
+
+// matchNamespace matches a database.collection namespace, or all the namespaces of a database.
+func matchNamespace(filter, ns string) bool {
+	if ns == "" {
+		return false
+	}
+	if ns == filter {
+		return true
+	}
+	return !strings.Contains(filter, ".") && strings.HasPrefix(ns, filter+".")
+}
+
+func recordValue(record map[string]any, path ...string) any {
+	var value any = record
+	for _, key := range path {
+		doc, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = doc[key]
+	}
+	return value
+}
+
+func recordString(record map[string]any, path ...string) string {
+	s, _ := recordValue(record, path...).(string)
+	return s
+}
+
+// recordUsers returns the names of the users of the record, both as user and user@db.
+func recordUsers(record map[string]any) []string {
+	users, _ := recordValue(record, "users").(primitive.A)
+	names := make([]string, 0, 2*len(users))
+	for _, u := range users {
+		doc, ok := u.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := doc["user"].(string)
+		db, _ := doc["db"].(string)
+		names = append(names, name, name+"@"+db)
+	}
+	return names
+}
+
+func recordTime(record map[string]any) (time.Time, bool) {
+	switch ts := record["ts"].(type) {
+	case primitive.DateTime:
+		return ts.Time(), true
+	case time.Time:
+		return ts, true
+	case *time.Time:
+		if ts != nil {
+			return *ts, true
+		}
+	}
+	return time.Time{}, false
+}
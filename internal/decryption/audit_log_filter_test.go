@@ -0,0 +1,83 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decryption
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func testAuditLogRecord(t *testing.T) map[string]any {
+	t.Helper()
+
+	raw, err := bson.Marshal(bson.D{
+		{Key: "atype", Value: "authCheck"},
+		{Key: "ts", Value: primitive.NewDateTimeFromTime(time.Date(2022, 2, 7, 11, 7, 30, 0, time.UTC))},
+		{Key: "local", Value: bson.D{{Key: "ip", Value: "127.0.0.1"}, {Key: "port", Value: 27017}}},
+		{Key: "remote", Value: bson.D{{Key: "ip", Value: "10.0.0.1"}, {Key: "port", Value: 51234}}},
+		{Key: "users", Value: bson.A{bson.D{{Key: "user", Value: "alice"}, {Key: "db", Value: "admin"}}}},
+		{Key: "param", Value: bson.D{{Key: "command", Value: "find"}, {Key: "ns", Value: "sales.orders"}}},
+		{Key: "result", Value: 13},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var record map[string]any
+	if err := bson.Unmarshal(raw, &record); err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+func TestAuditLogFilter_Match(t *testing.T) {
+	record := testAuditLogRecord(t)
+	ts := time.Date(2022, 2, 7, 11, 7, 30, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		filter *AuditLogFilter
+		want   bool
+	}{
+		{name: "nil", filter: nil, want: true},
+		{name: "empty", filter: &AuditLogFilter{}, want: true},
+		{name: "atype", filter: &AuditLogFilter{ATypes: []string{"authenticate", "authCheck"}}, want: true},
+		{name: "other atype", filter: &AuditLogFilter{ATypes: []string{"authenticate"}}, want: false},
+		{name: "user", filter: &AuditLogFilter{Users: []string{"alice"}}, want: true},
+		{name: "user with database", filter: &AuditLogFilter{Users: []string{"alice@admin"}}, want: true},
+		{name: "other user", filter: &AuditLogFilter{Users: []string{"bob"}}, want: false},
+		{name: "namespace", filter: &AuditLogFilter{Namespaces: []string{"sales.orders"}}, want: true},
+		{name: "database", filter: &AuditLogFilter{Namespaces: []string{"sales"}}, want: true},
+		{name: "other collection", filter: &AuditLogFilter{Namespaces: []string{"sales.customers"}}, want: false},
+		{name: "database prefix", filter: &AuditLogFilter{Namespaces: []string{"sal"}}, want: false},
+		{name: "since", filter: &AuditLogFilter{Since: ts}, want: true},
+		{name: "after", filter: &AuditLogFilter{Since: ts.Add(time.Second)}, want: false},
+		{name: "until", filter: &AuditLogFilter{Until: ts}, want: false},
+		{name: "before", filter: &AuditLogFilter{Until: ts.Add(time.Second)}, want: true},
+		{name: "all", filter: &AuditLogFilter{ATypes: []string{"authCheck"}, Users: []string{"alice"}, Namespaces: []string{"sales"}, Since: ts, Until: ts.Add(time.Hour)}, want: true},
+		{name: "all but user", filter: &AuditLogFilter{ATypes: []string{"authCheck"}, Users: []string{"bob"}, Namespaces: []string{"sales"}, Since: ts, Until: ts.Add(time.Hour)}, want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.filter.Match(record); got != tc.want {
+				t.Errorf("expected: %v got: %v", tc.want, got)
+			}
+		})
+	}
+}
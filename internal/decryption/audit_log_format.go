@@ -0,0 +1,277 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decryption
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// AuditLogOutputFormat is the format of the decrypted audit log records.
+type AuditLogOutputFormat string
+
+const (
+	// AuditLogOutputNDJSON writes a relaxed extended JSON document per line, like the audit log itself.
+	AuditLogOutputNDJSON AuditLogOutputFormat = "ndjson"
+	// AuditLogOutputCEF writes an ArcSight Common Event Format event per line.
+	AuditLogOutputCEF AuditLogOutputFormat = "cef"
+	// AuditLogOutputOCSF writes an Open Cybersecurity Schema Framework event per line.
+	AuditLogOutputOCSF AuditLogOutputFormat = "ocsf"
+)
+
+// AuditLogOutputFormats lists the supported output formats.
+var AuditLogOutputFormats = []string{string(AuditLogOutputNDJSON), string(AuditLogOutputCEF), string(AuditLogOutputOCSF)}
+
+var ErrUnsupportedOutputFormat = errors.New("unsupported output format")
+
+const (
+	cefVendor         = "MongoDB"
+	cefProduct        = "Atlas"
+	cefVersion        = "1.0"
+	cefSeverityLow    = 3
+	cefSeverityMed    = 5
+	cefSeverityHigh   = 7
+	ocsfVersion       = "1.1.0"
+	ocsfProduct       = "MongoDB Atlas"
+	ocsfVendor        = "MongoDB"
+	ocsfSuccess       = 1
+	ocsfFailure       = 2
+	ocsfInformation   = 1
+	ocsfMedium        = 3
+	ocsfHigh          = 4
+	ocsfActivityOther = 99
+)
+
+// OCSF classes and categories the audit log records are mapped to.
+const (
+	ocsfBaseEvent            = 0
+	ocsfIdentityCategory     = 3
+	ocsfAuthenticationClass  = 3002
+	ocsfApplicationCategory  = 6
+	ocsfAPIActivityClass     = 6003
+	ocsfAuthenticationLogon  = 1
+	ocsfAuthenticationLogoff = 2
+)
+
+func formatAuditLogRecord(format AuditLogOutputFormat, value any) ([]byte, error) {
+	switch format {
+	case AuditLogOutputNDJSON, "":
+		return bson.MarshalExtJSON(value, false, false)
+	case AuditLogOutputCEF:
+		return formatCEF(value)
+	case AuditLogOutputOCSF:
+		return formatOCSF(value)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedOutputFormat, format)
+	}
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+type cefExtension []string
+
+func (e *cefExtension) add(key string, value any) {
+	if value == nil || value == "" {
+		return
+	}
+	*e = append(*e, key+"="+cefExtensionEscaper.Replace(fmt.Sprint(value)))
+}
+
+func cefEvent(signatureID string, severity int, ext cefExtension) []byte {
+	signatureID = cefHeaderEscaper.Replace(signatureID)
+	return fmt.Appendf(nil, "CEF:0|%s|%s|%s|%s|%s|%d|%s", cefVendor, cefProduct, cefVersion, signatureID, signatureID, severity, strings.Join(ext, " "))
+}
+
+func formatCEF(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case AuditLogError:
+		severity := cefSeverityHigh
+		if v.Level == AuditLogErrorLevelWarning {
+			severity = cefSeverityMed
+		}
+		var ext cefExtension
+		if v.TS != nil {
+			ext.add("rt", v.TS.UnixMilli())
+		}
+		ext.add("cn1Label", "line")
+		ext.add("cn1", v.Line)
+		ext.add("msg", v.Err.Error())
+		return cefEvent(string(v.Level), severity, ext), nil
+	case map[string]any:
+		result := recordValue(v, "result")
+		severity, outcome := cefSeverityLow, "success"
+		if !isSuccess(result) {
+			severity, outcome = cefSeverityHigh, "failure"
+		}
+
+		var ext cefExtension
+		if ts, ok := recordTime(v); ok {
+			ext.add("rt", ts.UnixMilli())
+		}
+		ext.add("src", recordValue(v, "remote", "ip"))
+		ext.add("spt", recordValue(v, "remote", "port"))
+		ext.add("dst", recordValue(v, "local", "ip"))
+		ext.add("dpt", recordValue(v, "local", "port"))
+		if users := recordUsers(v); len(users) > 0 {
+			ext.add("suser", users[1])
+		}
+		ext.add("outcome", outcome)
+		ext.add("cn1Label", "result")
+		ext.add("cn1", result)
+		if ns := recordString(v, "param", "ns"); ns != "" {
+			ext.add("cs1Label", "namespace")
+			ext.add("cs1", ns)
+		}
+		if param := recordValue(v, "param"); param != nil {
+			p, err := bson.MarshalExtJSON(param, false, false)
+			if err != nil {
+				return nil, err
+			}
+			ext.add("msg", string(p))
+		}
+		return cefEvent(recordString(v, "atype"), severity, ext), nil
+	default:
+		return nil, fmt.Errorf("%w: %s can't format %T", ErrUnsupportedOutputFormat, AuditLogOutputCEF, value)
+	}
+}
+
+func isSuccess(result any) bool {
+	switch r := result.(type) {
+	case int32:
+		return r == 0
+	case int64:
+		return r == 0
+	case float64:
+		return r == 0
+	}
+	return true
+}
+
+func ocsfMetadata() bson.D {
+	return bson.D{
+		{Key: "version", Value: ocsfVersion},
+		{Key: "product", Value: bson.D{
+			{Key: "name", Value: ocsfProduct},
+			{Key: "vendor_name", Value: ocsfVendor},
+		}},
+		{Key: "log_name", Value: "audit"},
+	}
+}
+
+func ocsfEndpoint(record map[string]any, key string) bson.D {
+	endpoint := bson.D{}
+	if ip := recordValue(record, key, "ip"); ip != nil {
+		endpoint = append(endpoint, bson.E{Key: "ip", Value: ip})
+	}
+	if port := recordValue(record, key, "port"); port != nil {
+		endpoint = append(endpoint, bson.E{Key: "port", Value: port})
+	}
+	return endpoint
+}
+
+func formatOCSF(value any) ([]byte, error) {
+	var event bson.D
+	switch v := value.(type) {
+	case AuditLogError:
+		severity := ocsfHigh
+		if v.Level == AuditLogErrorLevelWarning {
+			severity = ocsfMedium
+		}
+		var ts int64
+		if v.TS != nil {
+			ts = v.TS.UnixMilli()
+		}
+		event = bson.D{
+			{Key: "metadata", Value: ocsfMetadata()},
+			{Key: "time", Value: ts},
+			{Key: "category_uid", Value: ocsfBaseEvent},
+			{Key: "class_uid", Value: ocsfBaseEvent},
+			{Key: "activity_id", Value: ocsfActivityOther},
+			{Key: "type_uid", Value: ocsfActivityOther},
+			{Key: "severity_id", Value: severity},
+			{Key: "status_id", Value: ocsfFailure},
+			{Key: "message", Value: v.Err.Error()},
+			{Key: "unmapped", Value: bson.D{
+				{Key: "atype", Value: string(v.Level)},
+				{Key: "line", Value: v.Line},
+			}},
+		}
+	case map[string]any:
+		event = ocsfRecordEvent(v)
+	default:
+		return nil, fmt.Errorf("%w: %s can't format %T", ErrUnsupportedOutputFormat, AuditLogOutputOCSF, value)
+	}
+	return bson.MarshalExtJSON(event, false, false)
+}
+
+func ocsfRecordEvent(record map[string]any) bson.D {
+	atype := recordString(record, "atype")
+	category, class, activity := ocsfApplicationCategory, ocsfAPIActivityClass, ocsfActivityOther
+	switch atype {
+	case "authenticate":
+		category, class, activity = ocsfIdentityCategory, ocsfAuthenticationClass, ocsfAuthenticationLogon
+	case "logout":
+		category, class, activity = ocsfIdentityCategory, ocsfAuthenticationClass, ocsfAuthenticationLogoff
+	}
+
+	status := ocsfSuccess
+	if !isSuccess(recordValue(record, "result")) {
+		status = ocsfFailure
+	}
+
+	var ts int64
+	if t, ok := recordTime(record); ok {
+		ts = t.UnixMilli()
+	}
+
+	actor := bson.D{}
+	if users := recordUsers(record); len(users) > 0 {
+		actor = append(actor, bson.E{Key: "user", Value: bson.D{{Key: "name", Value: users[1]}}})
+	}
+
+	event := bson.D{
+		{Key: "metadata", Value: ocsfMetadata()},
+		{Key: "time", Value: ts},
+		{Key: "category_uid", Value: category},
+		{Key: "class_uid", Value: class},
+		{Key: "activity_id", Value: activity},
+		{Key: "type_uid", Value: class*100 + activity},
+		{Key: "severity_id", Value: ocsfInformation},
+		{Key: "status_id", Value: status},
+		{Key: "actor", Value: actor},
+		{Key: "src_endpoint", Value: ocsfEndpoint(record, "remote")},
+		{Key: "dst_endpoint", Value: ocsfEndpoint(record, "local")},
+	}
+	if result := recordValue(record, "result"); result != nil {
+		event = append(event, bson.E{Key: "status_code", Value: fmt.Sprint(result)})
+	}
+	if class == ocsfAPIActivityClass {
+		event = append(event, bson.E{Key: "api", Value: bson.D{{Key: "operation", Value: atype}}})
+		if ns := recordString(record, "param", "ns"); ns != "" {
+			event = append(event, bson.E{Key: "resources", Value: bson.A{bson.D{
+				{Key: "name", Value: ns},
+				{Key: "type", Value: "namespace"},
+			}}})
+		}
+	}
+
+	return append(event, bson.E{Key: "unmapped", Value: record})
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogOutput_CEF(t *testing.T) {
+	out := new(bytes.Buffer)
+	output := NewAuditLogOutput(out, AuditLogOutputCEF, nil)
+
+	require.NoError(t, output.LogRecord(2, testAuditLogRecord(t)))
+	ts := time.UnixMilli(1644232049921)
+	require.NoError(t, output.Warningf(3, &AuditLogLine{TS: &ts}, "line %d skipped, a|b=c", 3))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `CEF:0|MongoDB|Atlas|1.0|authCheck|authCheck|7|rt=1644232050000 src=10.0.0.1 spt=51234 dst=127.0.0.1 dpt=27017 suser=alice@admin outcome=failure cn1Label=result cn1=13 cs1Label=namespace cs1=sales.orders msg={"command":"find","ns":"sales.orders"}`, lines[0])
+	assert.Equal(t, `CEF:0|MongoDB|Atlas|1.0|decryptionLogWarning|decryptionLogWarning|5|rt=1644232049921 cn1Label=line cn1=3 msg=line 3 skipped, a|b\=c`, lines[1])
+}
+
+func TestAuditLogOutput_OCSF(t *testing.T) {
+	out := new(bytes.Buffer)
+	output := NewAuditLogOutput(out, AuditLogOutputOCSF, nil)
+
+	require.NoError(t, output.LogRecord(2, testAuditLogRecord(t)))
+	require.NoError(t, output.Error(3, nil, errors.New("corrupted")))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.InDelta(t, 6003, event["class_uid"], 0)
+	assert.InDelta(t, 600399, event["type_uid"], 0)
+	assert.InDelta(t, 2, event["status_id"], 0)
+	assert.Equal(t, "13", event["status_code"])
+	assert.Equal(t, map[string]any{"user": map[string]any{"name": "alice@admin"}}, event["actor"])
+	assert.Equal(t, map[string]any{"operation": "authCheck"}, event["api"])
+	assert.Equal(t, map[string]any{"ip": "10.0.0.1", "port": float64(51234)}, event["src_endpoint"])
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.InDelta(t, 0, event["class_uid"], 0)
+	assert.Equal(t, "corrupted", event["message"])
+}
+
+func TestAuditLogOutput_Filter(t *testing.T) {
+	out := new(bytes.Buffer)
+	output := NewAuditLogOutput(out, AuditLogOutputNDJSON, &AuditLogFilter{ATypes: []string{"authenticate"}})
+
+	require.NoError(t, output.LogRecord(2, testAuditLogRecord(t)))
+	assert.Empty(t, out.String())
+
+	require.NoError(t, output.Error(3, nil, errors.New("corrupted")))
+	assert.JSONEq(t, `{"atype":"decryptionLogError","line":3,"error":"corrupted"}`, out.String())
+}
+
+func TestAuditLogOutput_UnsupportedFormat(t *testing.T) {
+	output := NewAuditLogOutput(new(bytes.Buffer), "xml", nil)
+	if err := output.LogRecord(1, testAuditLogRecord(t)); !errors.Is(err, ErrUnsupportedOutputFormat) {
+		t.Errorf("expected: %v got: %v", ErrUnsupportedOutputFormat, err)
+	}
+}
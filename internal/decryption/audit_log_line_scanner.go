@@ -22,10 +22,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 )
 
-var (
-	ErrNoBytesToRead  = errors.New("no bytes to read")
-	ErrSeekNotAllowed = errors.New("impossible to seek bytes")
-)
+var ErrNoBytesToRead = errors.New("no bytes to read")
 
 type AuditLogFormat string
 
@@ -34,48 +31,32 @@ const (
 	BSON AuditLogFormat = "BSON"
 )
 
-func peekFirstByte(reader io.ReadSeeker) (byte, error) {
-	b := make([]byte, 1)
-
-	n, err := reader.Read(b)
-	if err != nil {
-		return 0, err
-	}
-
-	if n != 1 {
-		return 0, ErrNoBytesToRead
-	}
-
-	c, err := reader.Seek(0, io.SeekStart)
-	if err != nil {
-		return 0, err
-	}
-	if c != 0 {
-		return 0, ErrSeekNotAllowed
-	}
-	return b[0], nil
-}
-
-func readAuditLogFile(reader io.ReadSeeker) (auditLogScanner, error) {
+// readAuditLogFile detects the format of the audit log from its first byte,
+// peeking it from a buffered reader so that pipes and other non seekable streams are supported.
+func readAuditLogFile(reader io.Reader) (auditLogScanner, error) {
 	auditLogFormat := BSON
 
-	b, err := peekFirstByte(reader)
+	bufReader := bufio.NewReader(reader)
+	b, err := bufReader.Peek(1)
 	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrNoBytesToRead
+		}
 		return nil, err
 	}
 
-	if b == '{' {
+	if b[0] == '{' {
 		auditLogFormat = JSON
 	}
 
 	var scanner auditLogScanner
 	switch auditLogFormat {
 	case BSON:
-		scanner = newBSONScanner(reader)
+		scanner = newBSONScanner(bufReader)
 	case JSON:
-		scanner = newJSONScanner(reader)
+		scanner = newJSONScanner(bufReader)
 	}
-	return scanner, err
+	return scanner, nil
 }
 
 type auditLogScanner interface {
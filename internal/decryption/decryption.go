@@ -16,8 +16,13 @@ package decryption
 
 import (
 	"io"
+	"runtime"
+	"sync"
 )
 
+// entriesPerWorker bounds the number of audit log lines read ahead of the writer, per worker.
+const entriesPerWorker = 64
+
 type DecryptSection struct {
 	lek                    []byte
 	compressionMode        CompressionMode
@@ -34,7 +39,10 @@ func (s *DecryptSection) zeroLEK() {
 }
 
 type Decryption struct {
-	opts KeyProviderOpts
+	opts    KeyProviderOpts
+	workers int
+	format  AuditLogOutputFormat
+	filter  *AuditLogFilter
 }
 
 type Option func(d *Decryption)
@@ -47,6 +55,47 @@ func NewDecryption(options ...Option) *Decryption {
 	return d
 }
 
+// WithWorkers sets the number of workers decrypting log records concurrently, defaults to the number of CPUs.
+func WithWorkers(workers int) func(d *Decryption) {
+	return func(d *Decryption) {
+		d.workers = workers
+	}
+}
+
+// WithOutputFormat sets the format of the decrypted audit log records, defaults to NDJSON.
+func WithOutputFormat(format AuditLogOutputFormat) func(d *Decryption) {
+	return func(d *Decryption) {
+		d.format = format
+	}
+}
+
+// WithFilter only outputs the decrypted audit log records that match filter.
+func WithFilter(filter *AuditLogFilter) func(d *Decryption) {
+	return func(d *Decryption) {
+		d.filter = filter
+	}
+}
+
+func WithLocalOpts(keyFileName string) func(d *Decryption) {
+	return func(d *Decryption) {
+		d.opts.Local = &KeyProviderLocalOpts{
+			KeyFileName: keyFileName,
+		}
+	}
+}
+
+func WithKMIPOpts(serverCAFileName, clientCertificateFileName, clientCertificatePassword, username, password string) func(d *Decryption) {
+	return func(d *Decryption) {
+		d.opts.KMIP = &KeyProviderKMIPOpts{
+			ServerCAFileName:          serverCAFileName,
+			ClientCertificateFileName: clientCertificateFileName,
+			ClientCertificatePassword: clientCertificatePassword,
+			Username:                  username,
+			Password:                  password,
+		}
+	}
+}
+
 func WithAWSOpts(accessKey, secretAccessKey, sessionToken string) func(d *Decryption) {
 	return func(d *Decryption) {
 		d.opts.AWS = &KeyProviderAWSOpts{
@@ -75,83 +124,213 @@ func WithAzureOpts(tenantID, clientID, secret string) func(d *Decryption) {
 	}
 }
 
-// Decrypt decrypts the content of an audit log file using the metadata found in the file,
+// Decrypt decrypts the content of an audit log using the metadata found in it,
 // the credentials provided by the user and the AES-GCM algorithm.
-// The decrypted audit log records are saved in the out stream.
-func (d *Decryption) Decrypt(logReader io.ReadSeeker, out io.Writer) error {
+// The audit log is streamed from logReader, so it can be a pipe, and the log records of each
+// header section are decrypted concurrently by the workers of the Decryption.
+// The decrypted audit log records are saved in the out stream in the order of the audit log.
+func (d *Decryption) Decrypt(logReader io.Reader, out io.Writer) error {
+	return d.run(logReader, &auditLogWriter{output: NewAuditLogOutput(out, d.format, d.filter)})
+}
+
+func (d *Decryption) run(logReader io.Reader, w *auditLogWriter) error {
 	logLineScanner, err := readAuditLogFile(logReader)
 	if err != nil {
 		return err
 	}
 
-	output := NewAuditLogOutput(out)
+	workers := d.workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	entries := make(chan *auditLogEntry, workers*entriesPerWorker)
+	jobs := make(chan *auditLogEntry, workers)
+	stop := make(chan struct{})
+	writeErr := make(chan error, 1)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for e := range jobs {
+				e.decrypt()
+			}
+		}()
+	}
+	go func() {
+		writeErr <- w.write(entries, stop)
+	}()
+
+	d.scanAuditLog(logLineScanner, entries, jobs, stop)
+
+	close(jobs)
+	close(entries)
+	err = <-writeErr
+	wg.Wait()
+
+	w.section.zeroLEK()
+	// when the output failed, the lines read ahead are never written
+	for e := range entries {
+		e.section.zeroLEK()
+	}
+	return err
+}
+
+// scanAuditLog reads the audit log lines in order and processes the headers, which may prompt for credentials.
+// The log records are queued to the workers, and every line to the writer, until stop is closed.
+func (d *Decryption) scanAuditLog(logLineScanner auditLogScanner, entries, jobs chan<- *auditLogEntry, stop <-chan struct{}) {
 	var decryptSection *DecryptSection
 
+	send := func(e *auditLogEntry) bool {
+		if e.kind == auditLogEntryRecord && e.section != nil {
+			e.done = make(chan struct{})
+			select {
+			case jobs <- e:
+			case <-stop:
+				return false
+			}
+		}
+		select {
+		case entries <- e:
+			return true
+		case <-stop:
+			return false
+		}
+	}
+
 	idx := 0
 	for ; logLineScanner.Scan(); idx++ {
 		lineNb := idx + 1
 		logLine, err := logLineScanner.AuditLogLine()
-		if err != nil {
-			if outputErr := output.Errorf(lineNb, logLine, "error parsing line %d, %v", lineNb, err); outputErr != nil {
-				return outputErr
-			}
-			if decryptSection != nil {
-				// even if log record is corrupted, consider it was encrypted,
-				// so the lastKeyInvocationCount should be incremented
-				decryptSection.lastKeyInvocationCount++
-			}
-			continue
+		e := &auditLogEntry{
+			lineNb:  lineNb,
+			logLine: logLine,
+			section: decryptSection,
 		}
-
-		switch logLine.AuditRecordType {
-		case AuditHeaderRecord:
-			decryptSection.zeroLEK()
-			if decryptSection, err = processHeader(logLine, d.opts); err != nil {
-				if outputErr := output.Errorf(lineNb, logLine, `error processing header line %d: %s`, lineNb, err); outputErr != nil {
-					return outputErr
-				}
-			}
-		case AuditLogRecord:
-			if err := decryptAuditLogRecord(decryptSection, logLine, output, lineNb); err != nil {
-				return err
-			}
+		switch {
+		case err != nil:
+			e.kind = auditLogEntryCorrupted
+			e.err = err
+		case logLine.AuditRecordType == AuditHeaderRecord:
+			e.kind = auditLogEntryHeader
+			decryptSection, e.err = processHeader(logLine, d.opts)
+			e.section = decryptSection
+		case logLine.AuditRecordType == AuditLogRecord:
+			e.kind = auditLogEntryRecord
 		default:
-			if outputErr := output.Errorf(lineNb, logLine, `line %d skipped, unknown auditRecordType="%s"`, lineNb, logLine.AuditRecordType); outputErr != nil {
-				return outputErr
-			}
+			e.kind = auditLogEntryUnknown
+		}
+		if !send(e) {
+			return
 		}
 	}
-	decryptSection.zeroLEK()
 	if err := logLineScanner.Err(); err != nil {
-		lineNb := idx + 1
-		if outputErr := output.Errorf(lineNb, nil, "error parsing line %d, %v", lineNb, err); outputErr != nil {
-			return outputErr
+		send(&auditLogEntry{
+			kind:   auditLogEntryCorrupted,
+			lineNb: idx + 1,
+			err:    err,
+		})
+	}
+}
+
+type auditLogEntryKind int
+
+const (
+	auditLogEntryCorrupted auditLogEntryKind = iota
+	auditLogEntryHeader
+	auditLogEntryRecord
+	auditLogEntryUnknown
+)
+
+// auditLogEntry is a line of the audit log on its way to the output.
+// For log records, the decryption result is set by a worker, which closes done once finished.
+type auditLogEntry struct {
+	kind    auditLogEntryKind
+	lineNb  int
+	logLine *AuditLogLine
+	section *DecryptSection
+	err     error
+
+	done               chan struct{}
+	logRecord          any
+	keyInvocationCount uint64
+}
+
+func (e *auditLogEntry) decrypt() {
+	defer close(e.done)
+	e.logRecord, e.keyInvocationCount, e.err = processLogRecord(e.section, e.logLine, e.lineNb)
+}
+
+// auditLogWriter writes the entries in the order of the audit log and validates the key invocation counts,
+// which depend on the previous log records of the section. The integrity issues are added to report, when set.
+type auditLogWriter struct {
+	output  AuditLogOutput
+	report  *VerificationReport
+	section *DecryptSection
+}
+
+// write consumes the entries until the channel is closed, it closes stop when the output fails.
+func (w *auditLogWriter) write(entries <-chan *auditLogEntry, stop chan<- struct{}) error {
+	for e := range entries {
+		var err error
+		switch e.kind {
+		case auditLogEntryCorrupted:
+			w.report.addIssue(e.lineNb, IssueCorruptedLine, "%v", e.err)
+			err = w.output.Errorf(e.lineNb, e.logLine, "error parsing line %d, %v", e.lineNb, e.err)
+			if e.section != nil {
+				// even if log record is corrupted, consider it was encrypted,
+				// so the lastKeyInvocationCount should be incremented
+				e.section.lastKeyInvocationCount++
+			}
+		case auditLogEntryHeader:
+			// the log records of the previous section are all written, so its key is no longer needed
+			w.section.zeroLEK()
+			w.section = e.section
+			w.report.addSection(e.lineNb, e.err)
+			if e.err != nil {
+				err = w.output.Errorf(e.lineNb, e.logLine, `error processing header line %d: %s`, e.lineNb, e.err)
+			}
+		case auditLogEntryRecord:
+			err = w.writeLogRecord(e)
+		case auditLogEntryUnknown:
+			w.report.addIssue(e.lineNb, IssueUnknownRecordType, "%s", e.logLine.AuditRecordType)
+			err = w.output.Errorf(e.lineNb, e.logLine, `line %d skipped, unknown auditRecordType="%s"`, e.lineNb, e.logLine.AuditRecordType)
+		}
+		if err != nil {
+			close(stop)
+			return err
 		}
 	}
 
 	return nil
 }
 
-func decryptAuditLogRecord(decryptSection *DecryptSection, logLine *AuditLogLine, output AuditLogOutput, lineNb int) error {
-	if decryptSection == nil {
-		return output.Warningf(lineNb, logLine, `line %d skipped, the header record for current section is missing or corrupted`, lineNb)
+func (w *auditLogWriter) writeLogRecord(e *auditLogEntry) error {
+	if e.section == nil {
+		w.report.addIssue(e.lineNb, IssueRecordWithoutHeader, "the header record for current section is missing or corrupted")
+		return w.output.Warningf(e.lineNb, e.logLine, `line %d skipped, the header record for current section is missing or corrupted`, e.lineNb)
 	}
 
-	decryptedLogRecord, keyInvocationCount, err := processLogRecord(decryptSection, logLine, lineNb)
-	if err != nil {
+	<-e.done
+	if e.err != nil {
 		// even if log record is corrupted, consider it was encrypted,
 		// so the lastKeyInvocationCount should be incremented
-		decryptSection.lastKeyInvocationCount++
-		return output.Error(lineNb, logLine, err)
+		e.section.lastKeyInvocationCount++
+		w.report.addIssue(e.lineNb, IssueCorruptedLine, "%v", e.err)
+		return w.output.Error(e.lineNb, e.logLine, e.err)
 	}
 
-	err = validateLogRecord(decryptSection, keyInvocationCount)
-	decryptSection.lastKeyInvocationCount = keyInvocationCount
+	w.report.addRecord()
+	w.report.addKeyInvocationCount(e.lineNb, e.section.lastKeyInvocationCount+1, e.keyInvocationCount)
+	err := validateLogRecord(e.section, e.keyInvocationCount)
+	e.section.lastKeyInvocationCount = e.keyInvocationCount
 	if err != nil {
-		if outputErr := output.Error(lineNb, logLine, err); outputErr != nil {
+		if outputErr := w.output.Error(e.lineNb, e.logLine, err); outputErr != nil {
 			return outputErr
 		}
 	}
 
-	return output.LogRecord(lineNb, decryptedLogRecord)
+	return w.output.LogRecord(e.lineNb, e.logRecord)
 }
@@ -15,8 +15,24 @@
 package decryption
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func Test_zeroLEK(t *testing.T) {
@@ -28,3 +44,133 @@ func Test_zeroLEK(t *testing.T) {
 		t.Errorf("expected: %v got: %v", expected, d.lek)
 	}
 }
+
+// localKeyAuditLog is encrypted with the base64 encoded key of localKey.
+const (
+	localKey         = "YXRsYXNjbGktbG9jYWwta2V5LWZvci1lMmUtdGVzdHM="
+	localKeyAuditLog = `{"ts":{"$date":{"$numberLong":"1644232049921"}},"version":"0.0","compressionMode":"zstd","keyStoreIdentifier":{"provider":"local","filename":"localKey"},"encryptedKey":{"$binary":{"base64":"MDEyMzQ1Njc4OWFiY2RlZtb1r8rXt/MszVn7btjVLYWOn5SHe5HYx5Uuu8oCBs/vqC+ARhFLVwhEE91ybiedDw==","subType":"0"}},"MAC":"qE9fUsGK0EuRrrCRAQAAAAAAAAAAAAAA","auditRecordType":"header"}
+{"ts":{"$date":{"$numberLong":"1644232049922"}},"log":"1Lu4o8XVMM/Rg7GKAQAAAAEAAAAAAAAA/8tXQ36mEd90OaAOzCOSti7N5a2jr0B9ek48/uvyteG/zUJHyM16Hs3wMEhDqTQGBwGhWSHEqXh0/5Jbz6tXsYHhDTMr1BOsn1zaavZScx/CkO5+Hd8Vx+zeFPREtQTe1y+JngXSIroezeyV0/zF4YC4vpug+OZtrEQLNEgwT2bjaqUyaKDbmzCNetd2Ff/eFfMFzinbzKVgXAC7T4YmDuowqXommEXLIBiYh2u4VagwJKZRw5OGZjnvqwyVpSPgGqLxGKUoFigh3NgC6EuGi17VIs5BLRZOIw7+OfbPgQQiKzjCxCk="}
+{"ts":{"$date":{"$numberLong":"1644232050645"}},"log":"gjn0BYabxQku84PTAQAAAAIAAAAAAAAAuTcMKIEGtlkPyGGqcj3mVOW0d+SUKGREXwicsBC5oDs+f4GkiNQmwXzpsassKTGLCS30xoEe/2NK/pBB7hB0mUmmoEVXCqgT28aztDXlkN5GeX0ECCYG4HoQyzyVdIi/SFF1Xa7jTLkYGdqwghDaMD/PzJVXcdhCckqPUn9CZKurMnsVZ7KiL8hpgNpQ8Q=="}
+`
+)
+
+func TestDecrypt_LocalKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "localKey")
+	require.NoError(t, os.WriteFile(keyFile, []byte(localKey+"\n"), 0600))
+
+	out := new(bytes.Buffer)
+	d := NewDecryption(WithLocalOpts(keyFile))
+	require.NoError(t, d.Decrypt(strings.NewReader(localKeyAuditLog), out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"atype":"startup"`)
+	assert.Contains(t, lines[1], `"atype":"createDatabase"`)
+}
+
+func TestDecrypt_LocalKeyWrongKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "localKey")
+	require.NoError(t, os.WriteFile(keyFile, []byte("cG52YisrM3NiaHhJSmRmT0RPcTV1SWFVWDh5eFR1V1M="), 0600))
+
+	out := new(bytes.Buffer)
+	d := NewDecryption(WithLocalOpts(keyFile))
+	require.NoError(t, d.Decrypt(strings.NewReader(localKeyAuditLog), out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], `"atype":"decryptionLogError"`)
+	assert.Contains(t, lines[0], "unable to decrypt data key with the local key file")
+}
+
+func TestDecrypt_LocalKeyWithoutKeyFile(t *testing.T) {
+	// the localKey filename of the header doesn't exist in the working directory
+	t.Chdir(t.TempDir())
+
+	out := new(bytes.Buffer)
+	d := NewDecryption(WithLocalOpts(""))
+	require.NoError(t, d.Decrypt(strings.NewReader(localKeyAuditLog), out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "key provider not supported: local")
+}
+
+// localKeyLEK is the log encryption key of the header of localKeyAuditLog.
+const localKeyLEK = "pnvb++3sbhxIJdfODOq5uIaUX8yxTuWS95VLgES30FM="
+
+// encryptLogRecord returns an audit log line with doc compressed and encrypted like mongod does.
+func encryptLogRecord(t *testing.T, ts int64, keyInvocationCount uint64, doc bson.D) string {
+	t.Helper()
+
+	raw, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	compressed := encoder.EncodeAll(raw, nil)
+
+	lek, _ := base64.StdEncoding.DecodeString(localKeyLEK)
+	block, err := aes.NewCipher(lek)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCMWithTagSize(block, 12)
+	require.NoError(t, err)
+
+	iv := binary.LittleEndian.AppendUint32(nil, 1)
+	iv = binary.LittleEndian.AppendUint64(iv, keyInvocationCount)
+	aad := binary.LittleEndian.AppendUint64(nil, uint64(ts))
+	sealed := gcm.Seal(nil, iv, compressed, aad)
+	cipherText, tag := sealed[:len(compressed)], sealed[len(compressed):]
+
+	log := append(append(append([]byte{}, tag...), iv...), cipherText...)
+	return fmt.Sprintf(`{"ts":{"$date":{"$numberLong":"%d"}},"log":"%s"}`, ts, base64.StdEncoding.EncodeToString(log))
+}
+
+func TestDecrypt_Stream(t *testing.T) {
+	const records = 200
+	const skipped = 100
+
+	keyFile := filepath.Join(t.TempDir(), "localKey")
+	require.NoError(t, os.WriteFile(keyFile, []byte(localKey), 0600))
+
+	header, _, _ := strings.Cut(localKeyAuditLog, "\n")
+	lines := []string{header}
+	for i := 1; i <= records; i++ {
+		keyInvocationCount := uint64(i)
+		if i >= skipped {
+			keyInvocationCount++
+		}
+		lines = append(lines, encryptLogRecord(t, 1644232049922+int64(i), keyInvocationCount, bson.D{{Key: "atype", Value: "test"}, {Key: "n", Value: i}}))
+	}
+
+	for _, workers := range []int{1, 8} {
+		t.Run(fmt.Sprintf("workers_%d", workers), func(t *testing.T) {
+			// a pipe can't seek, like the standard input
+			r, w := io.Pipe()
+			go func() {
+				_, err := io.WriteString(w, strings.Join(lines, "\n"))
+				_ = w.CloseWithError(err)
+			}()
+
+			out := new(bytes.Buffer)
+			d := NewDecryption(WithLocalOpts(keyFile), WithWorkers(workers))
+			require.NoError(t, d.Decrypt(r, out))
+
+			n, mismatches := 0, 0
+			scanner := bufio.NewScanner(out)
+			for scanner.Scan() {
+				var got map[string]any
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &got))
+				if got["atype"] == string(AuditLogErrorLevelError) {
+					// the key invocation count of the skipped record is reported once, before the record
+					assert.InDelta(t, skipped+1, got["line"], 0)
+					assert.Contains(t, got["error"], ErrKeyInvokCountMismatch.Error())
+					mismatches++
+					continue
+				}
+				n++
+				assert.InDelta(t, n, got["n"], 0)
+			}
+			assert.Equal(t, records, n)
+			assert.Equal(t, 1, mismatches)
+		})
+	}
+}
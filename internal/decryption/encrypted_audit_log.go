@@ -17,6 +17,7 @@ package decryption
 import (
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/decryption/keyproviders"
@@ -32,6 +33,13 @@ type AuditRecordType string
 
 type AuditLogLineKeyStoreIdentifier struct {
 	Provider *keyproviders.KeyStoreProvider `json:"provider,omitempty"`
+	// local
+	Filename string `json:"filename,omitempty"`
+	// kmip
+	UID            string                         `json:"uid,omitempty"`
+	KMIPServerName []string                       `json:"kmipServerName,omitempty"`
+	KMIPPort       int                            `json:"kmipPort,omitempty"`
+	KeyWrapMethod  keyproviders.KMIPKeyWrapMethod `json:"keyWrapMethod,omitempty"`
 	// aws
 	Key      string `json:"key,omitempty"`
 	Region   string `json:"region,omitempty"`
@@ -59,6 +67,20 @@ type AuditLogLine struct {
 	Log                *string
 }
 
+// localKeyFileName returns the key file of the local provider, which defaults to the filename of the header when that file exists.
+func localKeyFileName(opts *KeyProviderLocalOpts, filename string) string {
+	if opts != nil && opts.KeyFileName != "" {
+		return opts.KeyFileName
+	}
+	if filename == "" {
+		return ""
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return ""
+	}
+	return filename
+}
+
 func (logLine *AuditLogLine) KeyProvider(opts KeyProviderOpts) (KeyProvider, error) {
 	if logLine.AuditRecordType != AuditHeaderRecord {
 		return nil, ErrInvalidHeaderLine
@@ -69,6 +91,30 @@ func (logLine *AuditLogLine) KeyProvider(opts KeyProviderOpts) (KeyProvider, err
 	}
 
 	switch *logLine.KeyStoreIdentifier.Provider {
+	case keyproviders.LocalKey:
+		keyFileName := localKeyFileName(opts.Local, logLine.KeyStoreIdentifier.Filename)
+		if keyFileName == "" {
+			return nil, fmt.Errorf("%w: %s", ErrKeyProviderNotSupported, *logLine.KeyStoreIdentifier.Provider)
+		}
+		return &keyproviders.LocalKeyIdentifier{
+			Filename:    logLine.KeyStoreIdentifier.Filename,
+			KeyFileName: keyFileName,
+		}, nil
+	case keyproviders.KMIP:
+		if opts.KMIP == nil {
+			return nil, fmt.Errorf("%w: %s", ErrKeyProviderNotSupported, *logLine.KeyStoreIdentifier.Provider)
+		}
+		return &keyproviders.KMIPKeyIdentifier{
+			UniqueKeyID:               logLine.KeyStoreIdentifier.UID,
+			ServerNames:               logLine.KeyStoreIdentifier.KMIPServerName,
+			ServerPort:                logLine.KeyStoreIdentifier.KMIPPort,
+			KeyWrapMethod:             logLine.KeyStoreIdentifier.KeyWrapMethod,
+			ServerCAFileName:          opts.KMIP.ServerCAFileName,
+			ClientCertificateFileName: opts.KMIP.ClientCertificateFileName,
+			ClientCertificatePassword: opts.KMIP.ClientCertificatePassword,
+			Username:                  opts.KMIP.Username,
+			Password:                  opts.KMIP.Password,
+		}, nil
 	case keyproviders.AWS:
 		if opts.AWS == nil {
 			return nil, fmt.Errorf("%w: %s", ErrKeyProviderNotSupported, *logLine.KeyStoreIdentifier.Provider)
@@ -17,9 +17,11 @@ package decryption
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/decryption/aes"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/decryption/keyproviders"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
@@ -32,6 +34,10 @@ var (
 	ErrEncryptedKeyMissing    = errors.New("missing encrypted key")
 	ErrMACMissing             = errors.New("missing mac")
 	ErrHeaderRecordInvalid    = errors.New("incorrect header record")
+	ErrLocalFilenameMissing   = errors.New("missing local key filename")
+	ErrKMIPUIDMissing         = errors.New("missing KMIP key unique identifier")
+	ErrKMIPServerNameMissing  = errors.New("missing KMIP server name")
+	ErrKMIPKeyWrapInvalid     = errors.New("invalid KMIP key wrap method")
 )
 
 type KeyProvider interface {
@@ -92,6 +98,10 @@ func validateHeaderFields(logLine *AuditLogLine) error {
 		return ErrProviderMissing
 	}
 
+	if err := validateKeyStoreIdentifier(&logLine.KeyStoreIdentifier); err != nil {
+		return err
+	}
+
 	if logLine.EncryptedKey == nil {
 		return ErrEncryptedKeyMissing
 	}
@@ -107,6 +117,28 @@ func validateHeaderFields(logLine *AuditLogLine) error {
 	return nil
 }
 
+// validateKeyStoreIdentifier checks the fields that locate the key of the local and KMIP providers.
+func validateKeyStoreIdentifier(id *AuditLogLineKeyStoreIdentifier) error {
+	switch *id.Provider {
+	case keyproviders.LocalKey:
+		if id.Filename == "" {
+			return ErrLocalFilenameMissing
+		}
+	case keyproviders.KMIP:
+		if id.UID == "" {
+			return ErrKMIPUIDMissing
+		}
+		if len(id.KMIPServerName) == 0 {
+			return ErrKMIPServerNameMissing
+		}
+		if id.KeyWrapMethod != "" && id.KeyWrapMethod != keyproviders.KMIPKeyWrapMethodGet && id.KeyWrapMethod != keyproviders.KMIPKeyWrapMethodEncrypt {
+			return fmt.Errorf("%w: %s", ErrKMIPKeyWrapInvalid, id.KeyWrapMethod)
+		}
+	}
+
+	return nil
+}
+
 func processHeader(logLine *AuditLogLine, opts KeyProviderOpts) (*DecryptSection, error) {
 	err := validateHeaderFields(logLine)
 	if err != nil {
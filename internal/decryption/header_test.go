@@ -16,6 +16,7 @@ package decryption
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -237,3 +238,52 @@ func Test_validateHeaderFields(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateKeyStoreIdentifier(t *testing.T) {
+	local := keyproviders.LocalKey
+	kmip := keyproviders.KMIP
+
+	testCases := []struct {
+		name    string
+		input   AuditLogLineKeyStoreIdentifier
+		wantErr error
+	}{
+		{
+			name:  "local",
+			input: AuditLogLineKeyStoreIdentifier{Provider: &local, Filename: "localKey"},
+		},
+		{
+			name:    "local without filename",
+			input:   AuditLogLineKeyStoreIdentifier{Provider: &local},
+			wantErr: ErrLocalFilenameMissing,
+		},
+		{
+			name:  "kmip",
+			input: AuditLogLineKeyStoreIdentifier{Provider: &kmip, UID: "1", KMIPServerName: []string{"kmip.example.com"}},
+		},
+		{
+			name:    "kmip without uid",
+			input:   AuditLogLineKeyStoreIdentifier{Provider: &kmip, KMIPServerName: []string{"kmip.example.com"}},
+			wantErr: ErrKMIPUIDMissing,
+		},
+		{
+			name:    "kmip without server",
+			input:   AuditLogLineKeyStoreIdentifier{Provider: &kmip, UID: "1"},
+			wantErr: ErrKMIPServerNameMissing,
+		},
+		{
+			name:    "kmip with unknown key wrap method",
+			input:   AuditLogLineKeyStoreIdentifier{Provider: &kmip, UID: "1", KMIPServerName: []string{"kmip.example.com"}, KeyWrapMethod: "wrap"},
+			wantErr: ErrKMIPKeyWrapInvalid,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateKeyStoreIdentifier(&tc.input)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected: %v got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -24,9 +24,11 @@ import (
 type KeyStoreProvider string
 
 const (
-	AWS   KeyStoreProvider = "aws"
-	GCP   KeyStoreProvider = "gcp"
-	Azure KeyStoreProvider = "azure"
+	LocalKey KeyStoreProvider = "local"
+	KMIP     KeyStoreProvider = "kmip"
+	AWS      KeyStoreProvider = "aws"
+	GCP      KeyStoreProvider = "gcp"
+	Azure    KeyStoreProvider = "azure"
 )
 
 type KeyStoreIdentifier struct {
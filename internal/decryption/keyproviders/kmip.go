@@ -0,0 +1,186 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyproviders
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/kmip"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+)
+
+type KMIPKeyWrapMethod string
+
+const (
+	KMIPKeyWrapMethodGet     KMIPKeyWrapMethod = "get"
+	KMIPKeyWrapMethodEncrypt KMIPKeyWrapMethod = "encrypt"
+
+	defaultKMIPPort = 5696
+)
+
+var (
+	ErrKMIPDecrypt           = errors.New("unable to decrypt data key with KMIP")
+	ErrKMIPInit              = errors.New("failed to initialize the KMIP client")
+	ErrKMIPServerCAInvalid   = errors.New("the KMIP server CA file doesn't contain any PEM certificate")
+	ErrKMIPClientCertMissing = errors.New("a KMIP client certificate file is required")
+)
+
+type KMIPKeyIdentifier struct {
+	KeyStoreIdentifier
+
+	// Header
+	UniqueKeyID   string
+	ServerNames   []string
+	ServerPort    int
+	KeyWrapMethod KMIPKeyWrapMethod
+
+	// CLI
+	ServerCAFileName          string
+	ClientCertificateFileName string
+	ClientCertificatePassword string
+	Username                  string
+	Password                  string
+
+	client *kmip.Client
+}
+
+func (ki *KMIPKeyIdentifier) ValidateCredentials() error {
+	if ki.ClientCertificateFileName == "" {
+		_, _ = log.Warningf(`No credentials found for resource: KMIP servers="%v" port="%v" uid="%v"
+`, ki.ServerNames, ki.ServerPort, ki.UniqueKeyID)
+
+		var err error
+		ki.ServerCAFileName, err = provideInput("Provide KMIP server CA filename:", ki.ServerCAFileName)
+		if err != nil {
+			return err
+		}
+		ki.ClientCertificateFileName, err = provideInput("Provide KMIP client certificate filename:", "")
+		if err != nil {
+			return err
+		}
+		if ki.ClientCertificateFileName == "" {
+			return ErrKMIPClientCertMissing
+		}
+	}
+
+	tlsConfig, err := ki.tlsConfig()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrKMIPInit, err)
+	}
+
+	port := ki.ServerPort
+	if port == 0 {
+		port = defaultKMIPPort
+	}
+	addrs := make([]string, 0, len(ki.ServerNames))
+	for _, name := range ki.ServerNames {
+		addrs = append(addrs, net.JoinHostPort(name, strconv.Itoa(port)))
+	}
+
+	ki.client = kmip.NewClient(addrs, kmip.Config{
+		TLS:      tlsConfig,
+		Username: ki.Username,
+		Password: ki.Password,
+	})
+	return nil
+}
+
+func (ki *KMIPKeyIdentifier) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if ki.ServerCAFileName != "" {
+		ca, err := os.ReadFile(ki.ServerCAFileName)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, ErrKMIPServerCAInvalid
+		}
+	}
+
+	cert, err := loadClientCertificate(ki.ClientCertificateFileName, ki.ClientCertificatePassword)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Certificates = []tls.Certificate{cert}
+
+	return cfg, nil
+}
+
+// loadClientCertificate loads a PEM file holding the certificate and its private key, like the mongod --kmipClientCertificateFile.
+func loadClientCertificate(filename, password string) (tls.Certificate, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	var certPEM, keyPEM []byte
+	for block, rest := pem.Decode(b); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			certPEM = append(certPEM, pem.EncodeToMemory(block)...)
+			continue
+		}
+
+		//nolint:staticcheck // SA1019: legacy PEM encryption is the format of password protected mongod certificate key files
+		if password != "" && x509.IsEncryptedPEMBlock(block) {
+			der, err := x509.DecryptPEMBlock(block, []byte(password)) //nolint:staticcheck // SA1019: see above
+			if err != nil {
+				return tls.Certificate{}, err
+			}
+			block = &pem.Block{Type: block.Type, Bytes: der}
+		}
+		keyPEM = append(keyPEM, pem.EncodeToMemory(block)...)
+	}
+
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// DecryptKey unwraps the data key with the key encryption key of the KMIP server, depending on the key wrap method
+// the key encryption key is either retrieved with the Get operation or the server decrypts the data key.
+func (ki *KMIPKeyIdentifier) DecryptKey(encryptedKey []byte) ([]byte, error) {
+	ctx := context.Background()
+
+	if ki.KeyWrapMethod == KMIPKeyWrapMethodEncrypt {
+		if len(encryptedKey) <= ivLength {
+			return nil, fmt.Errorf("%w: %w", ErrKMIPDecrypt, ErrInvalidWrapped)
+		}
+		key, err := ki.client.Decrypt(ctx, ki.UniqueKeyID, encryptedKey[ivLength:], encryptedKey[:ivLength])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrKMIPDecrypt, err)
+		}
+		return key, nil
+	}
+
+	kek, err := ki.client.GetSymmetricKey(ctx, ki.UniqueKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKMIPDecrypt, err)
+	}
+
+	key, err := unwrapKey(kek, encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKMIPDecrypt, err)
+	}
+
+	return key, nil
+}
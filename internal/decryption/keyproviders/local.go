@@ -0,0 +1,103 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyproviders
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/decryption/aes"
+)
+
+const ivLength = 16
+
+var (
+	ErrLocalKeyDecrypt = errors.New("unable to decrypt data key with the local key file")
+	ErrInvalidWrapped  = errors.New("invalid wrapped key")
+)
+
+type LocalKeyIdentifier struct {
+	KeyStoreIdentifier
+
+	// Header
+	Filename string
+
+	// CLI
+	KeyFileName string
+}
+
+// ValidateCredentials looks for the key file, the header holds its path on the host that wrote the audit log.
+func (ki *LocalKeyIdentifier) ValidateCredentials() error {
+	if ki.KeyFileName != "" {
+		return nil
+	}
+
+	if _, err := os.Stat(ki.Filename); err != nil {
+		return fmt.Errorf("%w: %w", ErrLocalKeyDecrypt, err)
+	}
+	ki.KeyFileName = ki.Filename
+	return nil
+}
+
+// DecryptKey decrypts the data key with the base64 encoded key of the key file.
+func (ki *LocalKeyIdentifier) DecryptKey(encryptedKey []byte) ([]byte, error) {
+	encodedKEK, err := os.ReadFile(ki.KeyFileName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLocalKeyDecrypt, err)
+	}
+
+	kek, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedKEK)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLocalKeyDecrypt, err)
+	}
+
+	key, err := unwrapKey(kek, encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLocalKeyDecrypt, err)
+	}
+
+	return key, nil
+}
+
+// unwrapKey decrypts a key wrapped with AES-CBC and PKCS#7 padding, prefixed by its IV.
+func unwrapKey(kek, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < 2*ivLength || len(wrapped)%ivLength != 0 {
+		return nil, fmt.Errorf("%w: unexpected length %d", ErrInvalidWrapped, len(wrapped))
+	}
+
+	input := aes.CBCInput{
+		Key: kek,
+		IV:  wrapped[:ivLength],
+	}
+	key, err := input.Decrypt(wrapped[ivLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	return unpad(key)
+}
+
+func unpad(b []byte) ([]byte, error) {
+	n := int(b[len(b)-1])
+	if n == 0 || n > ivLength || !bytes.Equal(b[len(b)-n:], bytes.Repeat([]byte{byte(n)}, n)) {
+		return nil, fmt.Errorf("%w: bad padding", ErrInvalidWrapped)
+	}
+
+	return b[:len(b)-n], nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyproviders
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testLocalKey     = "YXRsYXNjbGktbG9jYWwta2V5LWZvci1lMmUtdGVzdHM="
+	testLocalWrapped = "MDEyMzQ1Njc4OWFiY2RlZtb1r8rXt/MszVn7btjVLYWOn5SHe5HYx5Uuu8oCBs/vqC+ARhFLVwhEE91ybiedDw=="
+)
+
+func writeLocalKey(t *testing.T, key string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "localKey")
+	if err := os.WriteFile(filename, []byte(key+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestLocalKeyIdentifier_DecryptKey(t *testing.T) {
+	wrapped, _ := base64.StdEncoding.DecodeString(testLocalWrapped)
+
+	ki := &LocalKeyIdentifier{KeyFileName: writeLocalKey(t, testLocalKey)}
+	key, err := ki.DecryptKey(wrapped)
+	if err != nil {
+		t.Fatalf("DecryptKey() unexpected error: %v", err)
+	}
+	if len(key) != 32 {
+		t.Errorf("expected a 32 bytes key, got %d", len(key))
+	}
+}
+
+func TestLocalKeyIdentifier_DecryptKey_Errors(t *testing.T) {
+	wrapped, _ := base64.StdEncoding.DecodeString(testLocalWrapped)
+
+	testCases := []struct {
+		name    string
+		key     string
+		wrapped []byte
+	}{
+		{
+			name:    "wrong key",
+			key:     "cG52YisrM3NiaHhJSmRmT0RPcTV1SWFVWDh5eFR1V1M=",
+			wrapped: wrapped,
+		},
+		{
+			name:    "not base64",
+			key:     "not a key",
+			wrapped: wrapped,
+		},
+		{
+			name:    "truncated",
+			key:     testLocalKey,
+			wrapped: wrapped[:ivLength],
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ki := &LocalKeyIdentifier{KeyFileName: writeLocalKey(t, tc.key)}
+			if _, err := ki.DecryptKey(tc.wrapped); !errors.Is(err, ErrLocalKeyDecrypt) {
+				t.Errorf("expected: %v got: %v", ErrLocalKeyDecrypt, err)
+			}
+		})
+	}
+}
+
+func TestLocalKeyIdentifier_ValidateCredentials(t *testing.T) {
+	filename := writeLocalKey(t, testLocalKey)
+
+	ki := &LocalKeyIdentifier{Filename: filename}
+	if err := ki.ValidateCredentials(); err != nil {
+		t.Fatalf("ValidateCredentials() unexpected error: %v", err)
+	}
+	if ki.KeyFileName != filename {
+		t.Errorf("expected: %s got: %s", filename, ki.KeyFileName)
+	}
+}
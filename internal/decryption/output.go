@@ -30,7 +30,9 @@ type AuditLogOutput interface {
 }
 
 type auditLogOutputWriter struct {
-	out io.Writer
+	out    io.Writer
+	format AuditLogOutputFormat
+	filter *AuditLogFilter
 }
 
 type AuditLogErrorLevel string
@@ -65,19 +67,23 @@ func (e AuditLogError) Error() string {
 	return e.Err.Error()
 }
 
-func NewAuditLogOutput(out io.Writer) AuditLogOutput {
+// NewAuditLogOutput writes the decrypted audit log records that match filter, in the given format.
+// Errors and warnings are always written, a nil filter matches all the records.
+func NewAuditLogOutput(out io.Writer, format AuditLogOutputFormat, filter *AuditLogFilter) AuditLogOutput {
 	return &auditLogOutputWriter{
-		out: out,
+		out:    out,
+		format: format,
+		filter: filter,
 	}
 }
 
 func (l *auditLogOutputWriter) writeRecord(value any) error {
-	jsonVal, err := bson.MarshalExtJSON(value, false, false)
+	line, err := formatAuditLogRecord(l.format, value)
 	if err != nil {
 		return err
 	}
 
-	_, err = l.out.Write(jsonVal)
+	_, err = l.out.Write(line)
 	if err != nil {
 		return err
 	}
@@ -116,5 +122,8 @@ func (l *auditLogOutputWriter) Errorf(lineNb int, logLine *AuditLogLine, format
 }
 
 func (l *auditLogOutputWriter) LogRecord(_ int, logRecord any) error {
+	if record, ok := logRecord.(map[string]any); ok && !l.filter.Match(record) {
+		return nil
+	}
 	return l.writeRecord(logRecord)
 }
@@ -0,0 +1,141 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decryption
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrAuditLogTampered = errors.New("audit log integrity verification failed")
+
+// VerificationIssueType is the kind of integrity issue found by Verify.
+type VerificationIssueType string
+
+const (
+	IssueInvalidHeader           VerificationIssueType = "invalidHeader"
+	IssueMissingKeyInvocations   VerificationIssueType = "missingKeyInvocations"
+	IssueOutOfOrderKeyInvocation VerificationIssueType = "outOfOrderKeyInvocation"
+	IssueCorruptedLine           VerificationIssueType = "corruptedLine"
+	IssueUnknownRecordType       VerificationIssueType = "unknownRecordType"
+	IssueRecordWithoutHeader     VerificationIssueType = "recordWithoutHeader"
+)
+
+type VerificationIssue struct {
+	Line   int                   `json:"line"`
+	Type   VerificationIssueType `json:"type"`
+	Detail string                `json:"detail"`
+}
+
+// VerificationSection summarizes the log records of a header section.
+type VerificationSection struct {
+	Line    int    `json:"line"`
+	Error   string `json:"error,omitempty"`
+	Records int    `json:"records"`
+	Issues  int    `json:"issues"`
+}
+
+// VerificationReport is the result of the integrity verification of an audit log.
+type VerificationReport struct {
+	Sections []*VerificationSection `json:"sections"`
+	Records  int                    `json:"records"`
+	Issues   []VerificationIssue    `json:"issues"`
+}
+
+// Tampered reports whether the audit log has integrity issues.
+func (r *VerificationReport) Tampered() bool {
+	return len(r.Issues) > 0
+}
+
+func (r *VerificationReport) currentSection() *VerificationSection {
+	if len(r.Sections) == 0 {
+		return nil
+	}
+	return r.Sections[len(r.Sections)-1]
+}
+
+func (r *VerificationReport) addIssue(lineNb int, issueType VerificationIssueType, format string, a ...any) {
+	if r == nil {
+		return
+	}
+	r.Issues = append(r.Issues, VerificationIssue{
+		Line:   lineNb,
+		Type:   issueType,
+		Detail: fmt.Sprintf(format, a...),
+	})
+	if s := r.currentSection(); s != nil {
+		s.Issues++
+	}
+}
+
+func (r *VerificationReport) addSection(lineNb int, err error) {
+	if r == nil {
+		return
+	}
+	s := &VerificationSection{Line: lineNb}
+	r.Sections = append(r.Sections, s)
+	if err != nil {
+		s.Error = err.Error()
+		r.addIssue(lineNb, IssueInvalidHeader, "%s", err)
+	}
+}
+
+func (r *VerificationReport) addRecord() {
+	if r == nil {
+		return
+	}
+	r.Records++
+	if s := r.currentSection(); s != nil {
+		s.Records++
+	}
+}
+
+// addKeyInvocationCount reports the gaps and the reordering of the key invocation counts of a section.
+func (r *VerificationReport) addKeyInvocationCount(lineNb int, expected, got uint64) {
+	switch {
+	case got == expected+1:
+		r.addIssue(lineNb, IssueMissingKeyInvocations, "key invocation count %d missing", expected)
+	case got > expected:
+		r.addIssue(lineNb, IssueMissingKeyInvocations, "key invocation counts %d to %d missing", expected, got-1)
+	case got < expected:
+		r.addIssue(lineNb, IssueOutOfOrderKeyInvocation, "key invocation count %d found where %d was expected", got, expected)
+	}
+}
+
+// Verify decrypts an audit log like Decrypt, without writing the records,
+// and reports the sections and the integrity issues of the audit log.
+func (d *Decryption) Verify(logReader io.Reader) (*VerificationReport, error) {
+	report := &VerificationReport{
+		Sections: []*VerificationSection{},
+		Issues:   []VerificationIssue{},
+	}
+	w := &auditLogWriter{
+		output: nopAuditLogOutput{},
+		report: report,
+	}
+	if err := d.run(logReader, w); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// nopAuditLogOutput discards the output of Verify, the report holds the findings.
+type nopAuditLogOutput struct{}
+
+func (nopAuditLogOutput) Warningf(int, *AuditLogLine, string, ...any) error { return nil }
+func (nopAuditLogOutput) Error(int, *AuditLogLine, error) error             { return nil }
+func (nopAuditLogOutput) Errorf(int, *AuditLogLine, string, ...any) error   { return nil }
+func (nopAuditLogOutput) LogRecord(int, any) error                          { return nil }
@@ -0,0 +1,78 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decryption
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDecryption_Verify(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "localKey")
+	require.NoError(t, os.WriteFile(keyFile, []byte(localKey), 0600))
+	header, _, _ := strings.Cut(localKeyAuditLog, "\n")
+
+	record := func(keyInvocationCount uint64) string {
+		return encryptLogRecord(t, 1644232049922+int64(keyInvocationCount), keyInvocationCount, bson.D{{Key: "atype", Value: "test"}})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		auditLog := strings.Join([]string{header, record(1), record(2), header, record(1)}, "\n")
+
+		report, err := NewDecryption(WithLocalOpts(keyFile)).Verify(strings.NewReader(auditLog))
+		require.NoError(t, err)
+		assert.False(t, report.Tampered())
+		assert.Equal(t, 3, report.Records)
+		assert.Equal(t, []*VerificationSection{{Line: 1, Records: 2}, {Line: 4, Records: 1}}, report.Sections)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		auditLog := strings.Join([]string{
+			record(1),
+			header,
+			record(1),
+			record(2),
+			record(4),
+			record(3),
+			`{"ts":{"$date":{"$numberLong":"1644232049922"}},"auditRecordType":"other"}`,
+			`{"ts":`,
+		}, "\n")
+
+		report, err := NewDecryption(WithLocalOpts(keyFile)).Verify(strings.NewReader(auditLog))
+		require.NoError(t, err)
+		assert.True(t, report.Tampered())
+		assert.Equal(t, 4, report.Records)
+		assert.Equal(t, []*VerificationSection{{Line: 2, Records: 4, Issues: 4}}, report.Sections)
+
+		types := make([]VerificationIssueType, 0, len(report.Issues))
+		for _, issue := range report.Issues {
+			types = append(types, issue.Type)
+		}
+		assert.Equal(t, []VerificationIssueType{
+			IssueRecordWithoutHeader,
+			IssueMissingKeyInvocations,
+			IssueOutOfOrderKeyInvocation,
+			IssueUnknownRecordType,
+			IssueCorruptedLine,
+		}, types)
+		assert.Equal(t, "key invocation count 3 missing", report.Issues[1].Detail)
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dirconfig discovers the directory context file that sets the profile, project and defaults
+// of the commands run in a directory tree.
+package dirconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml"
+	"github.com/spf13/afero"
+)
+
+const (
+	FileName    = ".atlascli.toml"
+	DirName     = ".atlas"
+	DirFileName = "config"
+)
+
+// Config is the content of a directory context file.
+type Config struct {
+	Profile     string `toml:"profile"`
+	ProjectID   string `toml:"project_id"`
+	OrgID       string `toml:"org_id"`
+	ClusterName string `toml:"cluster_name"`
+	Output      string `toml:"output"`
+	// Path of the file the values come from, empty when no file was found.
+	Path string `toml:"-"`
+}
+
+var current = &Config{}
+
+// candidates returns the context files of dir, in order of precedence.
+func candidates(dir string) []string {
+	return []string{
+		filepath.Join(dir, FileName),
+		filepath.Join(dir, DirName, DirFileName),
+	}
+}
+
+// Find walks up from dir and parses the first context file found, it returns an empty Config when there is none.
+func Find(fsys afero.Fs, dir string) (*Config, error) {
+	for {
+		for _, path := range candidates(dir) {
+			info, err := fsys.Stat(path)
+			if errors.Is(err, fs.ErrNotExist) || (err == nil && info.IsDir()) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			return parse(fsys, path)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return &Config{}, nil
+		}
+		dir = parent
+	}
+}
+
+func parse(fsys afero.Fs, path string) (*Config, error) {
+	b, err := afero.ReadFile(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Config{}
+	if err := toml.NewDecoder(bytes.NewReader(b)).Strict(true).Decode(c); err != nil {
+		return nil, fmt.Errorf("invalid context file %s: %w", path, err)
+	}
+	c.Path = path
+
+	return c, nil
+}
+
+// Load finds the context file of the working directory, Current returns it afterwards.
+func Load() error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	c, err := Find(afero.NewOsFs(), wd)
+	if err != nil {
+		return err
+	}
+	current = c
+
+	return nil
+}
+
+// Current returns the context file loaded by Load, its values are empty when there is none.
+func Current() *Config {
+	return current
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dirconfig
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	root := filepath.FromSlash("/repo")
+	work := filepath.Join(root, "services", "api")
+	require.NoError(t, fsys.MkdirAll(work, 0o755))
+	require.NoError(t, afero.WriteFile(fsys, filepath.Join(root, FileName), []byte(`profile = "dev"
+project_id = "5e2211c17a3e5a48f5497de3"
+cluster_name = "Cluster0"
+`), 0o600))
+
+	c, err := Find(fsys, work)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{
+		Profile:     "dev",
+		ProjectID:   "5e2211c17a3e5a48f5497de3",
+		ClusterName: "Cluster0",
+		Path:        filepath.Join(root, FileName),
+	}, c)
+}
+
+func TestFind_Precedence(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	root := filepath.FromSlash("/repo")
+	require.NoError(t, fsys.MkdirAll(filepath.Join(root, DirName), 0o755))
+	require.NoError(t, afero.WriteFile(fsys, filepath.Join(root, DirName, DirFileName), []byte(`output = "json"`), 0o600))
+	require.NoError(t, afero.WriteFile(fsys, filepath.Join(root, FileName), []byte(`output = "plaintext"`), 0o600))
+
+	c, err := Find(fsys, root)
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext", c.Output)
+
+	require.NoError(t, fsys.Remove(filepath.Join(root, FileName)))
+	c, err = Find(fsys, root)
+	require.NoError(t, err)
+	assert.Equal(t, "json", c.Output)
+	assert.Equal(t, filepath.Join(root, DirName, DirFileName), c.Path)
+}
+
+func TestFind_NotFound(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	work := filepath.FromSlash("/repo/services")
+	require.NoError(t, fsys.MkdirAll(filepath.Join(work, DirName), 0o755))
+
+	c, err := Find(fsys, work)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, c)
+}
+
+func TestFind_UnknownKey(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	root := filepath.FromSlash("/repo")
+	require.NoError(t, afero.WriteFile(fsys, filepath.Join(root, FileName), []byte(`projectId = "5e2211c17a3e5a48f5497de3"`), 0o600))
+
+	_, err := Find(fsys, root)
+	require.Error(t, err)
+}
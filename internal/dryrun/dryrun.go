@@ -0,0 +1,156 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dryrun provides an http.RoundTripper that prints mutating requests instead of sending them,
+// and Skip for the other operations that change something, like container operations.
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/jsonwriter"
+)
+
+// ErrRequestSkipped is returned for the first mutating request, the command stops there and exits successfully.
+var ErrRequestSkipped = errors.New("dry run: request not sent")
+
+var (
+	readMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+	}
+	redactedHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Proxy-Authorization",
+	}
+	output io.Writer
+)
+
+// Request is the printed form of a request that was not sent.
+type Request struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    any               `json:"body,omitempty"`
+}
+
+// Enable makes WrapClient print mutating requests to w instead of sending them.
+func Enable(w io.Writer) {
+	output = w
+}
+
+// Enabled returns true when the dry run mode is enabled.
+func Enabled() bool {
+	return output != nil
+}
+
+// Skip prints the operation that the dry run mode doesn't run and returns ErrRequestSkipped.
+func Skip(operation any) error {
+	if err := jsonwriter.Print(output, operation); err != nil {
+		return err
+	}
+	return ErrRequestSkipped
+}
+
+// Transport sends read requests so commands can look up the resources they change,
+// POST, PUT, PATCH and DELETE requests are printed and fail with ErrRequestSkipped.
+type Transport struct {
+	base http.RoundTripper
+	out  io.Writer
+}
+
+// NewTransport wraps base, http.DefaultTransport is used when base is nil.
+func NewTransport(base http.RoundTripper, w io.Writer) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		base: base,
+		out:  w,
+	}
+}
+
+// WrapClient replaces the transport of the client when the dry run mode is enabled.
+func WrapClient(client *http.Client) *http.Client {
+	if client != nil && Enabled() {
+		client.Transport = NewTransport(client.Transport, output)
+	}
+	return client
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if slices.Contains(readMethods, req.Method) {
+		return t.base.RoundTrip(req)
+	}
+
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+
+	r, err := NewRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonwriter.Print(t.out, r); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrRequestSkipped
+}
+
+// NewRequest reads the request body, JSON bodies are kept as JSON and credentials are left out.
+func NewRequest(req *http.Request) (*Request, error) {
+	r := &Request{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: map[string]string{},
+	}
+
+	for name, values := range req.Header {
+		if !slices.Contains(redactedHeaders, http.CanonicalHeaderKey(name)) {
+			r.Headers[http.CanonicalHeaderKey(name)] = strings.Join(values, ", ")
+		}
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return r, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return r, nil
+	}
+
+	if json.Valid(body) {
+		r.Body = json.RawMessage(body)
+	} else {
+		r.Body = string(body)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport_SkipsMutatingRequests(t *testing.T) {
+	sent := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		sent++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	buf := new(bytes.Buffer)
+	client := &http.Client{Transport: NewTransport(nil, buf)}
+
+	req, err := http.NewRequest(http.MethodPatch, server.URL+"/api/atlas/v2/groups/1/clusters/Cluster0", strings.NewReader(`{"paused":true}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/vnd.atlas.2025-03-12+json")
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := client.Do(req) //nolint:bodyclose // no response is returned
+	require.ErrorIs(t, err, ErrRequestSkipped)
+	assert.Nil(t, resp)
+	assert.Zero(t, sent)
+
+	want := `{
+  "method": "PATCH",
+  "url": "` + server.URL + `/api/atlas/v2/groups/1/clusters/Cluster0",
+  "headers": {
+    "Content-Type": "application/vnd.atlas.2025-03-12+json"
+  },
+  "body": {
+    "paused": true
+  }
+}
+`
+	assert.Equal(t, want, buf.String())
+}
+
+func TestTransport_SendsReadRequests(t *testing.T) {
+	sent := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		sent++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	buf := new(bytes.Buffer)
+	client := &http.Client{Transport: NewTransport(nil, buf)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, sent)
+	assert.Empty(t, buf.String())
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want any
+	}{
+		{
+			name: "no body",
+		},
+		{
+			name: "text body",
+			body: "not json",
+			want: "not json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, "https://cloud.mongodb.com/api/atlas/v2/groups/1", strings.NewReader(tt.body))
+			require.NoError(t, err)
+
+			r, err := NewRequest(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.MethodDelete, r.Method)
+			assert.Equal(t, tt.want, r.Body)
+		})
+	}
+}
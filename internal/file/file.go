@@ -123,15 +123,20 @@ func LoadFile(fs afero.Fs, filename string) ([]byte, error) {
 }
 
 // Save saves a given data interface into a given file path
-// The file should be a yaml format.
+// The file should be a yaml or json format.
 func Save(fs afero.Fs, filePath string, data any) error {
-	var content []byte
-
-	if _, err := configType(filePath, []string{yamlName}); err != nil {
+	t, err := configType(filePath, []string{yamlName, jsonName})
+	if err != nil {
 		return err
 	}
 
-	content, err := yaml.Marshal(data)
+	var content []byte
+	switch t {
+	case yamlName:
+		content, err = yaml.Marshal(data)
+	case jsonName:
+		content, err = json.MarshalIndent(data, "", "  ")
+	}
 	if err != nil {
 		return err
 	}
@@ -90,6 +90,15 @@ func TestSave(t *testing.T) {
 		yamlData, _ := yaml.Marshal(&tYaml)
 		require.NoError(t, Save(appFS, yamlFileName, yamlData))
 	})
+	t.Run("save valid json file", func(t *testing.T) {
+		appFS := afero.NewMemMapFs()
+		data := map[string]any{"name": "MongoDB"}
+		require.NoError(t, Save(appFS, "dir/test.json", data))
+
+		content, err := afero.ReadFile(appFS, "dir/test.json")
+		require.NoError(t, err)
+		require.JSONEq(t, `{"name": "MongoDB"}`, string(content))
+	})
 }
 
 func TestDecodeWithWarning(t *testing.T) {
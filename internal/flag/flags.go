@@ -320,4 +320,43 @@ const (
 	AuditFilter                                   = "auditFilter"                                   // AuditFilter flag
 	InitDB                                        = "initdb"                                        // InitDB flag
 	AutoScalingMode                               = "autoScalingMode"                               // AutoScalingMode flag
+	AllPages                                      = "allPages"                                      // AllPages flag
+	MaxItems                                      = "maxItems"                                      // MaxItems flag
+	NDJSON                                        = "ndjson"                                        // NDJSON flag
+	MaxRetries                                    = "maxRetries"                                    // MaxRetries flag
+	RetryMaxWait                                  = "retryMaxWait"                                  // RetryMaxWait flag
+	RetryAllMethods                               = "retryAllMethods"                               // RetryAllMethods flag
+	Columns                                       = "columns"                                       // Columns flag
+	DryRun                                        = "dryRun"                                        // DryRun flag
+	DryRunAlias                                   = "dry-run"                                       // DryRunAlias flag
+	FromSnapshot                                  = "fromSnapshot"                                  // FromSnapshot flag
+	FromSnapshotAlias                             = "from-snapshot"                                 // FromSnapshotAlias flag
+	LocalKeyFile                                  = "localKeyFile"                                  // LocalKeyFile flag
+	KMIPServerCAFile                              = "kmipServerCAFile"                              // KMIPServerCAFile flag
+	KMIPClientCertificateFile                     = "kmipClientCertificateFile"                     // KMIPClientCertificateFile flag
+	KMIPClientCertificatePassword                 = "kmipClientCertificatePassword"                 // KMIPClientCertificatePassword flag
+	KMIPUsername                                  = "kmipUsername"                                  // KMIPUsername flag
+	KMIPPassword                                  = "kmipPassword"                                  // KMIPPassword flag
+	DecryptWorkers                                = "workers"                                       // DecryptWorkers flag
+	AuditType                                     = "atype"                                         // AuditType flag
+	AuditUser                                     = "user"                                          // AuditUser flag
+	AuditNamespace                                = "ns"                                            // AuditNamespace flag
+	DecryptFormat                                 = "format"                                        // DecryptFormat flag
+	VerifyOnly                                    = "verifyOnly"                                    // VerifyOnly flag
+	VerifyOnlyAlias                               = "verify-only"                                   // VerifyOnlyAlias flag
+	AllHosts                                      = "allHosts"                                      // AllHosts flag
+	AllHostsAlias                                 = "all-hosts"                                     // AllHostsAlias flag
+	LogTypes                                      = "types"                                         // LogTypes flag
+	Parallelism                                   = "parallelism"                                   // Parallelism flag
+	Decrypt                                       = "decrypt"                                       // Decrypt flag
+	Follow                                        = "follow"                                        // Follow flag
+	LogGrep                                       = "grep"                                          // LogGrep flag
+	LogComponent                                  = "component"                                     // LogComponent flag
+	LogSeverity                                   = "severity"                                      // LogSeverity flag
+	ForPlugin                                     = "forPlugin"                                     // ForPlugin flag
+	ForPluginAlias                                = "for-plugin"                                    // ForPluginAlias flag
+	SHA256                                        = "sha256"                                        // SHA256 flag
+	AuditLogCommand                               = "command"                                       // AuditLogCommand flag
+	Effective                                     = "effective"                                     // Effective flag
+	Extends                                       = "extends"                                       // Extends flag
 )
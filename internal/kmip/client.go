@@ -0,0 +1,209 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kmip implements the subset of the Key Management Interoperability Protocol used to unwrap
+// the keys of encrypted audit logs: the Get and Decrypt operations of KMIP 1.2, over TLS.
+package kmip
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	OperationGet     = 0x0A
+	OperationDecrypt = 0x20
+
+	ResultStatusSuccess = 0x00
+
+	CredentialTypeUsernameAndPassword = 0x01
+	BlockCipherModeCBC                = 0x01
+	PaddingMethodPKCS5                = 0x03
+	CryptographicAlgorithmAES         = 0x03
+	ObjectTypeSymmetricKey            = 0x02
+	KeyFormatTypeRaw                  = 0x01
+
+	protocolVersionMajor = 1
+	protocolVersionMinor = 2
+	defaultTimeout       = 30 * time.Second
+)
+
+var (
+	ErrNoServer        = errors.New("no KMIP server")
+	ErrOperationFailed = errors.New("KMIP operation failed")
+)
+
+type Config struct {
+	TLS      *tls.Config
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+// Client sends requests to the first server of a list that accepts the connection.
+type Client struct {
+	addrs []string
+	cfg   Config
+}
+
+func NewClient(addrs []string, cfg Config) *Client {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	return &Client{addrs: addrs, cfg: cfg}
+}
+
+// GetSymmetricKey returns the material of the symmetric key uid.
+func (c *Client) GetSymmetricKey(ctx context.Context, uid string) ([]byte, error) {
+	payload, err := c.send(ctx, OperationGet, TextString(TagUniqueIdentifier, uid))
+	if err != nil {
+		return nil, err
+	}
+
+	material, err := payload.Path(TagSymmetricKey, TagKeyBlock, TagKeyValue, TagKeyMaterial)
+	if err != nil {
+		return nil, err
+	}
+
+	// transparent symmetric keys wrap the key material in a structure
+	if material.Type == TypeStructure {
+		if material, err = material.Child(TagKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return material.Bytes, nil
+}
+
+// Decrypt decrypts data with the key uid, using AES-CBC and PKCS#5 padding.
+func (c *Client) Decrypt(ctx context.Context, uid string, data, iv []byte) ([]byte, error) {
+	payload, err := c.send(ctx, OperationDecrypt,
+		TextString(TagUniqueIdentifier, uid),
+		Structure(TagCryptographicParameters,
+			Enumeration(TagBlockCipherMode, BlockCipherModeCBC),
+			Enumeration(TagPaddingMethod, PaddingMethodPKCS5),
+			Enumeration(TagCryptographicAlgorithm, CryptographicAlgorithmAES),
+		),
+		ByteString(TagData, data),
+		ByteString(TagIVCounterNonce, iv),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := payload.Child(TagData)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypted.Bytes, nil
+}
+
+func (c *Client) request(operation uint32, payload []Item) Item {
+	header := []Item{
+		Structure(TagProtocolVersion,
+			Integer(TagProtocolVersionMajor, protocolVersionMajor),
+			Integer(TagProtocolVersionMinor, protocolVersionMinor),
+		),
+	}
+	if c.cfg.Username != "" {
+		header = append(header, Structure(TagAuthentication,
+			Structure(TagCredential,
+				Enumeration(TagCredentialType, CredentialTypeUsernameAndPassword),
+				Structure(TagCredentialValue,
+					TextString(TagUsername, c.cfg.Username),
+					TextString(TagPassword, c.cfg.Password),
+				),
+			),
+		))
+	}
+	header = append(header, Integer(TagBatchCount, 1))
+
+	return Structure(TagRequestMessage,
+		Structure(TagRequestHeader, header...),
+		Structure(TagBatchItem,
+			Enumeration(TagOperation, operation),
+			Structure(TagRequestPayload, payload...),
+		),
+	)
+}
+
+// send returns the payload of the response, servers are tried in order until one of them can be reached.
+func (c *Client) send(ctx context.Context, operation uint32, payload ...Item) (Item, error) {
+	if len(c.addrs) == 0 {
+		return Item{}, ErrNoServer
+	}
+
+	msg := c.request(operation, payload).Marshal()
+
+	var errs []error
+	for _, addr := range c.addrs {
+		resp, err := c.roundTrip(ctx, addr, msg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", addr, err))
+			continue
+		}
+
+		return parseResponse(resp)
+	}
+
+	return Item{}, errors.Join(errs...)
+}
+
+func (c *Client) roundTrip(ctx context.Context, addr string, msg []byte) (Item, error) {
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: c.cfg.Timeout},
+		Config:    c.cfg.TLS,
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return Item{}, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(c.cfg.Timeout)); err != nil {
+		return Item{}, err
+	}
+
+	if _, err := conn.Write(msg); err != nil {
+		return Item{}, err
+	}
+
+	return ReadMessage(conn)
+}
+
+func parseResponse(resp Item) (Item, error) {
+	batchItem, err := resp.Child(TagBatchItem)
+	if err != nil {
+		return Item{}, err
+	}
+
+	status, err := batchItem.Child(TagResultStatus)
+	if err != nil {
+		return Item{}, err
+	}
+
+	if status.Int != ResultStatusSuccess {
+		message, _ := batchItem.Child(TagResultMessage)
+		reason, _ := batchItem.Child(TagResultReason)
+		return Item{}, fmt.Errorf("%w: status=%d reason=%d %s", ErrOperationFailed, status.Int, reason.Int, message.Bytes)
+	}
+
+	return batchItem.Child(TagResponsePayload)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kmip_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/kmip"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/kmip/kmiptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestServer(t *testing.T) *kmiptest.Server {
+	t.Helper()
+
+	server, err := kmiptest.NewServer(map[string][]byte{"key1": testKey})
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func tlsConfig(t *testing.T, server *kmiptest.Server) *tls.Config {
+	t.Helper()
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(server.CA))
+	cert, err := tls.X509KeyPair(server.ClientCertificate, server.ClientCertificate)
+	require.NoError(t, err)
+
+	return &tls.Config{RootCAs: pool, Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+}
+
+func TestClient_GetSymmetricKey(t *testing.T) {
+	server := newTestServer(t)
+	client := kmip.NewClient([]string{server.Addr()}, kmip.Config{TLS: tlsConfig(t, server)})
+
+	key, err := client.GetSymmetricKey(t.Context(), "key1")
+	require.NoError(t, err)
+	assert.Equal(t, testKey, key)
+
+	_, err = client.GetSymmetricKey(t.Context(), "unknown")
+	require.ErrorIs(t, err, kmip.ErrOperationFailed)
+}
+
+func TestClient_Decrypt(t *testing.T) {
+	server := newTestServer(t)
+	client := kmip.NewClient([]string{server.Addr()}, kmip.Config{TLS: tlsConfig(t, server)})
+
+	block, err := aes.NewCipher(testKey)
+	require.NoError(t, err)
+	iv := make([]byte, aes.BlockSize)
+	plain := append([]byte("0123456789"), 6, 6, 6, 6, 6, 6)
+	encrypted := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plain)
+
+	decrypted, err := client.Decrypt(t.Context(), "key1", encrypted, iv)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("0123456789"), decrypted)
+}
+
+func TestClient_Authentication(t *testing.T) {
+	server := newTestServer(t)
+	server.Username = "user"
+	server.Password = "pass"
+
+	client := kmip.NewClient([]string{server.Addr()}, kmip.Config{TLS: tlsConfig(t, server), Username: "user", Password: "wrong"})
+	_, err := client.GetSymmetricKey(t.Context(), "key1")
+	require.ErrorIs(t, err, kmip.ErrOperationFailed)
+
+	client = kmip.NewClient([]string{server.Addr()}, kmip.Config{TLS: tlsConfig(t, server), Username: "user", Password: "pass"})
+	_, err = client.GetSymmetricKey(t.Context(), "key1")
+	require.NoError(t, err)
+}
+
+func TestClient_FallbackServer(t *testing.T) {
+	server := newTestServer(t)
+	unreachable := newTestServer(t)
+	unreachable.Close()
+
+	client := kmip.NewClient([]string{unreachable.Addr(), server.Addr()}, kmip.Config{TLS: tlsConfig(t, server)})
+	key, err := client.GetSymmetricKey(t.Context(), "key1")
+	require.NoError(t, err)
+	assert.Equal(t, testKey, key)
+
+	client = kmip.NewClient([]string{unreachable.Addr()}, kmip.Config{TLS: tlsConfig(t, server)})
+	_, err = client.GetSymmetricKey(t.Context(), "key1")
+	require.Error(t, err)
+}
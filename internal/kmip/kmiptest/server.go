@@ -0,0 +1,298 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kmiptest provides an in-process KMIP server, a stand-in for the key management servers of
+// encrypted audit logs in tests. It serves the Get and Decrypt operations of the kmip package.
+package kmiptest
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/kmip"
+)
+
+const (
+	resultStatusOperationFailed      = 0x01
+	resultReasonItemNotFound         = 0x01
+	resultReasonOperationUnsupported = 0x05
+	resultReasonPermissionDenied     = 0x0C
+	certificateValidity              = time.Hour
+)
+
+var errBadPadding = errors.New("bad padding")
+
+// Server accepts TLS connections with a client certificate signed by its CA.
+type Server struct {
+	// Host and Port of the listener
+	Host string
+	Port int
+	// CA is the PEM encoded certificate of the authority that signed the server and client certificates.
+	CA []byte
+	// ClientCertificate is the PEM encoded client certificate, followed by its private key.
+	ClientCertificate []byte
+	// Keys are the symmetric keys, by unique identifier.
+	Keys map[string][]byte
+	// Username and Password are required from clients when Username isn't empty.
+	Username string
+	Password string
+
+	listener net.Listener
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server on a random port of the loopback interface, call Close to stop it.
+func NewServer(keys map[string][]byte) (*Server, error) {
+	caKey, caCert, err := newCertificate("kmiptest CA", nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	serverKey, serverCert, err := newCertificate("127.0.0.1", caKey, caCert, false)
+	if err != nil {
+		return nil, err
+	}
+	clientKey, clientCert, err := newCertificate("kmiptest client", caKey, caCert, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.Raw}, PrivateKey: serverKey}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clientPEM, err := encodeCertificate(clientCert, clientKey)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	addr, _ := listener.Addr().(*net.TCPAddr)
+	s := &Server{
+		Host:              addr.IP.String(),
+		Port:              addr.Port,
+		CA:                pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}),
+		ClientCertificate: clientPEM,
+		Keys:              keys,
+		listener:          listener,
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns the host:port address of the server.
+func (s *Server) Addr() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+// Close stops the server and waits for the pending requests.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+
+			req, err := kmip.ReadMessage(conn)
+			if err != nil {
+				return
+			}
+			_, _ = conn.Write(s.handle(req).Marshal())
+		}()
+	}
+}
+
+func (s *Server) handle(req kmip.Item) kmip.Item {
+	operation, err := req.Path(kmip.TagBatchItem, kmip.TagOperation)
+	if err != nil {
+		return failure(0, resultReasonOperationUnsupported, err.Error())
+	}
+
+	if !s.authenticated(req) {
+		return failure(operation.Int, resultReasonPermissionDenied, "authentication failed")
+	}
+
+	payload, _ := req.Path(kmip.TagBatchItem, kmip.TagRequestPayload)
+	uid, _ := payload.Child(kmip.TagUniqueIdentifier)
+	key, ok := s.Keys[string(uid.Bytes)]
+	if !ok {
+		return failure(operation.Int, resultReasonItemNotFound, "key not found")
+	}
+
+	switch operation.Int {
+	case kmip.OperationGet:
+		return success(operation.Int,
+			kmip.TextString(kmip.TagUniqueIdentifier, string(uid.Bytes)),
+			kmip.Structure(kmip.TagSymmetricKey,
+				kmip.Structure(kmip.TagKeyBlock,
+					kmip.Enumeration(kmip.TagKeyFormatType, kmip.KeyFormatTypeRaw),
+					kmip.Structure(kmip.TagKeyValue, kmip.ByteString(kmip.TagKeyMaterial, key)),
+				),
+			),
+		)
+	case kmip.OperationDecrypt:
+		data, _ := payload.Child(kmip.TagData)
+		iv, _ := payload.Child(kmip.TagIVCounterNonce)
+		decrypted, err := decryptCBC(key, iv.Bytes, data.Bytes)
+		if err != nil {
+			return failure(operation.Int, 0, err.Error())
+		}
+		return success(operation.Int,
+			kmip.TextString(kmip.TagUniqueIdentifier, string(uid.Bytes)),
+			kmip.ByteString(kmip.TagData, decrypted),
+		)
+	default:
+		return failure(operation.Int, resultReasonOperationUnsupported, "operation not supported")
+	}
+}
+
+func (s *Server) authenticated(req kmip.Item) bool {
+	if s.Username == "" {
+		return true
+	}
+
+	value, err := req.Path(kmip.TagRequestHeader, kmip.TagAuthentication, kmip.TagCredential, kmip.TagCredentialValue)
+	if err != nil {
+		return false
+	}
+	username, _ := value.Child(kmip.TagUsername)
+	password, _ := value.Child(kmip.TagPassword)
+
+	return string(username.Bytes) == s.Username && string(password.Bytes) == s.Password
+}
+
+func response(operation uint32, status kmip.Item, items ...kmip.Item) kmip.Item {
+	batchItem := append([]kmip.Item{kmip.Enumeration(kmip.TagOperation, operation), status}, items...)
+	return kmip.Structure(kmip.TagResponseMessage,
+		kmip.Structure(kmip.TagResponseHeader, kmip.Integer(kmip.TagBatchCount, 1)),
+		kmip.Structure(kmip.TagBatchItem, batchItem...),
+	)
+}
+
+func success(operation uint32, payload ...kmip.Item) kmip.Item {
+	return response(operation,
+		kmip.Enumeration(kmip.TagResultStatus, kmip.ResultStatusSuccess),
+		kmip.Structure(kmip.TagResponsePayload, payload...),
+	)
+}
+
+func failure(operation, reason uint32, message string) kmip.Item {
+	return response(operation,
+		kmip.Enumeration(kmip.TagResultStatus, resultStatusOperationFailed),
+		kmip.Enumeration(kmip.TagResultReason, reason),
+		kmip.TextString(kmip.TagResultMessage, message),
+	)
+}
+
+func decryptCBC(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize || len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errBadPadding
+	}
+
+	decrypted := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, data)
+
+	n := int(decrypted[len(decrypted)-1])
+	if n == 0 || n > aes.BlockSize || !bytes.Equal(decrypted[len(decrypted)-n:], bytes.Repeat([]byte{byte(n)}, n)) {
+		return nil, errBadPadding
+	}
+
+	return decrypted[:len(decrypted)-n], nil
+}
+
+func newCertificate(commonName string, parentKey *ecdsa.PrivateKey, parent *x509.Certificate, isCA bool) (*ecdsa.PrivateKey, *x509.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(certificateValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	return key, cert, err
+}
+
+func encodeCertificate(cert *x509.Certificate, key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	return append(b, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})...), nil
+}
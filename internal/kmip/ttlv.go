@@ -0,0 +1,240 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kmip
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Tag identifies a KMIP item, see the KMIP 1.2 specification, section 9.1.3.1.
+type Tag uint32
+
+const (
+	TagAuthentication          Tag = 0x42000C
+	TagBatchCount              Tag = 0x42000D
+	TagBatchItem               Tag = 0x42000F
+	TagBlockCipherMode         Tag = 0x420011
+	TagCredential              Tag = 0x420023
+	TagCredentialType          Tag = 0x420024
+	TagCredentialValue         Tag = 0x420025
+	TagCryptographicAlgorithm  Tag = 0x420028
+	TagCryptographicParameters Tag = 0x42002B
+	TagIVCounterNonce          Tag = 0x42003D
+	TagKey                     Tag = 0x42003F
+	TagKeyBlock                Tag = 0x420040
+	TagKeyFormatType           Tag = 0x420042
+	TagKeyMaterial             Tag = 0x420043
+	TagKeyValue                Tag = 0x420045
+	TagObjectType              Tag = 0x420057
+	TagOperation               Tag = 0x42005C
+	TagPaddingMethod           Tag = 0x42005F
+	TagProtocolVersion         Tag = 0x420069
+	TagProtocolVersionMajor    Tag = 0x42006A
+	TagProtocolVersionMinor    Tag = 0x42006B
+	TagRequestHeader           Tag = 0x420077
+	TagRequestMessage          Tag = 0x420078
+	TagRequestPayload          Tag = 0x420079
+	TagResponseHeader          Tag = 0x42007A
+	TagResponseMessage         Tag = 0x42007B
+	TagResponsePayload         Tag = 0x42007C
+	TagResultMessage           Tag = 0x42007D
+	TagResultReason            Tag = 0x42007E
+	TagResultStatus            Tag = 0x42007F
+	TagSymmetricKey            Tag = 0x42008F
+	TagUniqueIdentifier        Tag = 0x420094
+	TagUsername                Tag = 0x420099
+	TagPassword                Tag = 0x4200A1
+	TagData                    Tag = 0x4200C2
+)
+
+// Type is the encoding of the value of an item.
+type Type byte
+
+const (
+	TypeStructure   Type = 0x01
+	TypeInteger     Type = 0x02
+	TypeEnumeration Type = 0x05
+	TypeTextString  Type = 0x07
+	TypeByteString  Type = 0x08
+)
+
+const (
+	headerLength = 8
+	// maxMessageLength bounds the memory allocated for a response, keys and audit log keys are small.
+	maxMessageLength = 1 << 20
+)
+
+var (
+	ErrInvalidItem     = errors.New("invalid TTLV item")
+	ErrMessageTooLarge = errors.New("KMIP message too large")
+	ErrItemNotFound    = errors.New("KMIP item not found")
+)
+
+// Item is a tag-type-length-value item, the values of structures are their children.
+type Item struct {
+	Tag      Tag
+	Type     Type
+	Children []Item
+	Int      uint32
+	Bytes    []byte
+}
+
+func Structure(tag Tag, children ...Item) Item {
+	return Item{Tag: tag, Type: TypeStructure, Children: children}
+}
+
+func Integer(tag Tag, v uint32) Item {
+	return Item{Tag: tag, Type: TypeInteger, Int: v}
+}
+
+func Enumeration(tag Tag, v uint32) Item {
+	return Item{Tag: tag, Type: TypeEnumeration, Int: v}
+}
+
+func TextString(tag Tag, v string) Item {
+	return Item{Tag: tag, Type: TypeTextString, Bytes: []byte(v)}
+}
+
+func ByteString(tag Tag, v []byte) Item {
+	return Item{Tag: tag, Type: TypeByteString, Bytes: v}
+}
+
+// Child returns the first child with the given tag.
+func (i Item) Child(tag Tag) (Item, error) {
+	for _, c := range i.Children {
+		if c.Tag == tag {
+			return c, nil
+		}
+	}
+
+	return Item{}, fmt.Errorf("%w: %#x", ErrItemNotFound, uint32(tag))
+}
+
+// Path returns the descendant reached by following tags.
+func (i Item) Path(tags ...Tag) (Item, error) {
+	var err error
+	for _, tag := range tags {
+		if i, err = i.Child(tag); err != nil {
+			return Item{}, err
+		}
+	}
+
+	return i, nil
+}
+
+// Marshal encodes the item, values are padded to a multiple of 8 bytes.
+func (i Item) Marshal() []byte {
+	var value []byte
+	switch i.Type {
+	case TypeStructure:
+		for _, c := range i.Children {
+			value = append(value, c.Marshal()...)
+		}
+	case TypeInteger, TypeEnumeration:
+		value = binary.BigEndian.AppendUint32(nil, i.Int)
+	default:
+		value = i.Bytes
+	}
+
+	b := make([]byte, headerLength, headerLength+padded(len(value)))
+	b[0] = byte(i.Tag >> 16)
+	b[1] = byte(i.Tag >> 8)
+	b[2] = byte(i.Tag)
+	b[3] = byte(i.Type)
+	binary.BigEndian.PutUint32(b[4:], uint32(len(value))) //nolint:gosec // messages are bounded by maxMessageLength
+	b = append(b, value...)
+
+	return append(b, make([]byte, padded(len(value))-len(value))...)
+}
+
+func padded(n int) int {
+	return (n + 7) / 8 * 8
+}
+
+// Unmarshal decodes a single item, trailing bytes are rejected.
+func Unmarshal(b []byte) (Item, error) {
+	item, n, err := unmarshal(b)
+	if err != nil {
+		return Item{}, err
+	}
+	if n != len(b) {
+		return Item{}, fmt.Errorf("%w: %d trailing bytes", ErrInvalidItem, len(b)-n)
+	}
+
+	return item, nil
+}
+
+func unmarshal(b []byte) (Item, int, error) {
+	if len(b) < headerLength {
+		return Item{}, 0, fmt.Errorf("%w: truncated header", ErrInvalidItem)
+	}
+
+	item := Item{
+		Tag:  Tag(b[0])<<16 | Tag(b[1])<<8 | Tag(b[2]),
+		Type: Type(b[3]),
+	}
+	length := int(binary.BigEndian.Uint32(b[4:8]))
+	end := headerLength + padded(length)
+	if end > len(b) {
+		return Item{}, 0, fmt.Errorf("%w: truncated value of %#x", ErrInvalidItem, uint32(item.Tag))
+	}
+	value := b[headerLength : headerLength+length]
+
+	switch item.Type {
+	case TypeStructure:
+		for len(value) > 0 {
+			child, n, err := unmarshal(value)
+			if err != nil {
+				return Item{}, 0, err
+			}
+			item.Children = append(item.Children, child)
+			value = value[n:]
+		}
+	case TypeInteger, TypeEnumeration:
+		if length != 4 {
+			return Item{}, 0, fmt.Errorf("%w: integer of length %d", ErrInvalidItem, length)
+		}
+		item.Int = binary.BigEndian.Uint32(value)
+	default:
+		// other types are kept as raw bytes
+		item.Bytes = append([]byte{}, value...)
+	}
+
+	return item, end, nil
+}
+
+// ReadMessage reads a single item from r.
+func ReadMessage(r io.Reader) (Item, error) {
+	header := make([]byte, headerLength)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return Item{}, err
+	}
+
+	length := int(binary.BigEndian.Uint32(header[4:]))
+	if length > maxMessageLength {
+		return Item{}, fmt.Errorf("%w: %d bytes", ErrMessageTooLarge, length)
+	}
+
+	b := make([]byte, headerLength+padded(length))
+	copy(b, header)
+	if _, err := io.ReadFull(r, b[headerLength:]); err != nil {
+		return Item{}, err
+	}
+
+	return Unmarshal(b)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kmip
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem_Marshal(t *testing.T) {
+	// examples of the KMIP 1.2 specification, section 9.1.2
+	tests := []struct {
+		name     string
+		item     Item
+		expected string
+	}{
+		{
+			name:     "integer",
+			item:     Integer(0x420020, 8),
+			expected: "42002002000000040000000800000000",
+		},
+		{
+			name:     "enumeration",
+			item:     Enumeration(0x420020, 255),
+			expected: "4200200500000004000000ff00000000",
+		},
+		{
+			name:     "text string",
+			item:     TextString(0x420020, "Hello World"),
+			expected: "420020070000000b48656c6c6f20576f726c640000000000",
+		},
+		{
+			name:     "byte string",
+			item:     ByteString(0x420020, []byte{1, 2, 3}),
+			expected: "42002008000000030102030000000000",
+		},
+		{
+			name:     "structure",
+			item:     Structure(0x420020, Enumeration(0x420004, 254), Integer(0x420005, 255)),
+			expected: "42002001000000204200040500000004000000fe000000004200050200000004000000ff00000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := hex.DecodeString(tt.expected)
+			require.NoError(t, err)
+			assert.Equal(t, expected, tt.item.Marshal())
+
+			got, err := Unmarshal(expected)
+			require.NoError(t, err)
+			assert.Equal(t, expected, got.Marshal())
+		})
+	}
+}
+
+func TestUnmarshal_Invalid(t *testing.T) {
+	b := TextString(TagUsername, "user").Marshal()
+
+	_, err := Unmarshal(b[:len(b)-1])
+	require.ErrorIs(t, err, ErrInvalidItem)
+
+	_, err = Unmarshal(append(b, 0))
+	require.ErrorIs(t, err, ErrInvalidItem)
+}
+
+func TestReadMessage(t *testing.T) {
+	msg := Structure(TagResponseMessage, Structure(TagBatchItem, Enumeration(TagResultStatus, ResultStatusSuccess)))
+
+	got, err := ReadMessage(bytes.NewReader(msg.Marshal()))
+	require.NoError(t, err)
+
+	status, err := got.Path(TagBatchItem, TagResultStatus)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(ResultStatusSuccess), status.Int)
+
+	_, err = got.Path(TagBatchItem, TagResponsePayload)
+	require.ErrorIs(t, err, ErrItemNotFound)
+}
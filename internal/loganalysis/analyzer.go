@@ -0,0 +1,408 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loganalysis summarises and filters the structured JSON logs written by mongod and mongos,
+// as downloaded by atlas logs download.
+package loganalysis
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Log message ids of the structured log format, see https://www.mongodb.com/docs/manual/reference/log-messages/.
+const (
+	idConnectionAccepted = 22943
+	idConnectionEnded    = 22944
+	idClientMetadata     = 51800
+	idSlowQuery          = 51803
+	idStateTransition    = 21358
+)
+
+const (
+	componentElection = "ELECTION"
+	collScanPlan      = "COLLSCAN"
+	maxLineSize       = 16 * 1024 * 1024
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// logEntry is a line of the structured log format.
+type logEntry struct {
+	T struct {
+		Date string `json:"$date"`
+	} `json:"t"`
+	S    string         `json:"s"`
+	C    string         `json:"c"`
+	ID   int            `json:"id"`
+	Ctx  string         `json:"ctx"`
+	Msg  string         `json:"msg"`
+	Attr map[string]any `json:"attr"`
+}
+
+// SlowQuery aggregates the slow queries of a namespace that share a query shape.
+type SlowQuery struct {
+	Namespace     string `json:"namespace"`
+	Shape         string `json:"shape"`
+	Count         int    `json:"count"`
+	CollScans     int    `json:"collScans"`
+	TotalMillis   int64  `json:"totalMillis"`
+	MaxMillis     int64  `json:"maxMillis"`
+	AverageMillis int64  `json:"averageMillis"`
+}
+
+// CollScan counts the slow queries of a namespace that scanned the whole collection.
+type CollScan struct {
+	Namespace   string `json:"namespace"`
+	Count       int    `json:"count"`
+	TotalMillis int64  `json:"totalMillis"`
+}
+
+// ConnectionChurn counts the connections opened and closed by a client,
+// identified by its application name or, when the driver didn't send one, its address.
+type ConnectionChurn struct {
+	Client string `json:"client"`
+	Opened int    `json:"opened"`
+	Closed int    `json:"closed"`
+}
+
+// ErrorCode counts the log entries that reported an error code.
+type ErrorCode struct {
+	Code  int    `json:"code"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// ElectionEvent is an election or a replica set state transition.
+type ElectionEvent struct {
+	Time    string `json:"time"`
+	Source  string `json:"source"`
+	Message string `json:"message"`
+}
+
+// Summary is the result of the analysis of one or more log files.
+type Summary struct {
+	Files       int               `json:"files"`
+	Lines       int               `json:"lines"`
+	Entries     int               `json:"entries"`
+	Skipped     int               `json:"skipped"`
+	SlowQueries []SlowQuery       `json:"slowQueries"`
+	CollScans   []CollScan        `json:"collScans"`
+	Connections []ConnectionChurn `json:"connections"`
+	Errors      []ErrorCode       `json:"errors"`
+	Elections   []ElectionEvent   `json:"elections"`
+}
+
+type slowQueryKey struct {
+	namespace string
+	shape     string
+}
+
+type errorCodeKey struct {
+	code int
+	name string
+}
+
+// connection is a client connection, identified by its source and remote address.
+type connection struct {
+	appName string
+	opened  bool
+	closed  bool
+}
+
+// Analyzer accumulates the statistics of the log files it reads.
+type Analyzer struct {
+	files       int
+	lines       int
+	entries     int
+	skipped     int
+	slowQueries map[slowQueryKey]*SlowQuery
+	collScans   map[string]*CollScan
+	connections map[string]*connection
+	ended       []*connection
+	errors      map[errorCodeKey]int
+	elections   []ElectionEvent
+}
+
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{
+		slowQueries: map[slowQueryKey]*SlowQuery{},
+		collScans:   map[string]*CollScan{},
+		connections: map[string]*connection{},
+		errors:      map[errorCodeKey]int{},
+	}
+}
+
+// Analyze reads the log of source, decompressing it when it's gzipped.
+// Lines that aren't in the structured log format are counted as skipped.
+func (a *Analyzer) Analyze(source string, r io.Reader) error {
+	reader := bufio.NewReader(r)
+	magic, err := reader.Peek(len(gzipMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	if bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+		defer gz.Close()
+		reader = bufio.NewReader(gz)
+	}
+
+	a.files++
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		a.lines++
+
+		var entry logEntry
+		if err := json.Unmarshal(line, &entry); err != nil || entry.T.Date == "" {
+			a.skipped++
+			continue
+		}
+		a.entries++
+		a.analyzeEntry(source, &entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	return nil
+}
+
+func (a *Analyzer) analyzeEntry(source string, entry *logEntry) {
+	switch entry.ID {
+	case idSlowQuery:
+		a.analyzeSlowQuery(entry)
+	case idConnectionAccepted:
+		a.connection(source, entry).opened = true
+	case idClientMetadata:
+		if name := attrString(entry.Attr, "doc", "application", "name"); name != "" {
+			a.connection(source, entry).appName = name
+		}
+	case idConnectionEnded:
+		a.connection(source, entry).closed = true
+	case idStateTransition:
+		a.elections = append(a.elections, ElectionEvent{
+			Time:    entry.T.Date,
+			Source:  source,
+			Message: fmt.Sprintf("%s: %s -> %s", entry.Msg, attrString(entry.Attr, "oldState"), attrString(entry.Attr, "newState")),
+		})
+	default:
+		if entry.C == componentElection {
+			a.elections = append(a.elections, ElectionEvent{Time: entry.T.Date, Source: source, Message: entry.Msg})
+		}
+	}
+
+	if code, name, ok := errorCode(entry.Attr); ok {
+		a.errors[errorCodeKey{code: code, name: name}]++
+	}
+}
+
+func (a *Analyzer) analyzeSlowQuery(entry *logEntry) {
+	ns := attrString(entry.Attr, "ns")
+	key := slowQueryKey{namespace: ns, shape: QueryShape(entry.Attr)}
+	millis := attrInt64(entry.Attr, "durationMillis")
+
+	q, ok := a.slowQueries[key]
+	if !ok {
+		q = &SlowQuery{Namespace: key.namespace, Shape: key.shape}
+		a.slowQueries[key] = q
+	}
+	q.Count++
+	q.TotalMillis += millis
+	q.MaxMillis = max(q.MaxMillis, millis)
+
+	if !strings.HasPrefix(attrString(entry.Attr, "planSummary"), collScanPlan) {
+		return
+	}
+	q.CollScans++
+	c, ok := a.collScans[ns]
+	if !ok {
+		c = &CollScan{Namespace: ns}
+		a.collScans[ns] = c
+	}
+	c.Count++
+	c.TotalMillis += millis
+}
+
+// connection returns the connection of the remote address of the entry,
+// the address can be reused by a later connection once the previous one ended.
+func (a *Analyzer) connection(source string, entry *logEntry) *connection {
+	remote := attrString(entry.Attr, "remote")
+	key := source + "/" + remote
+	c, ok := a.connections[key]
+	if ok && c.closed && entry.ID == idConnectionAccepted {
+		a.ended = append(a.ended, c)
+		ok = false
+	}
+	if !ok {
+		c = &connection{appName: clientAddress(remote)}
+		a.connections[key] = c
+	}
+	return c
+}
+
+// Summary returns the statistics of the logs analyzed so far, slowest and most frequent first.
+func (a *Analyzer) Summary() *Summary {
+	s := &Summary{
+		Files:       a.files,
+		Lines:       a.lines,
+		Entries:     a.entries,
+		Skipped:     a.skipped,
+		SlowQueries: make([]SlowQuery, 0, len(a.slowQueries)),
+		CollScans:   make([]CollScan, 0, len(a.collScans)),
+		Connections: []ConnectionChurn{},
+		Errors:      make([]ErrorCode, 0, len(a.errors)),
+		Elections:   append([]ElectionEvent{}, a.elections...),
+	}
+
+	for _, q := range a.slowQueries {
+		q.AverageMillis = q.TotalMillis / int64(q.Count)
+		s.SlowQueries = append(s.SlowQueries, *q)
+	}
+	sort.Slice(s.SlowQueries, func(i, j int) bool {
+		qi, qj := s.SlowQueries[i], s.SlowQueries[j]
+		if qi.TotalMillis != qj.TotalMillis {
+			return qi.TotalMillis > qj.TotalMillis
+		}
+		if qi.Namespace != qj.Namespace {
+			return qi.Namespace < qj.Namespace
+		}
+		return qi.Shape < qj.Shape
+	})
+
+	for _, c := range a.collScans {
+		s.CollScans = append(s.CollScans, *c)
+	}
+	sort.Slice(s.CollScans, func(i, j int) bool {
+		if s.CollScans[i].Count != s.CollScans[j].Count {
+			return s.CollScans[i].Count > s.CollScans[j].Count
+		}
+		return s.CollScans[i].Namespace < s.CollScans[j].Namespace
+	})
+
+	churn := map[string]*ConnectionChurn{}
+	for _, c := range append(slices.Collect(maps.Values(a.connections)), a.ended...) {
+		cc, ok := churn[c.appName]
+		if !ok {
+			cc = &ConnectionChurn{Client: c.appName}
+			churn[c.appName] = cc
+		}
+		if c.opened {
+			cc.Opened++
+		}
+		if c.closed {
+			cc.Closed++
+		}
+	}
+	for _, cc := range churn {
+		s.Connections = append(s.Connections, *cc)
+	}
+	sort.Slice(s.Connections, func(i, j int) bool {
+		ci, cj := s.Connections[i], s.Connections[j]
+		if ci.Opened+ci.Closed != cj.Opened+cj.Closed {
+			return ci.Opened+ci.Closed > cj.Opened+cj.Closed
+		}
+		return ci.Client < cj.Client
+	})
+
+	for k, count := range a.errors {
+		s.Errors = append(s.Errors, ErrorCode{Code: k.code, Name: k.name, Count: count})
+	}
+	sort.Slice(s.Errors, func(i, j int) bool {
+		if s.Errors[i].Count != s.Errors[j].Count {
+			return s.Errors[i].Count > s.Errors[j].Count
+		}
+		return s.Errors[i].Code < s.Errors[j].Code
+	})
+
+	sort.SliceStable(s.Elections, func(i, j int) bool {
+		return s.Elections[i].Time < s.Elections[j].Time
+	})
+
+	return s
+}
+
+// errorCode returns the error code of an entry, either reported as an error
+// document or, for slow operations, as errCode and errName attributes.
+func errorCode(attr map[string]any) (int, string, bool) {
+	if e, ok := attr["error"].(map[string]any); ok {
+		if code, ok := e["code"].(float64); ok {
+			name, _ := e["codeName"].(string)
+			return int(code), name, true
+		}
+	}
+	if code, ok := attr["errCode"].(float64); ok {
+		name, _ := attr["errName"].(string)
+		return int(code), name, true
+	}
+	return 0, "", false
+}
+
+// clientAddress strips the ephemeral port from the remote address of a connection.
+func clientAddress(remote string) string {
+	host, _, err := net.SplitHostPort(remote)
+	if err != nil {
+		return remote
+	}
+	return host
+}
+
+func attrValue(attr map[string]any, path ...string) any {
+	var v any = attr
+	for _, p := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[p]
+	}
+	return v
+}
+
+func attrString(attr map[string]any, path ...string) string {
+	s, _ := attrValue(attr, path...).(string)
+	return s
+}
+
+func attrInt64(attr map[string]any, path ...string) int64 {
+	switch v := attrValue(attr, path...).(type) {
+	case float64:
+		return int64(v)
+	case map[string]any:
+		// large values are logged in extended JSON, like {"$numberLong": "1234"}
+		var n int64
+		if s, ok := v["$numberLong"].(string); ok {
+			_, _ = fmt.Sscan(s, &n)
+		}
+		return n
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loganalysis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLog = `{"t":{"$date":"2026-01-10T10:00:00.000+00:00"},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"Connection accepted","attr":{"remote":"10.0.0.1:50001","connectionId":1,"connectionCount":1}}
+{"t":{"$date":"2026-01-10T10:00:00.010+00:00"},"s":"I","c":"NETWORK","id":51800,"ctx":"conn1","msg":"client metadata","attr":{"remote":"10.0.0.1:50001","client":"conn1","doc":{"driver":{"name":"nodejs","version":"6.0.0"},"application":{"name":"orders-api"}}}}
+{"t":{"$date":"2026-01-10T10:00:01.000+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"sales.orders","command":{"find":"orders","filter":{"status":"A","qty":{"$gt":10}}},"planSummary":"COLLSCAN","durationMillis":300}}
+{"t":{"$date":"2026-01-10T10:00:02.000+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"sales.orders","command":{"find":"orders","filter":{"qty":{"$gt":5},"status":"B"}},"planSummary":"COLLSCAN","durationMillis":100}}
+{"t":{"$date":"2026-01-10T10:00:03.000+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"sales.customers","command":{"update":"customers","updates":[{"q":{"_id":{"$oid":"65a1b2c3d4e5f60718293a4b"}},"u":{"$set":{"x":1}}}]},"planSummary":"IDHACK","durationMillis":150,"errCode":11000,"errName":"DuplicateKey"}}
+{"t":{"$date":"2026-01-10T10:00:04.000+00:00"},"s":"I","c":"NETWORK","id":22944,"ctx":"conn1","msg":"Connection ended","attr":{"remote":"10.0.0.1:50001","connectionId":1,"connectionCount":0}}
+{"t":{"$date":"2026-01-10T10:00:05.000+00:00"},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"Connection accepted","attr":{"remote":"10.0.0.2:50002","connectionId":2,"connectionCount":1}}
+{"t":{"$date":"2026-01-10T10:00:06.000+00:00"},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"Connection accepted","attr":{"remote":"10.0.0.1:50001","connectionId":3,"connectionCount":2}}
+{"t":{"$date":"2026-01-10T10:00:07.000+00:00"},"s":"W","c":"NETWORK","id":4615610,"ctx":"conn2","msg":"Failed to check socket connectivity","attr":{"error":{"code":6,"codeName":"HostUnreachable","errmsg":"Connection reset by peer"}}}
+{"t":{"$date":"2026-01-10T10:00:09.000+00:00"},"s":"I","c":"REPL","id":21358,"ctx":"ReplCoord-0","msg":"Replica set state transition","attr":{"newState":"PRIMARY","oldState":"SECONDARY"}}
+{"t":{"$date":"2026-01-10T10:00:08.000+00:00"},"s":"I","c":"ELECTION","id":21450,"ctx":"ReplCoord-0","msg":"Election succeeded, assuming primary role","attr":{"term":2}}
+not a structured log line
+`
+
+func TestAnalyzer_Analyze(t *testing.T) {
+	a := NewAnalyzer()
+	require.NoError(t, a.Analyze("mongodb.log", strings.NewReader(testLog)))
+	s := a.Summary()
+
+	assert.Equal(t, 1, s.Files)
+	assert.Equal(t, 12, s.Lines)
+	assert.Equal(t, 11, s.Entries)
+	assert.Equal(t, 1, s.Skipped)
+
+	assert.Equal(t, []SlowQuery{
+		{Namespace: "sales.orders", Shape: `find {"qty":{"$gt":1},"status":1}`, Count: 2, CollScans: 2, TotalMillis: 400, MaxMillis: 300, AverageMillis: 200},
+		{Namespace: "sales.customers", Shape: `update {"_id":1}`, Count: 1, TotalMillis: 150, MaxMillis: 150, AverageMillis: 150},
+	}, s.SlowQueries)
+	assert.Equal(t, []CollScan{{Namespace: "sales.orders", Count: 2, TotalMillis: 400}}, s.CollScans)
+	assert.Equal(t, []ConnectionChurn{
+		{Client: "orders-api", Opened: 1, Closed: 1},
+		{Client: "10.0.0.1", Opened: 1},
+		{Client: "10.0.0.2", Opened: 1},
+	}, s.Connections)
+	assert.Equal(t, []ErrorCode{
+		{Code: 6, Name: "HostUnreachable", Count: 1},
+		{Code: 11000, Name: "DuplicateKey", Count: 1},
+	}, s.Errors)
+	assert.Equal(t, []ElectionEvent{
+		{Time: "2026-01-10T10:00:08.000+00:00", Source: "mongodb.log", Message: "Election succeeded, assuming primary role"},
+		{Time: "2026-01-10T10:00:09.000+00:00", Source: "mongodb.log", Message: "Replica set state transition: SECONDARY -> PRIMARY"},
+	}, s.Elections)
+}
+
+func TestAnalyzer_AnalyzeGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(testLog))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	a := NewAnalyzer()
+	require.NoError(t, a.Analyze("mongodb.gz", &buf))
+	require.NoError(t, a.Analyze("empty.log", strings.NewReader("")))
+	s := a.Summary()
+
+	assert.Equal(t, 2, s.Files)
+	assert.Equal(t, 11, s.Entries)
+	assert.Len(t, s.SlowQueries, 2)
+}
+
+func TestAnalyzer_AnalyzeInvalidGzip(t *testing.T) {
+	a := NewAnalyzer()
+	require.Error(t, a.Analyze("mongodb.gz", bytes.NewReader([]byte{0x1f, 0x8b, 0x00})))
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loganalysis
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LineFilter keeps the log lines that match a regular expression, and the structured log
+// entries of some components or severities. A nil filter keeps all the lines.
+type LineFilter struct {
+	Grep       *regexp.Regexp
+	Components []string
+	Severities []string
+}
+
+// Match reports whether the filter keeps the line. Lines that aren't in the structured
+// log format are only kept when the filter has no components and severities.
+func (f *LineFilter) Match(line []byte) bool {
+	if f == nil {
+		return true
+	}
+	if f.Grep != nil && !f.Grep.Match(line) {
+		return false
+	}
+	if len(f.Components) == 0 && len(f.Severities) == 0 {
+		return true
+	}
+
+	var entry logEntry
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return false
+	}
+	return matchFold(f.Components, entry.C) && matchSeverity(f.Severities, entry.S)
+}
+
+func matchFold(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSeverity matches the severity of an entry, where D matches all the debug levels D1 to D5.
+func matchSeverity(severities []string, severity string) bool {
+	if len(severities) == 0 {
+		return true
+	}
+	for _, s := range severities {
+		if strings.EqualFold(s, severity) || (strings.EqualFold(s, "D") && strings.HasPrefix(severity, "D")) {
+			return true
+		}
+	}
+	return false
+}
+
+// EntryTime returns the time of a structured log entry.
+func EntryTime(line []byte) (time.Time, bool) {
+	var entry struct {
+		T struct {
+			Date string `json:"$date"`
+		} `json:"t"`
+	}
+	if err := json.Unmarshal(line, &entry); err != nil || entry.T.Date == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, entry.T.Date)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loganalysis
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestLineFilter_Match(t *testing.T) {
+	entry := []byte(`{"t":{"$date":"2026-01-10T10:00:00.000+00:00"},"s":"W","c":"NETWORK","id":4615610,"ctx":"conn2","msg":"Failed to check socket connectivity"}`)
+	debug := []byte(`{"t":{"$date":"2026-01-10T10:00:00.000+00:00"},"s":"D2","c":"QUERY","id":1,"ctx":"conn2","msg":"debug"}`)
+	plain := []byte("mongod startup complete")
+
+	testCases := []struct {
+		name   string
+		filter *LineFilter
+		line   []byte
+		want   bool
+	}{
+		{name: "nil", filter: nil, line: plain, want: true},
+		{name: "empty", filter: &LineFilter{}, line: plain, want: true},
+		{name: "grep", filter: &LineFilter{Grep: regexp.MustCompile("socket")}, line: entry, want: true},
+		{name: "grep no match", filter: &LineFilter{Grep: regexp.MustCompile("election")}, line: entry, want: false},
+		{name: "component", filter: &LineFilter{Components: []string{"network"}}, line: entry, want: true},
+		{name: "other component", filter: &LineFilter{Components: []string{"REPL"}}, line: entry, want: false},
+		{name: "severity", filter: &LineFilter{Severities: []string{"E", "W"}}, line: entry, want: true},
+		{name: "other severity", filter: &LineFilter{Severities: []string{"E"}}, line: entry, want: false},
+		{name: "debug severity", filter: &LineFilter{Severities: []string{"D"}}, line: debug, want: true},
+		{name: "component of unstructured line", filter: &LineFilter{Components: []string{"NETWORK"}}, line: plain, want: false},
+		{
+			name:   "all",
+			filter: &LineFilter{Grep: regexp.MustCompile("conn2"), Components: []string{"NETWORK"}, Severities: []string{"W"}},
+			line:   entry,
+			want:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.filter.Match(tc.line); got != tc.want {
+				t.Errorf("Match() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEntryTime(t *testing.T) {
+	got, ok := EntryTime([]byte(`{"t":{"$date":"2026-01-10T10:00:00.250+01:00"},"s":"I","c":"NETWORK","msg":"m"}`))
+	if !ok || !got.Equal(time.Date(2026, 1, 10, 9, 0, 0, 250_000_000, time.UTC)) {
+		t.Errorf("EntryTime() = %v, %v", got, ok)
+	}
+
+	if _, ok := EntryTime([]byte("mongod startup complete")); ok {
+		t.Error("EntryTime() expected no time for an unstructured line")
+	}
+}
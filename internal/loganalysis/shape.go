@@ -0,0 +1,128 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loganalysis
+
+import (
+	"encoding/json"
+)
+
+const shapeValue = 1
+
+// commandPredicates are the fields of the commands that hold the predicate of the query, in lookup order.
+var commandPredicates = []struct {
+	command string
+	path    []string
+}{
+	{command: "find", path: []string{"filter"}},
+	{command: "aggregate", path: []string{"pipeline"}},
+	{command: "count", path: []string{"query"}},
+	{command: "distinct", path: []string{"query"}},
+	{command: "findAndModify", path: []string{"query"}},
+	{command: "update", path: []string{"updates", "q"}},
+	{command: "delete", path: []string{"deletes", "q"}},
+	{command: "insert"},
+}
+
+// QueryShape returns the shape of the query of a slow query entry: the command name followed
+// by its predicate, with its values replaced so that queries that only differ by their
+// values share the same shape, like find {"age":{"$gt":1},"status":1}.
+func QueryShape(attr map[string]any) string {
+	command, _ := attr["command"].(map[string]any)
+	if getMore, ok := command["getMore"]; ok && getMore != nil {
+		if originating, ok := attr["originatingCommand"].(map[string]any); ok {
+			command = originating
+		}
+	}
+
+	for _, p := range commandPredicates {
+		if _, ok := command[p.command]; !ok {
+			continue
+		}
+		if len(p.path) == 0 {
+			return p.command
+		}
+		predicate := predicateValue(command, p.path)
+		if predicate == nil {
+			return p.command
+		}
+		b, err := json.Marshal(shape(predicate))
+		if err != nil {
+			return p.command
+		}
+		return p.command + " " + string(b)
+	}
+
+	if t, ok := attr["type"].(string); ok && t != "" {
+		return t
+	}
+	return "unknown"
+}
+
+// predicateValue follows the path in the command, using the first statement of batched commands like update.
+func predicateValue(command map[string]any, path []string) any {
+	var v any = command
+	for _, p := range path {
+		if a, ok := v.([]any); ok {
+			if len(a) == 0 {
+				return nil
+			}
+			v = a[0]
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[p]
+	}
+	return v
+}
+
+// shape replaces the values of a predicate, keeping its fields and operators.
+// Logical operators keep all their clauses, other arrays are collapsed.
+func shape(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, value := range t {
+			// extended JSON values, like {"$oid": "..."} or {"$date": "..."}, are values of the shape
+			if isExtendedJSON(k) {
+				return shapeValue
+			}
+			m[k] = shape(value)
+		}
+		return m
+	case []any:
+		a := make([]any, 0, len(t))
+		for _, value := range t {
+			s := shape(value)
+			if _, ok := s.(map[string]any); !ok {
+				return []any{shapeValue}
+			}
+			a = append(a, s)
+		}
+		return a
+	default:
+		return shapeValue
+	}
+}
+
+func isExtendedJSON(key string) bool {
+	switch key {
+	case "$oid", "$date", "$numberLong", "$numberDecimal", "$numberDouble", "$numberInt", "$binary", "$uuid", "$timestamp", "$regularExpression":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loganalysis
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestQueryShape(t *testing.T) {
+	testCases := []struct {
+		name string
+		attr string
+		want string
+	}{
+		{
+			name: "find",
+			attr: `{"command":{"find":"orders","filter":{"status":"A","items":{"$in":["a","b"]}}}}`,
+			want: `find {"items":{"$in":[1]},"status":1}`,
+		},
+		{
+			name: "logical operators keep their clauses",
+			attr: `{"command":{"find":"orders","filter":{"$or":[{"a":1},{"b":{"$exists":true}}]}}}`,
+			want: `find {"$or":[{"a":1},{"b":{"$exists":1}}]}`,
+		},
+		{
+			name: "aggregate",
+			attr: `{"command":{"aggregate":"orders","pipeline":[{"$match":{"day":{"$date":"2026-01-01T00:00:00Z"}}},{"$limit":10}]}}`,
+			want: `aggregate [{"$match":{"day":1}},{"$limit":1}]`,
+		},
+		{
+			name: "delete",
+			attr: `{"command":{"delete":"orders","deletes":[{"q":{"status":"D"},"limit":0}]}}`,
+			want: `delete {"status":1}`,
+		},
+		{
+			name: "getMore",
+			attr: `{"command":{"getMore":12345,"collection":"orders"},"originatingCommand":{"find":"orders","filter":{"a":2}}}`,
+			want: `find {"a":1}`,
+		},
+		{
+			name: "insert",
+			attr: `{"command":{"insert":"orders","documents":[{"a":1}]}}`,
+			want: "insert",
+		},
+		{
+			name: "other",
+			attr: `{"type":"op_msg","command":{"listIndexes":"orders"}}`,
+			want: "op_msg",
+		},
+		{
+			name: "no command",
+			attr: `{}`,
+			want: "unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attr map[string]any
+			if err := json.Unmarshal([]byte(tc.attr), &attr); err != nil {
+				t.Fatal(err)
+			}
+			if got := QueryShape(attr); got != tc.want {
+				t.Errorf("QueryShape() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logfollow follows the logs of Atlas hosts, which can only be downloaded as gzip files,
+// by polling the log download API with a moving start date.
+package logfollow
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/loganalysis"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	defaultLookback = 5 * time.Minute
+	maxLineSize     = 16 * 1024 * 1024
+)
+
+// Fetcher downloads the gzipped log lines written since start, in seconds since the epoch.
+type Fetcher func(ctx context.Context, start int64) (io.ReadCloser, error)
+
+type Follower struct {
+	fetch    Fetcher
+	out      io.Writer
+	filter   *loganalysis.LineFilter
+	interval time.Duration
+	start    int64
+	// seen holds the lines already written from the start second onwards, which every poll downloads again.
+	seen map[string]seenLine
+	now  func() time.Time
+}
+
+// seenLine is the second of a line and the number of times it was written,
+// identical lines can be logged more than once in the same second.
+type seenLine struct {
+	second int64
+	count  int
+}
+
+type Option func(f *Follower)
+
+// WithFilter only writes the lines that match the filter.
+func WithFilter(filter *loganalysis.LineFilter) Option {
+	return func(f *Follower) {
+		f.filter = filter
+	}
+}
+
+// WithInterval sets the time between two downloads of the log, defaults to 30 seconds.
+func WithInterval(interval time.Duration) Option {
+	return func(f *Follower) {
+		if interval > 0 {
+			f.interval = interval
+		}
+	}
+}
+
+// WithStart sets the date, in seconds since the epoch, of the first lines to write.
+// Defaults to the last 5 minutes.
+func WithStart(start int64) Option {
+	return func(f *Follower) {
+		if start > 0 {
+			f.start = start
+		}
+	}
+}
+
+func NewFollower(fetch Fetcher, out io.Writer, opts ...Option) *Follower {
+	f := &Follower{
+		fetch:    fetch,
+		out:      out,
+		interval: defaultInterval,
+		seen:     map[string]seenLine{},
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if f.start == 0 {
+		f.start = f.now().Add(-defaultLookback).Unix()
+	}
+	return f
+}
+
+// Run writes the new log lines until the context is done.
+func (f *Follower) Run(ctx context.Context) error {
+	for {
+		if err := f.poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(f.interval):
+		}
+	}
+}
+
+// poll downloads the log from the start date, writes the lines not written yet,
+// and moves the start date to the second of the last line.
+func (f *Follower) poll(ctx context.Context) error {
+	r, err := f.fetch(ctx, f.start)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	gr, err := gzip.NewReader(r)
+	if errors.Is(err, io.EOF) {
+		return nil // nothing was logged since the start date
+	}
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	latest := f.start
+	// occurrences counts the lines of this download, the nth occurrence of a line is new when fewer were written before
+	occurrences := map[string]int{}
+	scanner := bufio.NewScanner(gr)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		second := f.start
+		if t, ok := loganalysis.EntryTime(line); ok {
+			second = t.Unix()
+		}
+		if second < f.start {
+			continue
+		}
+		key := string(line)
+		occurrences[key]++
+		if occurrences[key] <= f.seen[key].count {
+			continue
+		}
+		f.seen[key] = seenLine{second: second, count: occurrences[key]}
+		latest = max(latest, second)
+
+		if !f.filter.Match(line) {
+			continue
+		}
+		if _, err := fmt.Fprintf(f.out, "%s\n", line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	f.start = latest
+	for line, seen := range f.seen {
+		if seen.second < f.start {
+			delete(f.seen, line)
+		}
+	}
+	return nil
+}
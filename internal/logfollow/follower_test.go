@@ -0,0 +1,139 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logfollow
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/loganalysis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	line1 = `{"t":{"$date":"2026-01-10T10:00:00.100+00:00"},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"Connection accepted"}`
+	line2 = `{"t":{"$date":"2026-01-10T10:00:01.200+00:00"},"s":"W","c":"NETWORK","id":4615610,"ctx":"conn1","msg":"Failed to check socket connectivity"}`
+	line3 = `{"t":{"$date":"2026-01-10T10:00:01.500+00:00"},"s":"I","c":"REPL","id":21358,"ctx":"ReplCoord-0","msg":"Replica set state transition"}`
+	line4 = `{"t":{"$date":"2026-01-10T10:00:03.000+00:00"},"s":"W","c":"NETWORK","id":22944,"ctx":"conn1","msg":"Connection ended"}`
+)
+
+func gzipLines(t *testing.T, lines ...string) io.ReadCloser {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	for _, l := range lines {
+		_, _ = gz.Write([]byte(l + "\n"))
+	}
+	require.NoError(t, gz.Close())
+	return io.NopCloser(&buf)
+}
+
+func TestFollower_Run(t *testing.T) {
+	start := time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC).Unix()
+
+	// every download returns the lines logged since the start date, rounded to the second
+	polls := [][]string{
+		{line1, line2},
+		{line2, line3},
+		{},
+		{line2, line3, line4},
+	}
+	var starts []int64
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	fetch := func(_ context.Context, s int64) (io.ReadCloser, error) {
+		starts = append(starts, s)
+		if len(starts) == len(polls) {
+			cancel()
+		}
+		lines := polls[len(starts)-1]
+		if len(lines) == 0 {
+			return io.NopCloser(bytes.NewReader(nil)), nil
+		}
+		return gzipLines(t, lines...), nil
+	}
+
+	out := new(bytes.Buffer)
+	f := NewFollower(fetch, out, WithStart(start), WithInterval(time.Millisecond))
+	require.NoError(t, f.Run(ctx))
+
+	assert.Equal(t, line1+"\n"+line2+"\n"+line3+"\n"+line4+"\n", out.String())
+	assert.Equal(t, []int64{start, start + 1, start + 1, start + 1}, starts)
+}
+
+func TestFollower_RunRepeatedLines(t *testing.T) {
+	start := time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC).Unix()
+
+	// identical lines logged in the same second are all written once
+	polls := [][]string{
+		{line1, line2, line2},
+		{line2, line2, line2, line3},
+	}
+	calls := 0
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	fetch := func(context.Context, int64) (io.ReadCloser, error) {
+		calls++
+		if calls == len(polls) {
+			cancel()
+		}
+		return gzipLines(t, polls[calls-1]...), nil
+	}
+
+	out := new(bytes.Buffer)
+	f := NewFollower(fetch, out, WithStart(start), WithInterval(time.Millisecond))
+	require.NoError(t, f.Run(ctx))
+
+	assert.Equal(t, line1+"\n"+line2+"\n"+line2+"\n"+line2+"\n"+line3+"\n", out.String())
+}
+
+func TestFollower_RunFilter(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	fetch := func(context.Context, int64) (io.ReadCloser, error) {
+		cancel()
+		return gzipLines(t, line1, line2, line3, line4), nil
+	}
+
+	out := new(bytes.Buffer)
+	filter := &loganalysis.LineFilter{Grep: regexp.MustCompile("conn1"), Severities: []string{"W"}}
+	f := NewFollower(fetch, out, WithStart(1), WithFilter(filter))
+	require.NoError(t, f.Run(ctx))
+
+	assert.Equal(t, line2+"\n"+line4+"\n", out.String())
+}
+
+func TestFollower_RunError(t *testing.T) {
+	errDownload := errors.New("download failed")
+	fetch := func(context.Context, int64) (io.ReadCloser, error) {
+		return nil, errDownload
+	}
+
+	f := NewFollower(fetch, io.Discard)
+	require.ErrorIs(t, f.Run(t.Context()), errDownload)
+}
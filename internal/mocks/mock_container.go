@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	container "github.com/mongodb/mongodb-atlas-cli/atlascli/internal/container"
@@ -111,6 +112,21 @@ func (mr *MockEngineMockRecorder) ContainerLogs(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerLogs", reflect.TypeOf((*MockEngine)(nil).ContainerLogs), arg0, arg1)
 }
 
+// ContainerLogsFollow mocks base method.
+func (m *MockEngine) ContainerLogsFollow(arg0 context.Context, arg1 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerLogsFollow", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerLogsFollow indicates an expected call of ContainerLogsFollow.
+func (mr *MockEngineMockRecorder) ContainerLogsFollow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerLogsFollow", reflect.TypeOf((*MockEngine)(nil).ContainerLogsFollow), arg0, arg1)
+}
+
 // ContainerRm mocks base method.
 func (m *MockEngine) ContainerRm(arg0 context.Context, arg1 ...string) error {
 	m.ctrl.T.Helper()
@@ -265,6 +281,39 @@ func (mr *MockEngineMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockEngine)(nil).Name))
 }
 
+// NetworkCreate mocks base method.
+func (m *MockEngine) NetworkCreate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NetworkCreate indicates an expected call of NetworkCreate.
+func (mr *MockEngineMockRecorder) NetworkCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkCreate", reflect.TypeOf((*MockEngine)(nil).NetworkCreate), arg0, arg1)
+}
+
+// NetworkRm mocks base method.
+func (m *MockEngine) NetworkRm(arg0 context.Context, arg1 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NetworkRm", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NetworkRm indicates an expected call of NetworkRm.
+func (mr *MockEngineMockRecorder) NetworkRm(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkRm", reflect.TypeOf((*MockEngine)(nil).NetworkRm), varargs...)
+}
+
 // Ready mocks base method.
 func (m *MockEngine) Ready() error {
 	m.ctrl.T.Helper()
@@ -307,3 +356,56 @@ func (mr *MockEngineMockRecorder) Version(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockEngine)(nil).Version), arg0)
 }
+
+// VolumeCreate mocks base method.
+func (m *MockEngine) VolumeCreate(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeCreate indicates an expected call of VolumeCreate.
+func (mr *MockEngineMockRecorder) VolumeCreate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeCreate", reflect.TypeOf((*MockEngine)(nil).VolumeCreate), arg0, arg1, arg2)
+}
+
+// VolumeList mocks base method.
+func (m *MockEngine) VolumeList(arg0 context.Context, arg1 ...string) ([]container.Volume, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VolumeList", varargs...)
+	ret0, _ := ret[0].([]container.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeList indicates an expected call of VolumeList.
+func (mr *MockEngineMockRecorder) VolumeList(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeList", reflect.TypeOf((*MockEngine)(nil).VolumeList), varargs...)
+}
+
+// VolumeRm mocks base method.
+func (m *MockEngine) VolumeRm(arg0 context.Context, arg1 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VolumeRm", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeRm indicates an expected call of VolumeRm.
+func (mr *MockEngineMockRecorder) VolumeRm(arg0 any, arg1 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeRm", reflect.TypeOf((*MockEngine)(nil).VolumeRm), varargs...)
+}
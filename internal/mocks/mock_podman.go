@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -92,6 +93,21 @@ func (mr *MockClientMockRecorder) ContainerLogs(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerLogs", reflect.TypeOf((*MockClient)(nil).ContainerLogs), ctx, name)
 }
 
+// ContainerLogsFollow mocks base method.
+func (m *MockClient) ContainerLogsFollow(ctx context.Context, name string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerLogsFollow", ctx, name)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerLogsFollow indicates an expected call of ContainerLogsFollow.
+func (mr *MockClientMockRecorder) ContainerLogsFollow(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerLogsFollow", reflect.TypeOf((*MockClient)(nil).ContainerLogsFollow), ctx, name)
+}
+
 // ContainerStatusAndUptime mocks base method.
 func (m *MockClient) ContainerStatusAndUptime(ctx context.Context, name string) (string, time.Duration, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +124,36 @@ func (mr *MockClientMockRecorder) ContainerStatusAndUptime(ctx, name any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerStatusAndUptime", reflect.TypeOf((*MockClient)(nil).ContainerStatusAndUptime), ctx, name)
 }
 
+// CreateNetwork mocks base method.
+func (m *MockClient) CreateNetwork(ctx context.Context, name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", ctx, name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockClientMockRecorder) CreateNetwork(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockClient)(nil).CreateNetwork), ctx, name)
+}
+
+// CreateVolume mocks base method.
+func (m *MockClient) CreateVolume(ctx context.Context, name string, labels map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", ctx, name, labels)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockClientMockRecorder) CreateVolume(ctx, name, labels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockClient)(nil).CreateVolume), ctx, name, labels)
+}
+
 // ImageHealthCheck mocks base method.
 func (m *MockClient) ImageHealthCheck(ctx context.Context, name string) (*podman.Schema2HealthConfig, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +199,26 @@ func (mr *MockClientMockRecorder) ListImages(ctx, nameFilter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockClient)(nil).ListImages), ctx, nameFilter)
 }
 
+// ListVolumes mocks base method.
+func (m *MockClient) ListVolumes(ctx context.Context, labels ...string) ([]*podman.Volume, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range labels {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumes", varargs...)
+	ret0, _ := ret[0].([]*podman.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockClientMockRecorder) ListVolumes(ctx any, labels ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, labels...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockClient)(nil).ListVolumes), varargs...)
+}
+
 // Logs mocks base method.
 func (m *MockClient) Logs(ctx context.Context) (map[string]any, []error) {
 	m.ctrl.T.Helper()
@@ -217,6 +283,46 @@ func (mr *MockClientMockRecorder) RemoveContainers(ctx any, names ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContainers", reflect.TypeOf((*MockClient)(nil).RemoveContainers), varargs...)
 }
 
+// RemoveNetworks mocks base method.
+func (m *MockClient) RemoveNetworks(ctx context.Context, names ...string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range names {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveNetworks", varargs...)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNetworks indicates an expected call of RemoveNetworks.
+func (mr *MockClientMockRecorder) RemoveNetworks(ctx any, names ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, names...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworks", reflect.TypeOf((*MockClient)(nil).RemoveNetworks), varargs...)
+}
+
+// RemoveVolumes mocks base method.
+func (m *MockClient) RemoveVolumes(ctx context.Context, names ...string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range names {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveVolumes", varargs...)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVolumes indicates an expected call of RemoveVolumes.
+func (mr *MockClientMockRecorder) RemoveVolumes(ctx any, names ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, names...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolumes", reflect.TypeOf((*MockClient)(nil).RemoveVolumes), varargs...)
+}
+
 // RunContainer mocks base method.
 func (m *MockClient) RunContainer(ctx context.Context, opts podman.RunContainerOpts) ([]byte, error) {
 	m.ctrl.T.Helper()
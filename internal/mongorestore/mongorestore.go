@@ -0,0 +1,54 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongorestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+var ErrMongorestoreNotInstalled = errors.New("did not find mongorestore, install the MongoDB Database Tools: https://dochub.mongodb.org/core/install-database-tools")
+
+func Detect() bool {
+	return binPath() != ""
+}
+
+func binPath() string {
+	p, err := exec.LookPath(mongorestoreBin)
+	if errors.Is(err, exec.ErrDot) {
+		err = nil
+	}
+	if err == nil {
+		return p
+	}
+
+	return ""
+}
+
+// RestoreArchive restores the databases of an archive created by mongodump --archive.
+func RestoreArchive(ctx context.Context, mongoURI, archive string) error {
+	cmd := exec.CommandContext(ctx, mongorestoreBin, "--uri", mongoURI, "--archive="+archive, "--quiet")
+	cmd.Env = os.Environ()
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
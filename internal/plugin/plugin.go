@@ -138,12 +138,6 @@ func GetDefaultPluginDirectory() (string, error) {
 	return pluginDirectoryPath, nil
 }
 
-type Command struct {
-	Name        string
-	Description string
-	Aliases     []string
-}
-
 type Github struct {
 	Owner string
 	Name  string
@@ -175,11 +169,11 @@ func (p *Plugin) Run(cmd *cobra.Command, args []string) error {
 	p.setTelemetry()
 
 	binaryPath := path.Join(p.PluginDirectoryPath, p.BinaryName)
-	execCmd := exec.CommandContext(context.Background(), binaryPath, append([]string{cmd.Use}, args...)...)
+	execCmd := exec.CommandContext(context.Background(), binaryPath, append(commandPath(cmd), args...)...)
 	execCmd.Stdin = cmd.InOrStdin()
 	execCmd.Stdout = cmd.OutOrStdout()
 	execCmd.Stderr = cmd.OutOrStderr()
-	execCmd.Env = os.Environ()
+	execCmd.Env = append(os.Environ(), RefreshedContext(cmd.Context()).Environ()...)
 	if err := execCmd.Run(); err != nil {
 		var exitErr *exec.ExitError
 		if errors.As(err, &exitErr) {
@@ -203,31 +197,7 @@ func (p *Plugin) GetCobraCommands() []*cobra.Command {
 	commands := make([]*cobra.Command, 0, len(p.Commands))
 
 	for _, pluginCmd := range p.Commands {
-		command := &cobra.Command{
-			Use:   pluginCmd.Name,
-			Short: pluginCmd.Description,
-			Annotations: map[string]string{
-				sourceType:       PluginSourceType,
-				sourcePluginName: p.Name,
-			},
-			RunE:    p.Run,
-			Aliases: pluginCmd.Aliases,
-		}
-
-		// Disable the default cobra help function.
-		// Instead redirect help to the plugin.
-		// Example: atlas example-plugin --help -> [example-binary] example-plugin --help
-		command.SetHelpFunc(func(cmd *cobra.Command, args []string) {
-			// args contains all arguments + the name of the command
-			// we don't need the name of the subcommand
-			if err := p.Run(cmd, args[1:]); err != nil {
-				_, _ = log.Warningf("failed to generate help for plugin command '%v': %v", args[0], err)
-			}
-		})
-
-		command.DisableFlagParsing = true
-
-		commands = append(commands, command)
+		commands = append(commands, p.newCobraCommand(pluginCmd))
 	}
 
 	return commands
@@ -270,7 +240,7 @@ func createPluginFromManifest(manifest *Manifest) (*Plugin, error) {
 	}
 
 	for cmdName, value := range manifest.Commands {
-		plugin.Commands = append(plugin.Commands, &Command{Name: cmdName, Description: value.Description, Aliases: value.Aliases})
+		plugin.Commands = append(plugin.Commands, newCommand(cmdName, value))
 	}
 
 	return &plugin, nil
@@ -0,0 +1,309 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagTypeString      = "string"
+	flagTypeBool        = "bool"
+	flagTypeInt         = "int"
+	flagTypeFloat       = "float"
+	flagTypeDuration    = "duration"
+	flagTypeStringSlice = "stringSlice"
+)
+
+var (
+	flagTypes              = []string{flagTypeString, flagTypeBool, flagTypeInt, flagTypeFloat, flagTypeDuration, flagTypeStringSlice}
+	errUnsupportedFlagType = fmt.Errorf("unsupported flag type, valid types are %s", strings.Join(flagTypes, ", "))
+)
+
+type Command struct {
+	Name        string
+	Description string
+	Aliases     []string
+	Flags       []*Flag
+	Subcommands []*Command
+	Completion  *Completion
+}
+
+type Flag struct {
+	Name        string
+	Type        string
+	Shorthand   string
+	Description string
+	Required    bool
+	Default     string
+	Completion  *Completion
+}
+
+type Completion struct {
+	Values         []string
+	FileExtensions []string
+	Directories    bool
+}
+
+// declaresCLI reports whether the manifest declares the flags or the subcommands of the command,
+// commands that don't are documented by the plugin itself.
+func (c *Command) declaresCLI() bool {
+	return len(c.Flags) > 0 || len(c.Subcommands) > 0 || c.Completion != nil
+}
+
+func newCommand(name string, manifestCommand ManifestCommand) *Command {
+	command := &Command{
+		Name:        name,
+		Description: manifestCommand.Description,
+		Aliases:     manifestCommand.Aliases,
+		Completion:  newCompletion(manifestCommand.Completion),
+	}
+
+	for _, flagName := range sortedKeys(manifestCommand.Flags) {
+		command.Flags = append(command.Flags, newFlag(flagName, manifestCommand.Flags[flagName]))
+	}
+	for _, subcommandName := range sortedKeys(manifestCommand.Subcommands) {
+		command.Subcommands = append(command.Subcommands, newCommand(subcommandName, manifestCommand.Subcommands[subcommandName]))
+	}
+
+	return command
+}
+
+func newFlag(name string, manifestFlag ManifestFlag) *Flag {
+	return &Flag{
+		Name:        name,
+		Type:        manifestFlag.Type,
+		Shorthand:   manifestFlag.Shorthand,
+		Description: manifestFlag.Description,
+		Required:    manifestFlag.Required,
+		Default:     manifestFlag.Default,
+		Completion:  newCompletion(manifestFlag.Completion),
+	}
+}
+
+func newCompletion(manifestCompletion *ManifestCompletion) *Completion {
+	if manifestCompletion == nil {
+		return nil
+	}
+	return &Completion{
+		Values:         manifestCompletion.Values,
+		FileExtensions: manifestCompletion.FileExtensions,
+		Directories:    manifestCompletion.Directories,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// addFlag adds the flag declared in the manifest to the flag set, the type defaults to string.
+func addFlag(fs *pflag.FlagSet, f *Flag) error {
+	switch f.Type {
+	case "", flagTypeString:
+		fs.StringP(f.Name, f.Shorthand, "", f.Description)
+	case flagTypeBool:
+		fs.BoolP(f.Name, f.Shorthand, false, f.Description)
+	case flagTypeInt:
+		fs.IntP(f.Name, f.Shorthand, 0, f.Description)
+	case flagTypeFloat:
+		fs.Float64P(f.Name, f.Shorthand, 0, f.Description)
+	case flagTypeDuration:
+		fs.DurationP(f.Name, f.Shorthand, 0, f.Description)
+	case flagTypeStringSlice:
+		fs.StringSliceP(f.Name, f.Shorthand, nil, f.Description)
+	default:
+		return fmt.Errorf("%w: %s", errUnsupportedFlagType, f.Type)
+	}
+
+	if f.Default == "" {
+		return nil
+	}
+	flag := fs.Lookup(f.Name)
+	if err := flag.Value.Set(f.Default); err != nil {
+		return fmt.Errorf("invalid default value %q: %w", f.Default, err)
+	}
+	flag.DefValue = flag.Value.String()
+	return nil
+}
+
+func (c *Command) addFlags(cmd *cobra.Command) {
+	for _, f := range c.Flags {
+		if err := addFlag(cmd.Flags(), f); err != nil {
+			logPluginWarning(`could not add flag "%s" to command "%s": %s`, f.Name, c.Name, err.Error())
+			continue
+		}
+		if f.Required {
+			_ = cmd.MarkFlagRequired(f.Name)
+		}
+	}
+	// the plugin can accept flags that aren't declared in the manifest
+	cmd.Flags().ParseErrorsAllowlist.UnknownFlags = true
+}
+
+func (p *Plugin) newCobraCommand(c *Command) *cobra.Command {
+	command := &cobra.Command{
+		Use:   c.Name,
+		Short: c.Description,
+		Annotations: map[string]string{
+			sourceType:       PluginSourceType,
+			sourcePluginName: p.Name,
+		},
+		RunE:    p.Run,
+		Aliases: c.Aliases,
+		// The arguments are passed as they are to the plugin, flags declared in the manifest are
+		// only used for the completion, the documentation and the validation of the command.
+		DisableFlagParsing: true,
+	}
+
+	if !c.declaresCLI() {
+		// Disable the default cobra help function.
+		// Instead redirect help to the plugin.
+		// Example: atlas example-plugin --help -> [example-binary] example-plugin --help
+		command.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+			// args contains all arguments + the name of the command
+			// we don't need the name of the subcommand
+			if err := p.Run(cmd, args[1:]); err != nil {
+				_, _ = log.Warningf("failed to generate help for plugin command '%v': %v", args[0], err)
+			}
+		})
+		return command
+	}
+
+	c.addFlags(command)
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := validateFlags(cmd, args); err != nil {
+			return err
+		}
+		return p.Run(cmd, args)
+	}
+	command.ValidArgsFunction = c.complete
+	for _, subcommand := range c.Subcommands {
+		command.AddCommand(p.newCobraCommand(subcommand))
+	}
+
+	return command
+}
+
+// validateFlags parses the arguments with the flags declared in the manifest to validate
+// their types and that the required flags are set, help is left to the plugin.
+func validateFlags(cmd *cobra.Command, args []string) error {
+	if err := cmd.Flags().Parse(args); err != nil {
+		if errors.Is(err, pflag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if help, err := cmd.Flags().GetBool("help"); err == nil && help {
+		return nil
+	}
+
+	// cobra doesn't validate the required flags of commands that disable flag parsing
+	var missingFlags []string
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if required, ok := f.Annotations[cobra.BashCompOneRequiredFlag]; ok && len(required) > 0 && required[0] == "true" && !f.Changed {
+			missingFlags = append(missingFlags, f.Name)
+		}
+	})
+	if len(missingFlags) > 0 {
+		return fmt.Errorf(`required flag(s) "%s" not set`, strings.Join(missingFlags, `", "`))
+	}
+	return nil
+}
+
+// commandPath returns the names of the commands from the top-level command of the plugin,
+// like [kubernetes config generate], which the plugin binary expects before the arguments.
+func commandPath(cmd *cobra.Command) []string {
+	path := []string{cmd.Name()}
+	for c := cmd.Parent(); c != nil && IsPluginCmd(c); c = c.Parent() {
+		path = append([]string{c.Name()}, path...)
+	}
+	return path
+}
+
+func (c *Command) lookupFlag(arg string) *Flag {
+	for _, f := range c.Flags {
+		if arg == "--"+f.Name || (f.Shorthand != "" && arg == "-"+f.Shorthand) {
+			return f
+		}
+	}
+	return nil
+}
+
+// complete completes the value of the flag being set, or the arguments of the command,
+// with the completion hints of the manifest.
+func (c *Command) complete(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if strings.HasPrefix(toComplete, "-") {
+		name, value, found := strings.Cut(toComplete, "=")
+		f := c.lookupFlag(name)
+		if !found || f == nil {
+			// flag names are completed by cobra
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		values, directive := f.Completion.complete(value)
+		if directive != cobra.ShellCompDirectiveNoFileComp {
+			// file extensions and directories filter the files the shell completes, they aren't values
+			return values, directive
+		}
+		completions := make([]string, 0, len(values))
+		for _, v := range values {
+			completions = append(completions, name+"="+v)
+		}
+		return completions, directive
+	}
+
+	if len(args) > 0 {
+		if f := c.lookupFlag(args[len(args)-1]); f != nil && f.Type != flagTypeBool {
+			return f.Completion.complete(toComplete)
+		}
+	}
+
+	if c.Completion == nil && len(c.Subcommands) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return c.Completion.complete(toComplete)
+}
+
+func (c *Completion) complete(toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch {
+	case c == nil:
+		return nil, cobra.ShellCompDirectiveDefault
+	case len(c.Values) > 0:
+		var values []string
+		for _, v := range c.Values {
+			if strings.HasPrefix(v, toComplete) {
+				values = append(values, v)
+			}
+		}
+		return values, cobra.ShellCompDirectiveNoFileComp
+	case len(c.FileExtensions) > 0:
+		return slices.Clone(c.FileExtensions), cobra.ShellCompDirectiveFilterFileExt
+	case c.Directories:
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	default:
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+}
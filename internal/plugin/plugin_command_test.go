@@ -0,0 +1,132 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestManifestWithFlags() *Manifest {
+	manifest := getTestManifest()
+	manifest.Commands = map[string]ManifestCommand{
+		"kubernetes": {
+			Description: "Manage Kubernetes resources.",
+			Aliases:     []string{"k8s"},
+			Subcommands: map[string]ManifestCommand{
+				"config": {
+					Description: "Generate Kubernetes configuration.",
+					Flags: map[string]ManifestFlag{
+						"clusterName": {Description: "Name of the cluster.", Required: true},
+						"output":      {Shorthand: "o", Description: "Output format.", Default: "yaml", Completion: &ManifestCompletion{Values: []string{"json", "yaml"}}},
+						"file":        {Description: "Configuration file.", Completion: &ManifestCompletion{FileExtensions: []string{"yaml", "yml"}}},
+						"dryRun":      {Type: "bool", Description: "Only print the configuration."},
+						"timeout":     {Type: "duration", Description: "Timeout.", Default: "1m"},
+					},
+					Completion: &ManifestCompletion{Directories: true},
+				},
+			},
+		},
+	}
+	return manifest
+}
+
+func Test_GetCobraCommandsWithFlags(t *testing.T) {
+	plugin, err := createPluginFromManifest(getTestManifestWithFlags())
+	require.NoError(t, err)
+
+	commands := plugin.GetCobraCommands()
+	require.Len(t, commands, 1)
+	kubernetes := commands[0]
+	assert.Equal(t, "kubernetes", kubernetes.Use)
+	assert.Equal(t, []string{"k8s"}, kubernetes.Aliases)
+	assert.True(t, kubernetes.DisableFlagParsing)
+
+	require.Len(t, kubernetes.Commands(), 1)
+	config := kubernetes.Commands()[0]
+	assert.Equal(t, "config", config.Use)
+	assert.True(t, IsPluginCmd(config))
+	assert.True(t, config.DisableFlagParsing)
+	assert.Equal(t, []string{"kubernetes", "config"}, commandPath(config))
+
+	output := config.Flags().Lookup("output")
+	require.NotNil(t, output)
+	assert.Equal(t, "o", output.Shorthand)
+	assert.Equal(t, "yaml", output.DefValue)
+	assert.Equal(t, "bool", config.Flags().Lookup("dryRun").Value.Type())
+	assert.Equal(t, "1m0s", config.Flags().Lookup("timeout").DefValue)
+	assert.Contains(t, config.Flags().Lookup("clusterName").Annotations, cobra.BashCompOneRequiredFlag)
+}
+
+func Test_validateFlags(t *testing.T) {
+	plugin, err := createPluginFromManifest(getTestManifestWithFlags())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{name: "required flag", args: []string{"--clusterName", "c1"}, wantErr: require.NoError},
+		{name: "undeclared flag", args: []string{"--clusterName", "c1", "--other", "value"}, wantErr: require.NoError},
+		{name: "missing required flag", args: []string{"-o", "json"}, wantErr: require.Error},
+		{name: "invalid type", args: []string{"--clusterName", "c1", "--timeout", "soon"}, wantErr: require.Error},
+		{name: "help", args: []string{"--help"}, wantErr: require.NoError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := plugin.GetCobraCommands()[0].Commands()[0]
+			config.InitDefaultHelpFlag()
+			tt.wantErr(t, validateFlags(config, tt.args))
+		})
+	}
+}
+
+func TestCommand_complete(t *testing.T) {
+	plugin, err := createPluginFromManifest(getTestManifestWithFlags())
+	require.NoError(t, err)
+	kubernetes := plugin.Commands[0]
+	config := kubernetes.Subcommands[0]
+
+	tests := []struct {
+		name          string
+		command       *Command
+		args          []string
+		toComplete    string
+		wantValues    []string
+		wantDirective cobra.ShellCompDirective
+	}{
+		{name: "subcommands", command: kubernetes, toComplete: "", wantDirective: cobra.ShellCompDirectiveNoFileComp},
+		{name: "arguments", command: config, toComplete: "", wantDirective: cobra.ShellCompDirectiveFilterDirs},
+		{name: "flag value", command: config, args: []string{"--output"}, toComplete: "j", wantValues: []string{"json"}, wantDirective: cobra.ShellCompDirectiveNoFileComp},
+		{name: "flag shorthand value", command: config, args: []string{"-o"}, toComplete: "", wantValues: []string{"json", "yaml"}, wantDirective: cobra.ShellCompDirectiveNoFileComp},
+		{name: "flag value with equal", command: config, toComplete: "--output=y", wantValues: []string{"--output=yaml"}, wantDirective: cobra.ShellCompDirectiveNoFileComp},
+		{name: "file flag value with equal", command: config, toComplete: "--file=", wantValues: []string{"yaml", "yml"}, wantDirective: cobra.ShellCompDirectiveFilterFileExt},
+		{name: "file flag value", command: config, args: []string{"--file"}, toComplete: "", wantValues: []string{"yaml", "yml"}, wantDirective: cobra.ShellCompDirectiveFilterFileExt},
+		{name: "flag without hint", command: config, args: []string{"--clusterName"}, toComplete: "", wantDirective: cobra.ShellCompDirectiveDefault},
+		{name: "argument after bool flag", command: config, args: []string{"--dryRun"}, toComplete: "", wantDirective: cobra.ShellCompDirectiveFilterDirs},
+		{name: "flag name", command: config, toComplete: "--ou", wantDirective: cobra.ShellCompDirectiveNoFileComp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, directive := tt.command.complete(nil, tt.args, tt.toComplete)
+			assert.Equal(t, tt.wantValues, values)
+			assert.Equal(t, tt.wantDirective, directive)
+		})
+	}
+}
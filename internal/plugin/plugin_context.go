@@ -0,0 +1,139 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/cli"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/prerun"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	atlasauth "go.mongodb.org/atlas/auth"
+)
+
+// Environment variables that hand the context of the host CLI over to plugins,
+// so plugins don't have to read the configuration file or refresh tokens on their own.
+const (
+	ProfileEnvKey           = "ATLAS_CLI_PROFILE"
+	ProjectIDEnvKey         = "ATLAS_CLI_PROJECT_ID"
+	OrgIDEnvKey             = "ATLAS_CLI_ORG_ID"
+	OutputEnvKey            = "ATLAS_CLI_OUTPUT"
+	BaseURLEnvKey           = "ATLAS_CLI_BASE_URL"
+	AuthTypeEnvKey          = "ATLAS_CLI_AUTH_TYPE"
+	AccessTokenEnvKey       = "ATLAS_CLI_ACCESS_TOKEN"
+	AccessTokenExpiryEnvKey = "ATLAS_CLI_ACCESS_TOKEN_EXPIRY"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -typed -destination=plugin_context_mock_test.go -package=plugin -source=plugin_context.go
+
+type ContextConfig interface {
+	Name() string
+	ProjectID() string
+	OrgID() string
+	Output() string
+	Service() string
+	OpsManagerURL() string
+	AuthType() config.AuthMechanism
+	Token() (*atlasauth.Token, error)
+}
+
+// Context is the context of the host CLI that plugins receive.
+type Context struct {
+	Profile           string     `json:"profile"`
+	ProjectID         string     `json:"projectId,omitempty"`
+	OrgID             string     `json:"orgId,omitempty"`
+	Output            string     `json:"output,omitempty"`
+	BaseURL           string     `json:"baseUrl"`
+	AuthType          string     `json:"authType,omitempty"`
+	AccessToken       string     `json:"accessToken,omitempty"`
+	AccessTokenExpiry *time.Time `json:"accessTokenExpiry,omitempty"`
+}
+
+// NewContext returns the context of the given profile.
+// Only user and service accounts have an access token, plugins authenticated with API keys read the keys on their own.
+func NewContext(c ContextConfig) *Context {
+	pluginCtx := &Context{
+		Profile:   c.Name(),
+		ProjectID: cli.ContextProjectID(c.ProjectID),
+		OrgID:     cli.ContextOrgID(c.OrgID),
+		Output:    cli.ContextOutput(c.Output),
+		BaseURL:   baseURL(c),
+		AuthType:  string(c.AuthType()),
+	}
+
+	if c.AuthType() != config.UserAccount && c.AuthType() != config.ServiceAccount {
+		return pluginCtx
+	}
+
+	token, err := c.Token()
+	if err != nil {
+		_, _ = log.Debugf("No access token for the plugin context: %v\n", err)
+		return pluginCtx
+	}
+	if !token.Valid() {
+		return pluginCtx
+	}
+	pluginCtx.AccessToken = token.AccessToken
+	if !token.Expiry.IsZero() {
+		expiry := token.Expiry.UTC()
+		pluginCtx.AccessTokenExpiry = &expiry
+	}
+	return pluginCtx
+}
+
+func baseURL(c ContextConfig) string {
+	if configURL := c.OpsManagerURL(); configURL != "" {
+		return configURL
+	}
+	if c.Service() == config.CloudGovService {
+		return store.CloudGovServiceURL
+	}
+	return store.CloudServiceURL
+}
+
+// Environ returns the context as environment variables, skipping the empty values.
+func (c *Context) Environ() []string {
+	env := make([]string, 0)
+	add := func(key, value string) {
+		if value != "" {
+			env = append(env, key+"="+value)
+		}
+	}
+
+	add(ProfileEnvKey, c.Profile)
+	add(ProjectIDEnvKey, c.ProjectID)
+	add(OrgIDEnvKey, c.OrgID)
+	add(OutputEnvKey, c.Output)
+	add(BaseURLEnvKey, c.BaseURL)
+	add(AuthTypeEnvKey, c.AuthType)
+	add(AccessTokenEnvKey, c.AccessToken)
+	if c.AccessTokenExpiry != nil {
+		add(AccessTokenExpiryEnvKey, c.AccessTokenExpiry.Format(time.RFC3339))
+	}
+	return env
+}
+
+// RefreshedContext refreshes an expired access token of the current profile and returns its context.
+// Plugins don't always need to be authenticated, so a failed refresh only leaves the access token out.
+func RefreshedContext(ctx context.Context) *Context {
+	opts := &cli.RefresherOpts{}
+	if err := prerun.ExecuteE(opts.InitFlow(config.Default()), func() error { return opts.RefreshAccessToken(ctx) }); err != nil {
+		_, _ = log.Debugf("Unable to refresh the access token for the plugin context: %v\n", err)
+	}
+	return NewContext(config.Default())
+}
@@ -0,0 +1,347 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: plugin_context.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=plugin_context_mock_test.go -package=plugin -source=plugin_context.go
+//
+
+// Package plugin is a generated GoMock package.
+package plugin
+
+import (
+	reflect "reflect"
+
+	config "github.com/mongodb/atlas-cli-core/config"
+	auth "go.mongodb.org/atlas/auth"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContextConfig is a mock of ContextConfig interface.
+type MockContextConfig struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextConfigMockRecorder
+	isgomock struct{}
+}
+
+// MockContextConfigMockRecorder is the mock recorder for MockContextConfig.
+type MockContextConfigMockRecorder struct {
+	mock *MockContextConfig
+}
+
+// NewMockContextConfig creates a new mock instance.
+func NewMockContextConfig(ctrl *gomock.Controller) *MockContextConfig {
+	mock := &MockContextConfig{ctrl: ctrl}
+	mock.recorder = &MockContextConfigMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContextConfig) EXPECT() *MockContextConfigMockRecorder {
+	return m.recorder
+}
+
+// AuthType mocks base method.
+func (m *MockContextConfig) AuthType() config.AuthMechanism {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthType")
+	ret0, _ := ret[0].(config.AuthMechanism)
+	return ret0
+}
+
+// AuthType indicates an expected call of AuthType.
+func (mr *MockContextConfigMockRecorder) AuthType() *MockContextConfigAuthTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthType", reflect.TypeOf((*MockContextConfig)(nil).AuthType))
+	return &MockContextConfigAuthTypeCall{Call: call}
+}
+
+// MockContextConfigAuthTypeCall wrap *gomock.Call
+type MockContextConfigAuthTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigAuthTypeCall) Return(arg0 config.AuthMechanism) *MockContextConfigAuthTypeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigAuthTypeCall) Do(f func() config.AuthMechanism) *MockContextConfigAuthTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigAuthTypeCall) DoAndReturn(f func() config.AuthMechanism) *MockContextConfigAuthTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Name mocks base method.
+func (m *MockContextConfig) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockContextConfigMockRecorder) Name() *MockContextConfigNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockContextConfig)(nil).Name))
+	return &MockContextConfigNameCall{Call: call}
+}
+
+// MockContextConfigNameCall wrap *gomock.Call
+type MockContextConfigNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigNameCall) Return(arg0 string) *MockContextConfigNameCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigNameCall) Do(f func() string) *MockContextConfigNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigNameCall) DoAndReturn(f func() string) *MockContextConfigNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OpsManagerURL mocks base method.
+func (m *MockContextConfig) OpsManagerURL() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpsManagerURL")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OpsManagerURL indicates an expected call of OpsManagerURL.
+func (mr *MockContextConfigMockRecorder) OpsManagerURL() *MockContextConfigOpsManagerURLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpsManagerURL", reflect.TypeOf((*MockContextConfig)(nil).OpsManagerURL))
+	return &MockContextConfigOpsManagerURLCall{Call: call}
+}
+
+// MockContextConfigOpsManagerURLCall wrap *gomock.Call
+type MockContextConfigOpsManagerURLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigOpsManagerURLCall) Return(arg0 string) *MockContextConfigOpsManagerURLCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigOpsManagerURLCall) Do(f func() string) *MockContextConfigOpsManagerURLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigOpsManagerURLCall) DoAndReturn(f func() string) *MockContextConfigOpsManagerURLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrgID mocks base method.
+func (m *MockContextConfig) OrgID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OrgID indicates an expected call of OrgID.
+func (mr *MockContextConfigMockRecorder) OrgID() *MockContextConfigOrgIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgID", reflect.TypeOf((*MockContextConfig)(nil).OrgID))
+	return &MockContextConfigOrgIDCall{Call: call}
+}
+
+// MockContextConfigOrgIDCall wrap *gomock.Call
+type MockContextConfigOrgIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigOrgIDCall) Return(arg0 string) *MockContextConfigOrgIDCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigOrgIDCall) Do(f func() string) *MockContextConfigOrgIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigOrgIDCall) DoAndReturn(f func() string) *MockContextConfigOrgIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Output mocks base method.
+func (m *MockContextConfig) Output() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Output")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Output indicates an expected call of Output.
+func (mr *MockContextConfigMockRecorder) Output() *MockContextConfigOutputCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Output", reflect.TypeOf((*MockContextConfig)(nil).Output))
+	return &MockContextConfigOutputCall{Call: call}
+}
+
+// MockContextConfigOutputCall wrap *gomock.Call
+type MockContextConfigOutputCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigOutputCall) Return(arg0 string) *MockContextConfigOutputCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigOutputCall) Do(f func() string) *MockContextConfigOutputCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigOutputCall) DoAndReturn(f func() string) *MockContextConfigOutputCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProjectID mocks base method.
+func (m *MockContextConfig) ProjectID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProjectID indicates an expected call of ProjectID.
+func (mr *MockContextConfigMockRecorder) ProjectID() *MockContextConfigProjectIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectID", reflect.TypeOf((*MockContextConfig)(nil).ProjectID))
+	return &MockContextConfigProjectIDCall{Call: call}
+}
+
+// MockContextConfigProjectIDCall wrap *gomock.Call
+type MockContextConfigProjectIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigProjectIDCall) Return(arg0 string) *MockContextConfigProjectIDCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigProjectIDCall) Do(f func() string) *MockContextConfigProjectIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigProjectIDCall) DoAndReturn(f func() string) *MockContextConfigProjectIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Service mocks base method.
+func (m *MockContextConfig) Service() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockContextConfigMockRecorder) Service() *MockContextConfigServiceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockContextConfig)(nil).Service))
+	return &MockContextConfigServiceCall{Call: call}
+}
+
+// MockContextConfigServiceCall wrap *gomock.Call
+type MockContextConfigServiceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigServiceCall) Return(arg0 string) *MockContextConfigServiceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigServiceCall) Do(f func() string) *MockContextConfigServiceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigServiceCall) DoAndReturn(f func() string) *MockContextConfigServiceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Token mocks base method.
+func (m *MockContextConfig) Token() (*auth.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(*auth.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockContextConfigMockRecorder) Token() *MockContextConfigTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockContextConfig)(nil).Token))
+	return &MockContextConfigTokenCall{Call: call}
+}
+
+// MockContextConfigTokenCall wrap *gomock.Call
+type MockContextConfigTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextConfigTokenCall) Return(arg0 *auth.Token, arg1 error) *MockContextConfigTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextConfigTokenCall) Do(f func() (*auth.Token, error)) *MockContextConfigTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextConfigTokenCall) DoAndReturn(f func() (*auth.Token, error)) *MockContextConfigTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mongodb/atlas-cli-core/config"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dirconfig"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasauth "go.mongodb.org/atlas/auth"
+	"go.uber.org/mock/gomock"
+)
+
+func mockContextConfig(ctrl *gomock.Controller, authType config.AuthMechanism) *MockContextConfig {
+	c := NewMockContextConfig(ctrl)
+	c.EXPECT().Name().Return("default").AnyTimes()
+	c.EXPECT().ProjectID().Return("5e2211c17a3e5a48f5497de3").AnyTimes()
+	c.EXPECT().OrgID().Return("5e2211c17a3e5a48f5497de4").AnyTimes()
+	c.EXPECT().Output().Return("json").AnyTimes()
+	c.EXPECT().OpsManagerURL().Return("").AnyTimes()
+	c.EXPECT().Service().Return(config.CloudService).AnyTimes()
+	c.EXPECT().AuthType().Return(authType).AnyTimes()
+	return c
+}
+
+func TestNewContext(t *testing.T) {
+	t.Run("user account", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		c := mockContextConfig(ctrl, config.UserAccount)
+		expiry := time.Now().Add(time.Hour)
+		c.EXPECT().Token().Return(&atlasauth.Token{AccessToken: "token", Expiry: expiry}, nil).Times(1)
+
+		got := NewContext(c)
+		assert.Equal(t, "default", got.Profile)
+		assert.Equal(t, "5e2211c17a3e5a48f5497de3", got.ProjectID)
+		assert.Equal(t, "5e2211c17a3e5a48f5497de4", got.OrgID)
+		assert.Equal(t, "json", got.Output)
+		assert.Equal(t, store.CloudServiceURL, got.BaseURL)
+		assert.Equal(t, "token", got.AccessToken)
+		assert.True(t, expiry.Equal(*got.AccessTokenExpiry))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		c := mockContextConfig(ctrl, config.UserAccount)
+		c.EXPECT().Token().Return(&atlasauth.Token{AccessToken: "token", Expiry: time.Now().Add(-time.Hour)}, nil).Times(1)
+
+		got := NewContext(c)
+		assert.Empty(t, got.AccessToken)
+		assert.Nil(t, got.AccessTokenExpiry)
+	})
+
+	t.Run("token error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		c := mockContextConfig(ctrl, config.ServiceAccount)
+		c.EXPECT().Token().Return(nil, errors.New("unauthorized")).Times(1)
+
+		got := NewContext(c)
+		assert.Empty(t, got.AccessToken)
+	})
+
+	t.Run("api keys", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		c := mockContextConfig(ctrl, config.APIKeys)
+		c.EXPECT().Token().Times(0)
+
+		got := NewContext(c)
+		assert.Empty(t, got.AccessToken)
+	})
+
+	t.Run("directory context file", func(t *testing.T) {
+		dir := t.TempDir()
+		content := "project_id = \"5e2211c17a3e5a48f5497de5\"\noutput = \"yaml\"\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, dirconfig.FileName), []byte(content), 0o600))
+		t.Cleanup(func() { _ = dirconfig.Load() })
+		t.Chdir(dir)
+		require.NoError(t, dirconfig.Load())
+
+		ctrl := gomock.NewController(t)
+		c := mockContextConfig(ctrl, config.APIKeys)
+
+		got := NewContext(c)
+		assert.Equal(t, "5e2211c17a3e5a48f5497de5", got.ProjectID)
+		assert.Equal(t, "5e2211c17a3e5a48f5497de4", got.OrgID)
+		assert.Equal(t, "yaml", got.Output)
+	})
+}
+
+func TestBaseURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	c := NewMockContextConfig(ctrl)
+	c.EXPECT().OpsManagerURL().Return("https://cloud-dev.mongodb.com/").Times(1)
+	assert.Equal(t, "https://cloud-dev.mongodb.com/", baseURL(c))
+
+	c = NewMockContextConfig(ctrl)
+	c.EXPECT().OpsManagerURL().Return("").Times(1)
+	c.EXPECT().Service().Return(config.CloudGovService).Times(1)
+	assert.Equal(t, store.CloudGovServiceURL, baseURL(c))
+}
+
+func TestContext_Environ(t *testing.T) {
+	expiry := time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC)
+	c := &Context{
+		Profile:           "default",
+		ProjectID:         "5e2211c17a3e5a48f5497de3",
+		BaseURL:           store.CloudServiceURL,
+		AuthType:          "user_account",
+		AccessToken:       "token",
+		AccessTokenExpiry: &expiry,
+	}
+
+	assert.Equal(t, []string{
+		"ATLAS_CLI_PROFILE=default",
+		"ATLAS_CLI_PROJECT_ID=5e2211c17a3e5a48f5497de3",
+		"ATLAS_CLI_BASE_URL=https://cloud.mongodb.com/",
+		"ATLAS_CLI_AUTH_TYPE=user_account",
+		"ATLAS_CLI_ACCESS_TOKEN=token",
+		"ATLAS_CLI_ACCESS_TOKEN_EXPIRY=2026-01-10T10:00:00Z",
+	}, c.Environ())
+}
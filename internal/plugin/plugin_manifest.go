@@ -23,7 +23,9 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/flag"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/set"
+	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v3"
 )
 
@@ -31,14 +33,55 @@ const (
 	ExtraPluginDirectoryEnvKey = "ATLAS_CLI_EXTRA_PLUGIN_DIRECTORY"
 )
 
+// hostFlags are the persistent flags of the AtlasCLI and their shorthands, cobra adds them to every plugin command
+// and pflag panics when a plugin command declares a flag with the same name or shorthand.
+var hostFlags = map[string]string{
+	flag.Profile:         flag.ProfileShort,
+	flag.Debug:           flag.DebugShort,
+	flag.MaxRetries:      "",
+	flag.RetryMaxWait:    "",
+	flag.RetryAllMethods: "",
+	flag.DryRun:          "",
+}
+
+func isHostFlag(name, shorthand string) bool {
+	for hostName, hostShorthand := range hostFlags {
+		if name == hostName || (shorthand != "" && shorthand == hostShorthand) {
+			return true
+		}
+	}
+	return false
+}
+
 type ManifestGithubValues struct {
 	Owner string `yaml:"owner,omitempty"`
 	Name  string `yaml:"name,omitempty"`
 }
 
 type ManifestCommand struct {
-	Aliases     []string `yaml:"aliases,omitempty"`
-	Description string   `yaml:"description,omitempty"`
+	Aliases     []string                   `yaml:"aliases,omitempty"`
+	Description string                     `yaml:"description,omitempty"`
+	Flags       map[string]ManifestFlag    `yaml:"flags,omitempty"`
+	Subcommands map[string]ManifestCommand `yaml:"subcommands,omitempty"`
+	Completion  *ManifestCompletion        `yaml:"completion,omitempty"`
+}
+
+// ManifestFlag declares a flag of a plugin command, so that the AtlasCLI can
+// complete it, document it and validate it before running the plugin.
+type ManifestFlag struct {
+	Type        string              `yaml:"type,omitempty"`
+	Shorthand   string              `yaml:"shorthand,omitempty"`
+	Description string              `yaml:"description,omitempty"`
+	Required    bool                `yaml:"required,omitempty"`
+	Default     string              `yaml:"default,omitempty"`
+	Completion  *ManifestCompletion `yaml:"completion,omitempty"`
+}
+
+// ManifestCompletion is a hint of the shell completion of a flag value or of the arguments of a command.
+type ManifestCompletion struct {
+	Values         []string `yaml:"values,omitempty"`
+	FileExtensions []string `yaml:"fileExtensions,omitempty"`
+	Directories    bool     `yaml:"directories,omitempty"`
 }
 
 type Manifest struct {
@@ -86,9 +129,7 @@ func (m *Manifest) IsValid() (bool, []error) {
 		errorsList = append(errorsList, errors.New("the plugin needs to contain at least one command"))
 	default:
 		for command, value := range m.Commands {
-			if value.Description == "" {
-				errorsList = append(errorsList, fmt.Errorf(`value "description" in command "%s" is not defined`, command))
-			}
+			errorsList = append(errorsList, value.validate(command)...)
 		}
 	}
 
@@ -98,6 +139,38 @@ func (m *Manifest) IsValid() (bool, []error) {
 	return true, nil
 }
 
+// validate validates the command, its flags and its subcommands, path is the path of the command like "kubernetes config".
+func (c *ManifestCommand) validate(path string) []error {
+	var errorsList []error
+
+	if c.Description == "" {
+		errorsList = append(errorsList, fmt.Errorf(`value "description" in command "%s" is not defined`, path))
+	}
+
+	for name, f := range c.Flags {
+		if f.Description == "" {
+			errorsList = append(errorsList, fmt.Errorf(`value "description" in flag "%s" of command "%s" is not defined`, name, path))
+		}
+		if len(f.Shorthand) > 1 {
+			errorsList = append(errorsList, fmt.Errorf(`value "shorthand" in flag "%s" of command "%s" must be a single character`, name, path))
+			continue
+		}
+		if isHostFlag(name, f.Shorthand) {
+			errorsList = append(errorsList, fmt.Errorf(`flag "%s" of command "%s" conflicts with a flag of the AtlasCLI`, name, path))
+			continue
+		}
+		if err := addFlag(pflag.NewFlagSet(path, pflag.ContinueOnError), newFlag(name, f)); err != nil {
+			errorsList = append(errorsList, fmt.Errorf(`flag "%s" of command "%s": %w`, name, path, err))
+		}
+	}
+
+	for name, subcommand := range c.Subcommands {
+		errorsList = append(errorsList, subcommand.validate(path+" "+name)...)
+	}
+
+	return errorsList
+}
+
 func loadManifestsFromPluginDirectories() []*Manifest {
 	var manifests []*Manifest
 
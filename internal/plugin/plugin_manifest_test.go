@@ -147,6 +147,79 @@ func TestManifest_IsValid(t *testing.T) {
 			wantValid:    false,
 			wantErrCount: 2,
 		},
+		{
+			name: "Valid manifest with flags and subcommands",
+			manifest: Manifest{
+				Name:        "Kubernetes",
+				Description: "Kubernetes plugin",
+				Binary:      "kubernetes",
+				Version:     "1.2.3",
+				Commands: map[string]ManifestCommand{
+					"kubernetes": {
+						Description: "the kubernetes command",
+						Flags: map[string]ManifestFlag{
+							"projectId": {Description: "the project", Required: true},
+						},
+						Subcommands: map[string]ManifestCommand{
+							"config": {
+								Description: "the config command",
+								Flags: map[string]ManifestFlag{
+									"timeout": {Type: "duration", Shorthand: "t", Description: "the timeout", Default: "10s"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantValid:    true,
+			wantErrCount: 0,
+		},
+		{
+			name: "Invalid flags and subcommand without description",
+			manifest: Manifest{
+				Name:        "Kubernetes",
+				Description: "Kubernetes plugin",
+				Binary:      "kubernetes",
+				Version:     "1.2.3",
+				Commands: map[string]ManifestCommand{
+					"kubernetes": {
+						Description: "the kubernetes command",
+						Flags: map[string]ManifestFlag{
+							"projectId": {Type: "uuid", Description: "the project"},
+							"watch":     {Type: "bool", Shorthand: "wa"},
+							"count":     {Type: "int", Description: "the count", Default: "many"},
+						},
+						Subcommands: map[string]ManifestCommand{
+							"config": {},
+						},
+					},
+				},
+			},
+			wantValid:    false,
+			wantErrCount: 5,
+		},
+		{
+			name: "Flags that conflict with the AtlasCLI flags",
+			manifest: Manifest{
+				Name:        "Kubernetes",
+				Description: "Kubernetes plugin",
+				Binary:      "kubernetes",
+				Version:     "1.2.3",
+				Commands: map[string]ManifestCommand{
+					"kubernetes": {
+						Description: "the kubernetes command",
+						Flags: map[string]ManifestFlag{
+							"profile": {Description: "the profile"},
+							"plan":    {Shorthand: "P", Description: "the plan"},
+							"dryRun":  {Type: "bool", Description: "only print the changes"},
+							"output":  {Shorthand: "o", Description: "the output format"},
+						},
+					},
+				},
+			},
+			wantValid:    false,
+			wantErrCount: 3,
+		},
 	}
 
 	for _, tt := range tests {
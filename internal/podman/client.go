@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -41,10 +42,12 @@ type RunContainerOpts struct {
 	Name              string
 	Hostname          string
 	Volumes           map[string]string
+	VolumesFrom       string
 	Ports             map[int]int
 	BindIPAll         bool
 	Network           string
 	EnvVars           map[string]string
+	Labels            map[string]string
 	Args              []string
 	Entrypoint        string
 	Cmd               string
@@ -68,6 +71,11 @@ type Container struct {
 	Labels map[string]string `json:"Labels"`
 }
 
+type Volume struct {
+	Name   string            `json:"Name"`
+	Labels map[string]string `json:"Labels"`
+}
+
 type Image struct {
 	ID         string `json:"ID"`
 	Repository string `json:"repository"`
@@ -88,6 +96,11 @@ type Client interface {
 	StartContainers(ctx context.Context, names ...string) ([]byte, error)
 	UnpauseContainers(ctx context.Context, names ...string) ([]byte, error)
 	RemoveContainers(ctx context.Context, names ...string) ([]byte, error)
+	CreateNetwork(ctx context.Context, name string) ([]byte, error)
+	RemoveNetworks(ctx context.Context, names ...string) ([]byte, error)
+	CreateVolume(ctx context.Context, name string, labels map[string]string) ([]byte, error)
+	ListVolumes(ctx context.Context, labels ...string) ([]*Volume, error)
+	RemoveVolumes(ctx context.Context, names ...string) ([]byte, error)
 	ListContainers(ctx context.Context, nameFilter string) ([]*Container, error)
 	ListImages(ctx context.Context, nameFilter string) ([]*Image, error)
 	PullImage(ctx context.Context, name string) ([]byte, error)
@@ -95,6 +108,7 @@ type Client interface {
 	ContainerHealthStatus(ctx context.Context, name string) (string, error)
 	Logs(ctx context.Context) (map[string]any, []error)
 	ContainerLogs(ctx context.Context, name string) ([]string, error)
+	ContainerLogsFollow(ctx context.Context, name string) (io.ReadCloser, error)
 	ContainerStatusAndUptime(ctx context.Context, name string) (string, time.Duration, error)
 }
 
@@ -196,6 +210,10 @@ func (o *client) RunContainer(ctx context.Context, opts RunContainerOpts) ([]byt
 		arg = append(arg, "-v", hostVolume+":"+pathInContainer)
 	}
 
+	if opts.VolumesFrom != "" {
+		arg = append(arg, "--volumes-from", opts.VolumesFrom)
+	}
+
 	for hostPort, containerPort := range opts.Ports {
 		portMapping := ""
 		if hostPort != 0 {
@@ -212,6 +230,10 @@ func (o *client) RunContainer(ctx context.Context, opts RunContainerOpts) ([]byt
 		arg = append(arg, "-e", envVar+"="+value)
 	}
 
+	for label, value := range opts.Labels {
+		arg = append(arg, "--label", label+"="+value)
+	}
+
 	if opts.IP != "" {
 		arg = append(arg, "--ip", opts.IP)
 	}
@@ -293,6 +315,42 @@ func (o *client) RemoveContainers(ctx context.Context, names ...string) ([]byte,
 	return o.runPodman(ctx, append([]string{"rm", "-f", "-v"}, names...)...)
 }
 
+func (o *client) CreateNetwork(ctx context.Context, name string) ([]byte, error) {
+	return o.runPodman(ctx, "network", "create", name)
+}
+
+func (o *client) RemoveNetworks(ctx context.Context, names ...string) ([]byte, error) {
+	return o.runPodman(ctx, append([]string{"network", "rm", "-f"}, names...)...)
+}
+
+func (o *client) CreateVolume(ctx context.Context, name string, labels map[string]string) ([]byte, error) {
+	args := []string{"volume", "create"}
+	for label, value := range labels {
+		args = append(args, "--label", label+"="+value)
+	}
+	return o.runPodman(ctx, append(args, name)...)
+}
+
+func (o *client) ListVolumes(ctx context.Context, labels ...string) ([]*Volume, error) {
+	args := []string{"volume", "ls", "--format", "json"}
+	for _, label := range labels {
+		args = append(args, "--filter", "label="+label)
+	}
+
+	response, err := o.runPodman(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []*Volume
+	err = json.Unmarshal(response, &volumes)
+	return volumes, err
+}
+
+func (o *client) RemoveVolumes(ctx context.Context, names ...string) ([]byte, error) {
+	return o.runPodman(ctx, append([]string{"volume", "rm"}, names...)...)
+}
+
 func (o *client) ListContainers(ctx context.Context, label string) ([]*Container, error) {
 	args := []string{"ps", "--all", "--format", "json"}
 	if label != "" {
@@ -409,6 +467,33 @@ func (o *client) ContainerLogs(ctx context.Context, name string) ([]string, erro
 	return logs, nil
 }
 
+// followReader reads the output of podman container logs --follow, and stops it when closed.
+type followReader struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+}
+
+func (r *followReader) Close() error {
+	_ = r.cmd.Process.Kill()
+	_ = r.cmd.Wait()
+	return nil
+}
+
+func (*client) ContainerLogsFollow(ctx context.Context, name string) (io.ReadCloser, error) {
+	arg := []string{"container", "logs", "--follow", name}
+	_, _ = log.Debug(fmt.Sprintln(append([]string{"podman"}, arg...)))
+
+	cmd := exec.CommandContext(ctx, "podman", arg...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &followReader{ReadCloser: stdout, cmd: cmd}, nil
+}
+
 func (o *client) ContainerStatusAndUptime(ctx context.Context, name string) (string, time.Duration, error) {
 	output, err := o.runPodman(ctx, "inspect", "--format", "[\"{{.State.Status}}\",\"{{.State.StartedAt}}\"]", name)
 	if err != nil {
@@ -19,6 +19,8 @@ package podman
 // InspectContainerConfig holds further data about how a container was initially
 // configured.
 type InspectContainerConfig struct {
+	// Container hostname
+	Hostname string `json:"Hostname"`
 	// Container labels
 	Labels map[string]string `json:"Labels"`
 }
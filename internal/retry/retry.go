@@ -0,0 +1,120 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry provides an http.RoundTripper that retries rate limited and transient server errors.
+package retry
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	MaxRetriesProperty      = "max_retries"
+	RetryMaxWaitProperty    = "retry_max_wait"
+	RetryAllMethodsProperty = "retry_all_methods"
+
+	DefaultMaxRetries = 3
+	DefaultMaxWait    = 30 * time.Second
+	DefaultBaseDelay  = 500 * time.Millisecond
+)
+
+// Config controls how requests are retried.
+type Config struct {
+	// MaxRetries is the number of retries after the first attempt, 0 disables retries.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry, doubled on every attempt.
+	BaseDelay time.Duration
+	// MaxWait caps the delay between two attempts. Responses asking to wait longer with Retry-After are not retried.
+	MaxWait time.Duration
+	// AllMethods retries non idempotent requests like POST and PATCH.
+	AllMethods bool
+}
+
+var defaultConfig = Config{
+	MaxRetries: DefaultMaxRetries,
+	BaseDelay:  DefaultBaseDelay,
+	MaxWait:    DefaultMaxWait,
+}
+
+// Default returns the configuration used by the store and the api executor.
+func Default() Config {
+	return defaultConfig
+}
+
+// SetDefault sets the configuration used by the store and the api executor.
+func SetDefault(c Config) {
+	defaultConfig = c
+}
+
+// Properties returns the profile properties used to configure retries.
+func Properties() []string {
+	return []string{
+		MaxRetriesProperty,
+		RetryMaxWaitProperty,
+		RetryAllMethodsProperty,
+	}
+}
+
+// DefaultConfig returns the configuration used when the profile doesn't set the retry properties.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries: DefaultMaxRetries,
+		BaseDelay:  DefaultBaseDelay,
+		MaxWait:    DefaultMaxWait,
+	}
+}
+
+// ValidateProperty returns an error when value can't be used for the retry property.
+func ValidateProperty(property, value string) error {
+	_, err := ProfileConfig(func(name string) string {
+		if name == property {
+			return value
+		}
+		return ""
+	})
+	return err
+}
+
+// ProfileConfig reads the retry properties of a profile, unset properties use the default values.
+func ProfileConfig(get func(string) string) (Config, error) {
+	c := DefaultConfig()
+
+	if value := get(MaxRetriesProperty); value != "" {
+		maxRetries, err := strconv.Atoi(value)
+		if err != nil || maxRetries < 0 {
+			return c, fmt.Errorf("invalid %s: %q, expected a positive number", MaxRetriesProperty, value)
+		}
+		c.MaxRetries = maxRetries
+	}
+
+	if value := get(RetryMaxWaitProperty); value != "" {
+		maxWait, err := time.ParseDuration(value)
+		if err != nil || maxWait <= 0 {
+			return c, fmt.Errorf("invalid %s: %q, expected a duration like 30s", RetryMaxWaitProperty, value)
+		}
+		c.MaxWait = maxWait
+	}
+
+	if value := get(RetryAllMethodsProperty); value != "" {
+		allMethods, err := strconv.ParseBool(value)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s: %q, expected true or false", RetryAllMethodsProperty, value)
+		}
+		c.AllMethods = allMethods
+	}
+
+	return c, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		c, err := ProfileConfig(func(string) string { return "" })
+		require.NoError(t, err)
+		assert.Equal(t, Config{MaxRetries: DefaultMaxRetries, BaseDelay: DefaultBaseDelay, MaxWait: DefaultMaxWait}, c)
+	})
+
+	t.Run("profile values", func(t *testing.T) {
+		properties := map[string]string{
+			MaxRetriesProperty:      "5",
+			RetryMaxWaitProperty:    "1m",
+			RetryAllMethodsProperty: "true",
+		}
+		c, err := ProfileConfig(func(name string) string { return properties[name] })
+		require.NoError(t, err)
+		assert.Equal(t, Config{MaxRetries: 5, BaseDelay: DefaultBaseDelay, MaxWait: time.Minute, AllMethods: true}, c)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		for _, property := range Properties() {
+			_, err := ProfileConfig(func(name string) string {
+				if name == property {
+					return "invalid"
+				}
+				return ""
+			})
+			require.Error(t, err, property)
+		}
+	})
+}
+
+func TestValidateProperty(t *testing.T) {
+	require.NoError(t, ValidateProperty(MaxRetriesProperty, "0"))
+	require.NoError(t, ValidateProperty(RetryMaxWaitProperty, "1m"))
+	require.NoError(t, ValidateProperty(RetryAllMethodsProperty, "false"))
+
+	require.Error(t, ValidateProperty(MaxRetriesProperty, "abc"))
+	require.Error(t, ValidateProperty(MaxRetriesProperty, "-1"))
+	require.Error(t, ValidateProperty(RetryMaxWaitProperty, "30"))
+	require.Error(t, ValidateProperty(RetryAllMethodsProperty, "maybe"))
+}
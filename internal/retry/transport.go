@@ -0,0 +1,192 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"bytes"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+)
+
+var (
+	retryableStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+	idempotentMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete,
+	}
+)
+
+// Transport retries requests that fail with 429 Too Many Requests, 502, 503 or 504.
+// The Retry-After header is honored, otherwise attempts are spaced with a jittered exponential backoff.
+type Transport struct {
+	base   http.RoundTripper
+	config Config
+	// sleep waits for the given duration or until the request is cancelled, replaced in tests.
+	sleep func(*http.Request, time.Duration) error
+	now   func() time.Time
+}
+
+// NewTransport wraps base, http.DefaultTransport is used when base is nil.
+func NewTransport(base http.RoundTripper, c Config) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		base:   base,
+		config: c,
+		sleep:  sleep,
+		now:    time.Now,
+	}
+}
+
+// WrapClient makes the client retry requests using the default configuration.
+func WrapClient(client *http.Client) *http.Client {
+	if client != nil {
+		client.Transport = NewTransport(client.Transport, Default())
+	}
+	return client
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.config.MaxRetries <= 0 || !t.canRetry(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	getBody, err := bodyGetter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if getBody != nil {
+			// every attempt sends a fresh copy of the body, the request passed in must not be modified
+			r = req.Clone(req.Context())
+			if r.Body, err = getBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if err != nil || attempt >= t.config.MaxRetries || !slices.Contains(retryableStatusCodes, resp.StatusCode) {
+			return resp, err
+		}
+
+		wait, ok := t.delay(resp, attempt)
+		if !ok {
+			_, _ = log.Debugf("not retrying %s %s: Retry-After %q exceeds the maximum wait of %s\n",
+				req.Method, req.URL, resp.Header.Get("Retry-After"), t.config.MaxWait)
+			return resp, nil
+		}
+
+		_, _ = log.Debugf("%s %s returned %s, retrying in %s (retry %d of %d)\n",
+			req.Method, req.URL, resp.Status, wait.Round(time.Millisecond), attempt+1, t.config.MaxRetries)
+
+		// the body must be consumed for the connection to be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if err := t.sleep(req, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *Transport) canRetry(req *http.Request) bool {
+	return t.config.AllMethods || slices.Contains(idempotentMethods, req.Method)
+}
+
+// delay returns how long to wait before the next attempt, false if Retry-After asks to wait longer than MaxWait.
+func (t *Transport) delay(resp *http.Response, attempt int) (time.Duration, bool) {
+	if wait, ok := t.retryAfter(resp.Header.Get("Retry-After")); ok {
+		return wait, wait <= t.config.MaxWait
+	}
+
+	backoff := t.config.BaseDelay << attempt
+	if backoff <= 0 || backoff > t.config.MaxWait {
+		backoff = t.config.MaxWait
+	}
+
+	// equal jitter, wait at least half of the backoff so attempts stay spaced out
+	half := backoff / 2 //nolint:mnd // half of the backoff
+
+	return half + rand.N(half+1), true //nolint:gosec // jitter doesn't need a secure random number
+}
+
+// retryAfter parses a Retry-After header, it's either a number of seconds or an HTTP date.
+func (t *Transport) retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(t.now()), 0), true
+	}
+
+	return 0, false
+}
+
+// bodyGetter returns a function that returns a new copy of the request body, the body is buffered if needed.
+func bodyGetter(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		_ = req.Body.Close()
+		return req.GetBody, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}, nil
+}
+
+func sleep(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTransport returns a transport that records the waits instead of sleeping.
+func testTransport(c Config) (*Transport, *[]time.Duration) {
+	var waits []time.Duration
+	t := NewTransport(nil, c)
+	t.sleep = func(_ *http.Request, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return t, &waits
+}
+
+// statusServer replies with the given status codes in order, then 200.
+func statusServer(t *testing.T, headers http.Header, statuses ...int) (*httptest.Server, *[]string) {
+	t.Helper()
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		status := http.StatusOK
+		if len(bodies) <= len(statuses) {
+			status = statuses[len(bodies)-1]
+			for k, v := range headers {
+				w.Header()[k] = v
+			}
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies
+}
+
+func TestTransport_RetriesTransientErrors(t *testing.T) {
+	server, bodies := statusServer(t, nil, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable)
+	transport, waits := testTransport(Config{MaxRetries: 3, BaseDelay: time.Second, MaxWait: 30 * time.Second})
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("body"))
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"body", "body", "body", "body"}, *bodies)
+	require.Len(t, *waits, 3)
+	for i, wait := range *waits {
+		backoff := time.Second << i
+		assert.GreaterOrEqual(t, wait, backoff/2)
+		assert.LessOrEqual(t, wait, backoff)
+	}
+}
+
+func TestTransport_GivesUp(t *testing.T) {
+	server, bodies := statusServer(t, nil, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout)
+	transport, _ := testTransport(Config{MaxRetries: 2, BaseDelay: time.Second, MaxWait: time.Second})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+	assert.Len(t, *bodies, 3)
+}
+
+func TestTransport_DoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		config Config
+	}{
+		{
+			name:   "non idempotent method",
+			method: http.MethodPost,
+			status: http.StatusServiceUnavailable,
+			config: Config{MaxRetries: 3, MaxWait: time.Second},
+		},
+		{
+			name:   "client error",
+			method: http.MethodGet,
+			status: http.StatusBadRequest,
+			config: Config{MaxRetries: 3, MaxWait: time.Second},
+		},
+		{
+			name:   "internal server error",
+			method: http.MethodGet,
+			status: http.StatusInternalServerError,
+			config: Config{MaxRetries: 3, MaxWait: time.Second},
+		},
+		{
+			name:   "disabled",
+			method: http.MethodGet,
+			status: http.StatusServiceUnavailable,
+			config: Config{MaxRetries: 0, MaxWait: time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, bodies := statusServer(t, nil, tt.status)
+			transport, _ := testTransport(tt.config)
+
+			req, err := http.NewRequest(tt.method, server.URL, nil)
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Len(t, *bodies, 1)
+		})
+	}
+}
+
+func TestTransport_AllMethods(t *testing.T) {
+	server, bodies := statusServer(t, nil, http.StatusTooManyRequests)
+	transport, _ := testTransport(Config{MaxRetries: 1, MaxWait: time.Second, AllMethods: true})
+
+	// the body of this request can't be read twice, it's buffered by the transport
+	req, err := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(strings.NewReader("body")))
+	require.NoError(t, err)
+	require.Nil(t, req.GetBody)
+
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"body", "body"}, *bodies)
+}
+
+func TestTransport_RetryAfter(t *testing.T) {
+	t.Run("seconds", func(t *testing.T) {
+		server, _ := statusServer(t, http.Header{"Retry-After": {"2"}}, http.StatusTooManyRequests)
+		transport, waits := testTransport(Config{MaxRetries: 1, BaseDelay: time.Millisecond, MaxWait: time.Minute})
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []time.Duration{2 * time.Second}, *waits)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		retryAt := now.Add(5 * time.Second).Format(http.TimeFormat)
+		server, _ := statusServer(t, http.Header{"Retry-After": {retryAt}}, http.StatusServiceUnavailable)
+		transport, waits := testTransport(Config{MaxRetries: 1, BaseDelay: time.Millisecond, MaxWait: time.Minute})
+		transport.now = func() time.Time { return now }
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, []time.Duration{5 * time.Second}, *waits)
+	})
+
+	t.Run("exceeds max wait", func(t *testing.T) {
+		server, bodies := statusServer(t, http.Header{"Retry-After": {"120"}}, http.StatusTooManyRequests)
+		transport, waits := testTransport(Config{MaxRetries: 3, MaxWait: time.Minute})
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Len(t, *bodies, 1)
+		assert.Empty(t, *waits)
+	})
+}
+
+func TestTransport_Cancelled(t *testing.T) {
+	server, bodies := statusServer(t, nil, http.StatusServiceUnavailable)
+	transport := NewTransport(nil, Config{MaxRetries: 3, BaseDelay: time.Hour, MaxWait: time.Hour})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	transport.base = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		defer cancel()
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	_, err = transport.RoundTrip(req) //nolint:bodyclose // no response is returned
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, *bodies, 1)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
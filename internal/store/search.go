@@ -47,3 +47,9 @@ func (s *Store) DeleteSearchIndex(projectID, clusterName, indexID string) error
 	_, err := s.clientv2.AtlasSearchAPI.DeleteClusterSearchIndex(s.ctx, projectID, clusterName, indexID).Execute()
 	return err
 }
+
+// ClusterSearchIndexes encapsulate the logic to manage different cloud providers.
+func (s *Store) ClusterSearchIndexes(projectID, clusterName string) ([]atlasv2.SearchIndexResponse, error) {
+	result, _, err := s.clientv2.AtlasSearchAPI.ListClusterSearchIndexes(s.ctx, projectID, clusterName).Execute()
+	return result, err
+}
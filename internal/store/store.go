@@ -25,7 +25,11 @@ import (
 
 	"github.com/mongodb/atlas-cli-core/config"
 	"github.com/mongodb/atlas-cli-core/transport"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/audit"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/log"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/retry"
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/version"
 	atlasClustersPinned "go.mongodb.org/atlas-sdk/v20240530005/admin"
 	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
@@ -122,6 +126,10 @@ func WithAuthentication() Option {
 		if err != nil {
 			return err
 		}
+		// telemetry is best effort and must not delay the command
+		if !s.telemetry {
+			client = dryrun.WrapClient(audit.WrapClient(retry.WrapClient(tracing.WrapClient(client))))
+		}
 		s.httpClient = client
 		return nil
 	}
@@ -0,0 +1,216 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tabularwriter prints any JSON serializable value as rows and columns.
+package tabularwriter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/PaesslerAG/jsonpath"
+)
+
+const (
+	CSV   = "csv"
+	TSV   = "tsv"
+	Table = "table"
+
+	tabwriterMinWidth = 6
+	tabwriterWidth    = 4
+	tabwriterPadding  = 3
+	tabwriterPadChar  = ' '
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported tabular format")
+	ErrInvalidColumn     = errors.New("invalid column")
+)
+
+// Formats returns the supported formats.
+func Formats() []string {
+	return []string{CSV, TSV, Table}
+}
+
+// Print writes obj as a table with a header row.
+// Lists, and the results of paginated responses, are printed with one row per element, anything else as a single row.
+// Columns are JSON paths relative to a row, like name or $.replicationSpecs[0].zoneName,
+// when no columns are given every top level field is printed.
+func Print(w io.Writer, format string, columns []string, obj any) error {
+	if !slices.Contains(Formats(), format) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	rows, err := toRows(obj)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) == 0 {
+		columns = defaultColumns(rows)
+	}
+
+	paths := make([]string, len(columns))
+	for i, column := range columns {
+		if paths[i], err = path(column); err != nil {
+			return err
+		}
+	}
+
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, columns)
+	for _, row := range rows {
+		record := make([]string, len(paths))
+		for i, p := range paths {
+			record[i] = cell(p, row)
+		}
+		records = append(records, record)
+	}
+
+	switch format {
+	case Table:
+		return printTable(w, records)
+	case TSV:
+		return printDelimited(w, '\t', records)
+	default:
+		return printDelimited(w, ',', records)
+	}
+}
+
+// toRows converts obj to its JSON representation, numbers are kept as json.Number so they are printed as is.
+func toRows(obj any) ([]any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var val any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&val); err != nil {
+		return nil, err
+	}
+
+	switch v := val.(type) {
+	case nil:
+		return []any{}, nil
+	case []any:
+		return v, nil
+	case map[string]any:
+		if results, ok := v["results"].([]any); ok {
+			return results, nil
+		}
+	}
+
+	return []any{val}, nil
+}
+
+// defaultColumns returns the top level fields of all rows sorted by name, or the row itself for lists of values.
+func defaultColumns(rows []any) []string {
+	var columns []string
+	for _, row := range rows {
+		fields, ok := row.(map[string]any)
+		if !ok {
+			return []string{"$"}
+		}
+		for field := range fields {
+			if !slices.Contains(columns, field) {
+				columns = append(columns, field)
+			}
+		}
+	}
+
+	slices.Sort(columns)
+	return columns
+}
+
+func path(column string) (string, error) {
+	p := strings.TrimSpace(column)
+	if p == "" {
+		return "", fmt.Errorf("%w: empty path", ErrInvalidColumn)
+	}
+
+	if !strings.HasPrefix(p, "$") {
+		if !strings.HasPrefix(p, "[") {
+			p = "." + p
+		}
+		p = "$" + p
+	}
+
+	if _, err := jsonpath.New(p); err != nil {
+		return "", fmt.Errorf("%w: %s, %w", ErrInvalidColumn, column, err)
+	}
+
+	return p, nil
+}
+
+// cell returns the value of the path for the row, missing values are left empty and objects are printed as JSON.
+func cell(p string, row any) string {
+	v, err := jsonpath.Get(p, row)
+	if err != nil {
+		return ""
+	}
+
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return fmt.Sprint(value)
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	}
+}
+
+func printDelimited(w io.Writer, comma rune, records [][]string) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	return writer.WriteAll(records)
+}
+
+func printTable(w io.Writer, records [][]string) error {
+	writer := tabwriter.NewWriter(w, tabwriterMinWidth, tabwriterWidth, tabwriterPadding, tabwriterPadChar, 0)
+
+	for i, record := range records {
+		values := make([]string, len(record))
+		for j, value := range record {
+			// tabs and new lines would break the alignment of the columns
+			value = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(value)
+			if i == 0 {
+				value = strings.ToUpper(value)
+			}
+			values[j] = value
+		}
+
+		if _, err := fmt.Fprintln(writer, strings.Join(values, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
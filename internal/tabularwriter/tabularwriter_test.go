@@ -0,0 +1,116 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tabularwriter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type region struct {
+	Name string `json:"name"`
+}
+
+type cluster struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	DiskSize float64  `json:"diskSizeGB"`
+	Paused   bool     `json:"paused"`
+	Regions  []region `json:"regions,omitempty"`
+}
+
+type paginated struct {
+	Results    []cluster `json:"results"`
+	TotalCount int       `json:"totalCount"`
+}
+
+var clusters = []cluster{
+	{ID: "1", Name: "Cluster0", DiskSize: 10, Paused: false, Regions: []region{{Name: "US_EAST_1"}}},
+	{ID: "2", Name: "my, cluster", DiskSize: 2.5, Paused: true},
+}
+
+func TestPrint(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		columns []string
+		obj     any
+		want    string
+	}{
+		{
+			name:   "csv with default columns",
+			format: CSV,
+			obj:    clusters,
+			want: "diskSizeGB,id,name,paused,regions\n" +
+				`10,1,Cluster0,false,"[{""name"":""US_EAST_1""}]"` + "\n" +
+				`2.5,2,"my, cluster",true,` + "\n",
+		},
+		{
+			name:    "tsv with columns",
+			format:  TSV,
+			columns: []string{"name", "$.regions[0].name"},
+			obj:     clusters,
+			want:    "name\t$.regions[0].name\nCluster0\tUS_EAST_1\nmy, cluster\t\n",
+		},
+		{
+			name:    "table of paginated results",
+			format:  Table,
+			columns: []string{"id", "name"},
+			obj:     &paginated{Results: clusters, TotalCount: 2},
+			want: "ID    NAME\n" +
+				"1     Cluster0\n" +
+				"2     my, cluster\n",
+		},
+		{
+			name:    "single object",
+			format:  CSV,
+			columns: []string{"id", "paused"},
+			obj:     &clusters[0],
+			want:    "id,paused\n1,false\n",
+		},
+		{
+			name:   "list of values",
+			format: CSV,
+			obj:    []string{"a", "b"},
+			want:   "$\na\nb\n",
+		},
+		{
+			name:    "nil",
+			format:  CSV,
+			columns: []string{"id"},
+			obj:     nil,
+			want:    "id\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			require.NoError(t, Print(buf, tt.format, tt.columns, tt.obj))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestPrint_Errors(t *testing.T) {
+	buf := new(bytes.Buffer)
+	require.ErrorIs(t, Print(buf, "xml", nil, clusters), ErrUnsupportedFormat)
+	require.ErrorIs(t, Print(buf, CSV, []string{"name", " "}, clusters), ErrInvalidColumn)
+	require.ErrorIs(t, Print(buf, CSV, []string{"regions[0"}, clusters), ErrInvalidColumn)
+	assert.Empty(t, buf.String())
+}
@@ -359,9 +359,13 @@ const (
 	jsonPath        = "json-path"
 	goTemplate      = "go-template"
 	goTemplateFile  = "go-template-file"
+	yamlFormat      = "yaml"
+	csvFormat       = "csv"
+	tsvFormat       = "tsv"
+	tableFormat     = "table"
 )
 
-var templateFormats = []string{jsonFormat, goTemplate, goTemplateFile, jsonPath}
+var templateFormats = []string{jsonFormat, goTemplate, goTemplateFile, jsonPath, yamlFormat, csvFormat, tsvFormat, tableFormat}
 
 func output(o string) string {
 	for _, f := range templateFormats {
@@ -496,6 +496,12 @@ func Test_withOutput(t *testing.T) {
 			configValue: "json",
 			want:        "json",
 		},
+		{
+			name:        "tabular format",
+			flagValue:   "csv",
+			configValue: "json",
+			want:        "csv",
+		},
 		{
 			name:        "no value",
 			flagValue:   "",
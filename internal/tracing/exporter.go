@@ -0,0 +1,304 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	filePermissions = 0600
+	dirPermissions  = 0700
+	exportTimeout   = 10 * time.Second
+)
+
+// Exporter writes spans as OTLP JSON, the encoding of the OTLP/HTTP protocol and of the OpenTelemetry Collector file exporter.
+type Exporter struct {
+	write func(context.Context, []byte) error
+}
+
+var _ sdktrace.SpanExporter = (*Exporter)(nil)
+
+// NewExporter returns the exporter of the settings.
+func NewExporter(s Settings) (*Exporter, error) {
+	switch s.Exporter {
+	case FileExporter:
+		return NewFileExporter(afero.NewOsFs(), s.Endpoint), nil
+	case OTLPExporter:
+		return NewHTTPExporter(&http.Client{Timeout: exportTimeout}, s.Endpoint, s.Headers), nil
+	default:
+		return nil, fmt.Errorf("invalid %s %q, expected %s or %s", ExporterProperty, s.Exporter, FileExporter, OTLPExporter)
+	}
+}
+
+// NewFileExporter appends a line for every batch of spans to path.
+func NewFileExporter(fsys afero.Fs, path string) *Exporter {
+	return &Exporter{
+		write: func(_ context.Context, b []byte) error {
+			if err := fsys.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+				return err
+			}
+			f, err := fsys.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePermissions)
+			if err != nil {
+				return err
+			}
+			_, err = f.Write(append(b, '\n'))
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			return err
+		},
+	}
+}
+
+// NewHTTPExporter posts the spans to an OTLP/HTTP traces endpoint, such as http://localhost:4318/v1/traces.
+func NewHTTPExporter(client *http.Client, endpoint string, headers map[string]string) *Exporter {
+	return &Exporter{
+		write: func(ctx context.Context, b []byte) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			_, _ = io.Copy(io.Discard, resp.Body)
+
+			if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+				return fmt.Errorf("exporting spans to %s: %s", endpoint, resp.Status)
+			}
+			return nil
+		},
+	}
+}
+
+func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(newTracesData(spans))
+	if err != nil {
+		return err
+	}
+	return e.write(ctx, b)
+}
+
+func (*Exporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// The types below are the subset of the OTLP JSON encoding of TracesData used by the CLI,
+// see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+
+type tracesData struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   otlpResource `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []keyValue `json:"attributes,omitempty"`
+}
+
+type scopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []keyValue  `json:"attributes,omitempty"`
+	Events            []otlpEvent `json:"events,omitempty"`
+	Status            otlpStatus  `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string     `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []keyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	BoolValue   *bool       `json:"boolValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	DoubleValue *float64    `json:"doubleValue,omitempty"`
+	ArrayValue  *arrayValue `json:"arrayValue,omitempty"`
+}
+
+type arrayValue struct {
+	Values []anyValue `json:"values"`
+}
+
+// OTLP status codes, they don't match the order of codes.Code.
+const (
+	statusCodeOk    = 1
+	statusCodeError = 2
+)
+
+func newTracesData(spans []sdktrace.ReadOnlySpan) tracesData {
+	data := tracesData{}
+	resources := map[attribute.Distinct]int{}
+	for _, s := range spans {
+		key := s.Resource().Equivalent()
+		i, ok := resources[key]
+		if !ok {
+			i = len(data.ResourceSpans)
+			resources[key] = i
+			data.ResourceSpans = append(data.ResourceSpans, resourceSpans{
+				Resource: otlpResource{Attributes: newKeyValues(s.Resource().Attributes())},
+			})
+		}
+
+		rs := &data.ResourceSpans[i]
+		scope := otlpScope{Name: s.InstrumentationScope().Name, Version: s.InstrumentationScope().Version}
+		j := slices.IndexFunc(rs.ScopeSpans, func(ss scopeSpans) bool { return ss.Scope == scope })
+		if j < 0 {
+			rs.ScopeSpans = append(rs.ScopeSpans, scopeSpans{Scope: scope})
+			j = len(rs.ScopeSpans) - 1
+		}
+		rs.ScopeSpans[j].Spans = append(rs.ScopeSpans[j].Spans, newSpan(s))
+	}
+	return data
+}
+
+func newSpan(s sdktrace.ReadOnlySpan) otlpSpan {
+	traceID := s.SpanContext().TraceID()
+	spanID := s.SpanContext().SpanID()
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(traceID[:]),
+		SpanID:            hex.EncodeToString(spanID[:]),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: unixNano(s.StartTime()),
+		EndTimeUnixNano:   unixNano(s.EndTime()),
+		Attributes:        newKeyValues(s.Attributes()),
+	}
+	if s.Parent().HasSpanID() {
+		parentID := s.Parent().SpanID()
+		span.ParentSpanID = hex.EncodeToString(parentID[:])
+	}
+	for _, e := range s.Events() {
+		span.Events = append(span.Events, otlpEvent{
+			TimeUnixNano: unixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   newKeyValues(e.Attributes),
+		})
+	}
+	switch s.Status().Code {
+	case codes.Ok:
+		span.Status.Code = statusCodeOk
+	case codes.Error:
+		span.Status = otlpStatus{Code: statusCodeError, Message: s.Status().Description}
+	}
+	return span
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func newKeyValues(attrs []attribute.KeyValue) []keyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	kvs := make([]keyValue, 0, len(attrs))
+	for _, a := range attrs {
+		kvs = append(kvs, keyValue{Key: string(a.Key), Value: newAnyValue(a.Value)})
+	}
+	return kvs
+}
+
+func newAnyValue(v attribute.Value) anyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return anyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		return anyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return anyValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		return newArrayValue(v.AsBoolSlice(), attribute.BoolValue)
+	case attribute.INT64SLICE:
+		return newArrayValue(v.AsInt64Slice(), attribute.Int64Value)
+	case attribute.FLOAT64SLICE:
+		return newArrayValue(v.AsFloat64Slice(), attribute.Float64Value)
+	case attribute.STRINGSLICE:
+		return newArrayValue(v.AsStringSlice(), attribute.StringValue)
+	default:
+		s := v.Emit()
+		return anyValue{StringValue: &s}
+	}
+}
+
+func newArrayValue[T any](values []T, value func(T) attribute.Value) anyValue {
+	array := &arrayValue{Values: make([]anyValue, 0, len(values))}
+	for _, v := range values {
+		array.Values = append(array.Values, newAnyValue(value(v)))
+	}
+	return anyValue{ArrayValue: array}
+}
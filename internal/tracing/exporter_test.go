@@ -0,0 +1,122 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func testSpans() []sdktrace.ReadOnlySpan {
+	traceID := trace.TraceID{0x01}
+	start := time.Unix(1760000000, 0)
+	res := resource.NewSchemaless(attribute.String("service.name", serviceName))
+	scope := instrumentation.Scope{Name: instrumentationName}
+
+	return tracetest.SpanStubs{
+		{
+			Name:                 "atlas clusters list",
+			SpanContext:          trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{0x02}}),
+			SpanKind:             trace.SpanKindInternal,
+			StartTime:            start,
+			EndTime:              start.Add(time.Second),
+			Attributes:           []attribute.KeyValue{attribute.StringSlice("cli.flags", []string{"projectId"})},
+			Resource:             res,
+			InstrumentationScope: scope,
+		},
+		{
+			Name:                 "HTTP GET",
+			SpanContext:          trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{0x03}}),
+			Parent:               trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{0x02}}),
+			SpanKind:             trace.SpanKindClient,
+			StartTime:            start,
+			EndTime:              start.Add(time.Millisecond),
+			Attributes:           []attribute.KeyValue{attribute.Int("http.response.status_code", 500), attribute.Bool("retried", true)},
+			Status:               sdktrace.Status{Code: codes.Error, Description: "500 Internal Server Error"},
+			Resource:             res,
+			InstrumentationScope: scope,
+		},
+	}.Snapshots()
+}
+
+const wantTraces = `{"resourceSpans":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"atlascli"}}]},` +
+	`"scopeSpans":[{"scope":{"name":"github.com/mongodb/mongodb-atlas-cli/atlascli"},"spans":[` +
+	`{"traceId":"01000000000000000000000000000000","spanId":"0200000000000000","name":"atlas clusters list","kind":1,` +
+	`"startTimeUnixNano":"1760000000000000000","endTimeUnixNano":"1760000001000000000",` +
+	`"attributes":[{"key":"cli.flags","value":{"arrayValue":{"values":[{"stringValue":"projectId"}]}}}],"status":{}},` +
+	`{"traceId":"01000000000000000000000000000000","spanId":"0300000000000000","parentSpanId":"0200000000000000","name":"HTTP GET","kind":3,` +
+	`"startTimeUnixNano":"1760000000000000000","endTimeUnixNano":"1760000000001000000",` +
+	`"attributes":[{"key":"http.response.status_code","value":{"intValue":"500"}},{"key":"retried","value":{"boolValue":true}}],` +
+	`"status":{"code":2,"message":"500 Internal Server Error"}}]}]}]}`
+
+func TestFileExporter(t *testing.T) {
+	fsys := afero.NewMemMapFs()
+	path := "/home/atlascli/traces.jsonl"
+	e := NewFileExporter(fsys, path)
+
+	require.NoError(t, e.ExportSpans(context.Background(), testSpans()))
+	require.NoError(t, e.ExportSpans(context.Background(), nil))
+	require.NoError(t, e.ExportSpans(context.Background(), testSpans()[:1]))
+
+	b, err := afero.ReadFile(fsys, path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, wantTraces, lines[0])
+}
+
+func TestHTTPExporter(t *testing.T) {
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "secret", r.Header.Get("Api-Key"))
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	e := NewHTTPExporter(srv.Client(), srv.URL+"/v1/traces", map[string]string{"api-key": "secret"})
+	require.NoError(t, e.ExportSpans(context.Background(), testSpans()))
+	assert.JSONEq(t, wantTraces, string(body))
+
+	e = NewHTTPExporter(srv.Client(), srv.URL+"/v1/logs", nil)
+	require.Error(t, e.ExportSpans(context.Background(), testSpans()))
+}
+
+func TestNewExporter(t *testing.T) {
+	_, err := NewExporter(Settings{Exporter: OTLPExporter, Endpoint: DefaultOTLPEndpoint})
+	require.NoError(t, err)
+	_, err = NewExporter(Settings{})
+	require.Error(t, err)
+}
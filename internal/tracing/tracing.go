@@ -0,0 +1,227 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing exports opt-in OpenTelemetry spans of the commands, API requests, container engine operations
+// and watcher polls to a local file or an OTLP endpoint.
+// It is unrelated to the anonymous usage telemetry of the telemetry package.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	ExporterProperty = "trace_exporter"
+	EndpointProperty = "trace_endpoint"
+
+	FileExporter = "file"
+	OTLPExporter = "otlp"
+	noneExporter = "none"
+
+	DefaultOTLPEndpoint = "http://localhost:4318/v1/traces"
+	otlpTracesPath      = "/v1/traces"
+	fileName            = "traces.jsonl"
+
+	instrumentationName = "github.com/mongodb/mongodb-atlas-cli/atlascli"
+	serviceName         = "atlascli"
+	flushTimeout        = 5 * time.Second
+)
+
+var (
+	provider *sdktrace.TracerProvider
+	command  trace.Span
+)
+
+// Settings configures the exporter of the spans.
+type Settings struct {
+	// Exporter is FileExporter, OTLPExporter or empty when tracing is disabled.
+	Exporter string
+	// Endpoint is the file or the OTLP/HTTP traces URL the spans are exported to.
+	Endpoint string
+	// Headers are sent with every OTLP request.
+	Headers map[string]string
+}
+
+// Properties returns the profile properties used to configure tracing.
+func Properties() []string {
+	return []string{ExporterProperty, EndpointProperty}
+}
+
+// NewSettings reads the profile properties, which MONGODB_ATLAS_TRACE_EXPORTER and MONGODB_ATLAS_TRACE_ENDPOINT override,
+// and falls back to the standard OTEL_TRACES_EXPORTER and OTEL_EXPORTER_OTLP_* environment variables.
+func NewSettings(get, getenv func(string) string) (Settings, error) {
+	s := Settings{
+		Exporter: strings.ToLower(get(ExporterProperty)),
+		Endpoint: get(EndpointProperty),
+	}
+	if s.Exporter == "" && strings.ToLower(getenv("OTEL_TRACES_EXPORTER")) == OTLPExporter {
+		s.Exporter = OTLPExporter
+	}
+
+	switch s.Exporter {
+	case "", noneExporter:
+		return Settings{}, nil
+	case FileExporter:
+	case OTLPExporter:
+		if s.Endpoint == "" {
+			s.Endpoint = otlpEndpoint(getenv)
+		}
+		s.Headers = parseHeaders(firstNonEmpty(getenv("OTEL_EXPORTER_OTLP_TRACES_HEADERS"), getenv("OTEL_EXPORTER_OTLP_HEADERS")))
+	default:
+		return Settings{}, fmt.Errorf("invalid %s %q, expected %s or %s", ExporterProperty, s.Exporter, FileExporter, OTLPExporter)
+	}
+
+	return s, nil
+}
+
+// Enabled returns true when the settings export spans.
+func (s Settings) Enabled() bool {
+	return s.Exporter != ""
+}
+
+func otlpEndpoint(getenv func(string) string) string {
+	if endpoint := getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+	if endpoint := getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + otlpTracesPath
+	}
+	return DefaultOTLPEndpoint
+}
+
+// parseHeaders parses the W3C baggage like format of OTEL_EXPORTER_OTLP_HEADERS, for example "api-key=secret,team=cli".
+func parseHeaders(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+
+	headers := map[string]string{}
+	for pair := range strings.SplitSeq(s, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		if unescaped, err := url.QueryUnescape(strings.TrimSpace(v)); err == nil {
+			v = unescaped
+		}
+		headers[strings.TrimSpace(k)] = v
+	}
+	return headers
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// Path returns the default file of the file exporter in the config home of the CLI.
+func Path(configHome string) string {
+	return filepath.Join(configHome, fileName)
+}
+
+// Enable exports the spans of the current command with exporter until Finish.
+func Enable(exporter sdktrace.SpanExporter) {
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version.Version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+}
+
+// Enabled returns true when spans are exported.
+func Enabled() bool {
+	return provider != nil
+}
+
+// Tracer returns the tracer of the CLI, its spans are dropped unless tracing is enabled.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartCommand starts the span of cmd, the parent of the spans that Start creates from a context without span.
+// Only the names of the flags are recorded since their values may hold credentials.
+func StartCommand(ctx context.Context, cmd *cobra.Command) context.Context {
+	var flags []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		flags = append(flags, f.Name)
+	})
+
+	ctx, command = Tracer().Start(ctx, cmd.CommandPath(), trace.WithAttributes(
+		attribute.String("cli.command", cmd.CommandPath()),
+		attribute.StringSlice("cli.flags", flags),
+	))
+	return ctx
+}
+
+// Start starts a span, the command span is its parent when ctx doesn't have a span.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if command != nil && !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpan(ctx, command)
+	}
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// End records err, if any, and ends span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Finish ends the command span with the result of the command and flushes the spans.
+func Finish(ctx context.Context, err error) error {
+	if command != nil {
+		End(command, err)
+		command = nil
+	}
+	if provider == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+	defer cancel()
+
+	err = provider.Shutdown(ctx)
+	provider = nil
+	otel.SetTracerProvider(noop.NewTracerProvider())
+	return err
+}
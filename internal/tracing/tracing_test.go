@@ -0,0 +1,151 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func getter(values map[string]string) func(string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func TestNewSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		props   map[string]string
+		env     map[string]string
+		want    Settings
+		wantErr bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:  "none",
+			props: map[string]string{ExporterProperty: "none"},
+		},
+		{
+			name:  "file",
+			props: map[string]string{ExporterProperty: "file", EndpointProperty: "/tmp/traces.jsonl"},
+			want:  Settings{Exporter: FileExporter, Endpoint: "/tmp/traces.jsonl"},
+		},
+		{
+			name:  "otlp default endpoint",
+			props: map[string]string{ExporterProperty: "OTLP"},
+			want:  Settings{Exporter: OTLPExporter, Endpoint: DefaultOTLPEndpoint},
+		},
+		{
+			name: "otlp from OpenTelemetry environment variables",
+			env: map[string]string{
+				"OTEL_TRACES_EXPORTER":        "otlp",
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "https://collector:4318/",
+				"OTEL_EXPORTER_OTLP_HEADERS":  "api-key=a%3Db, team = cli",
+			},
+			want: Settings{
+				Exporter: OTLPExporter,
+				Endpoint: "https://collector:4318/v1/traces",
+				Headers:  map[string]string{"api-key": "a=b", "team": "cli"},
+			},
+		},
+		{
+			name:  "traces endpoint",
+			props: map[string]string{ExporterProperty: "otlp"},
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":        "https://collector:4318",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "https://traces:4318/custom",
+			},
+			want: Settings{Exporter: OTLPExporter, Endpoint: "https://traces:4318/custom"},
+		},
+		{
+			name:    "invalid",
+			props:   map[string]string{ExporterProperty: "zipkin"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSettings(getter(tt.props), getter(tt.env))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Exporter != "", got.Enabled())
+		})
+	}
+}
+
+// recorder keeps the spans after Shutdown, unlike tracetest.InMemoryExporter.
+type recorder struct {
+	spans tracetest.SpanStubs
+}
+
+func (r *recorder) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	r.spans = append(r.spans, tracetest.SpanStubsFromReadOnlySpans(spans)...)
+	return nil
+}
+
+func (*recorder) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestStart_CommandParent(t *testing.T) {
+	exporter := &recorder{}
+	Enable(exporter)
+
+	root := &cobra.Command{Use: "atlas"}
+	cmd := &cobra.Command{Use: "wait"}
+	root.AddCommand(cmd)
+	require.NoError(t, cmd.Flags().Parse(nil))
+
+	ctx := StartCommand(context.Background(), cmd)
+	_, child := Start(ctx, "child")
+	child.End()
+	_, orphan := Start(context.Background(), "orphan")
+	End(orphan, errors.New("failed"))
+
+	require.NoError(t, Finish(ctx, nil))
+	assert.False(t, Enabled())
+
+	spans := exporter.spans
+	require.Len(t, spans, 3)
+	command := spans[2]
+	assert.Equal(t, "atlas wait", command.Name)
+	for _, s := range spans[:2] {
+		assert.Equal(t, command.SpanContext.SpanID(), s.Parent.SpanID())
+		assert.Equal(t, command.SpanContext.TraceID(), s.SpanContext.TraceID())
+	}
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Equal(t, "failed", spans[1].Status.Description)
+}
+
+func TestFinish_Disabled(t *testing.T) {
+	require.NoError(t, Finish(context.Background(), errors.New("failed")))
+	_, span := Start(context.Background(), "noop")
+	assert.False(t, span.SpanContext().IsValid())
+}
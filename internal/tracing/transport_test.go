@@ -0,0 +1,59 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWrapClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := WrapClient(srv.Client())
+	_, traced := client.Transport.(*Transport)
+	assert.False(t, traced, "the client must not be traced when tracing is disabled")
+
+	exporter := &recorder{}
+	Enable(exporter)
+	client = WrapClient(srv.Client())
+	require.IsType(t, &Transport{}, client.Transport)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodDelete, srv.URL+"/api/atlas/v2/groups", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NoError(t, Finish(context.Background(), nil))
+
+	require.Len(t, exporter.spans, 1)
+	span := exporter.spans[0]
+	assert.Equal(t, "HTTP DELETE", span.Name)
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind)
+	assert.Contains(t, span.Attributes, attribute.String("url.path", "/api/atlas/v2/groups"))
+	assert.Contains(t, span.Attributes, attribute.Int("http.response.status_code", http.StatusServiceUnavailable))
+	assert.Equal(t, codes.Error, span.Status.Code)
+}
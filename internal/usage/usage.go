@@ -247,7 +247,7 @@ dbName and collection are required only for built-in roles.`
 	StreamsPrivateLinkFilename                    = "Path to a JSON configuration file that defines an Atlas Stream Processing PrivateLink endpoint. Note: Unsupported fields in the JSON file are ignored."
 	StreamsInstanceTier                           = "Tier for your Stream Instance."
 	WithoutDefaultAlertSettings                   = "Flag that creates the new project without the default alert settings enabled. This flag defaults to false. This option is useful if you create projects programmatically and want to create your own alerts instead of using the default alert settings."
-	FormatOut                                     = "Output format. Valid values are json, json-path, go-template, or go-template-file. To see the full output, use the -o json option."
+	FormatOut                                     = "Output format. Valid values are json, json-path, go-template, go-template-file, yaml, csv, tsv, or table. To see the full output, use the -o json option."
 	TargetClusterName                             = "Name of the target cluster. For use only with automated restore jobs. You must specify a targetClusterName for automated restores."
 	OplogTS                                       = "Oplog timestamp given as a timestamp in the number of seconds that have elapsed since the UNIX Epoch. When paired with oplogInc, they represent the point in time to which your data will be restored."
 	OplogInc                                      = "32-bit incrementing ordinal that represents operations within a given second. When paired with oplogTs, they represent the point in time to which your data will be restored."
@@ -279,7 +279,7 @@ dbName and collection are required only for built-in roles.`
 	CurrentIP                                     = "Flag that adds the IP address from the host that is currently executing the command to the access list. Only applicable for type ipAddress entries. You don't need the entry argument when you use the currentIp option."
 	CurrentIPSimplified                           = "Flag that adds the IP address from the host that is currently executing the command to the access list."
 	Gov                                           = "Create a default profile for atlas for gov"
-	EncryptedLogFile                              = "Path to the file that contains encrypted audit logs."
+	EncryptedLogFile                              = "Path to the file that contains encrypted audit logs. If not specified or set to -, Atlas CLI reads the encrypted audit logs from stdin."
 	OutputLogFile                                 = "Path to the file where Atlas CLI will save the contents of the decrypted audit log. If not specified, Atlas CLI writes the contents of the decrypted audit log to stdout."
 	GCPServiceAccountKey                          = "GCP service account key file."
 	AzureClientID                                 = "Application (client) ID assigned by Azure portal - App registrations experience."
@@ -405,4 +405,53 @@ dbName and collection are required only for built-in roles.`
 	InvitationFile             = "Path to an optional JSON configuration file that defines invitation settings. Note: Unsupported fields in the JSON file are ignored."
 	InitDB                     = "Flag that uses a folder to be mapped into LOCAL deployment for initialization"
 	AutoScalingMode            = "Mode in which the cluster scales. Valid values are clusterWideScaling or independentShardScaling."
+	AllPages                   = "Flag that indicates whether to fetch all pages of results and merge them into a single results array. Only supported for json output."
+	MaxItems                   = "Maximum number of results to return when fetching all pages. Requires --allPages. If not set, all results are returned. When set, totalCount is the number of results returned."
+	NDJSON                     = "Flag that indicates whether to write each result on a separate line (newline-delimited JSON) instead of a merged results array. Requires --allPages."
+	ManifestFilename           = "Path to a YAML or JSON manifest file that describes the desired state of the project. Resource fields use the same names as the Atlas Administration API request bodies."
+	ForceApply                 = "Flag that indicates whether to apply the changes without asking for confirmation."
+	ExportOut                  = "Path to the directory where the command writes the project configuration files. The command creates the directory if it doesn't exist."
+	MaxRetries                 = "Number of times to retry requests that fail with HTTP 429, 502, 503 or 504. Set to 0 to disable retries. Overrides the max_retries profile setting."
+	RetryMaxWait               = "Maximum time to wait between two attempts, for example 30s. Requests asking to wait longer with the Retry-After header are not retried. Overrides the retry_max_wait profile setting."
+	RetryAllMethods            = "Flag that indicates whether to also retry requests that are not idempotent, like POST and PATCH. Overrides the retry_all_methods profile setting."
+	Columns                    = "Comma-separated list of JSON paths to print as columns with the csv, tsv and table output formats, for example name,stateName,replicationSpecs[0].zoneName. Paths are relative to each element of lists and paginated results. Defaults to all top-level fields."
+	DryRun                     = "Flag that indicates whether to print the HTTP method, URL, headers and body of the first request that creates, updates or deletes a resource instead of sending it. Read requests are still sent. Operations that create, update or delete local deployment containers, networks, volumes or images are printed instead of run too. The command exits with 0 when it reaches that request or operation. Commands that change the configuration file don't support this flag."
+	DeploymentMembers          = "Number of members of the local replica set, between 1 and 7. With --shards, number of members of every shard and of the config server replica set. Each member runs in its own container, the members of a replica set are published on consecutive host ports starting at --port. The replica set configuration uses the container names, so clients on the host connect to a single member with directConnection=true. Defaults to a single node."
+	DeploymentShards           = "Number of shards of a local sharded cluster. When greater than 0, the deployment runs a mongos, a config server replica set and the shards, each member in its own container. Defaults to 0."
+	SnapshotsDeploymentName    = "Name of the local deployment to list the snapshots of. Defaults to all local deployments."
+	SnapshotRestoreTarget      = "Name of the local deployment to restore the snapshot to. When the deployment doesn't exist, it is created. Defaults to the deployment the snapshot was created from."
+	SnapshotRestorePort        = "Port that the MongoDB server of a new deployment listens to for client connections. Defaults to a random port."
+	FromSnapshot               = "Cluster name and snapshot ID of an Atlas Flex cluster snapshot, like myCluster/5f4007f327a3bd7b6f4103c5, to use as the data of the new local deployment. The snapshot is downloaded and extracted to the deployments directory of the Atlas CLI configuration home, which is mounted as the data directory of the deployment."
+	ComposeFile                = "Path to the YAML file that describes the local deployments. Defaults to atlas-local.yaml in the current directory."
+	LocalKeyFile               = "Path to the file that holds the base64 encoded local master key that encrypted the audit log. Defaults to the filename of the audit log header when that file exists."
+	KMIPServerCAFile           = "Path to the PEM file of the certificate authority that signed the certificate of the KMIP server. Defaults to the system certificate authorities."
+	KMIPClientCertFile         = "Path to the PEM file that holds the client certificate and its private key to authenticate to the KMIP server."
+	KMIPClientCertPassword     = "Password of the private key of the KMIP client certificate, for keys encrypted with legacy PEM encryption."
+	KMIPUsername               = "Username to authenticate to the KMIP server, for servers that require credentials in addition to the client certificate."
+	KMIPPassword               = "Password to authenticate to the KMIP server."
+	DecryptWorkers             = "Number of workers that decrypt the log records of the audit log concurrently. Defaults to the number of CPUs."
+	AuditType                  = "Audit event action types to keep, like authenticate or createCollection. Decryption errors and warnings are always kept."
+	AuditUser                  = "Names of the users, or user@database, whose audit log records to keep."
+	AuditNamespace             = "Namespaces, like myDB.myCollection, or databases, like myDB, whose audit log records to keep."
+	DecryptSince               = "ISO 8601-formatted time from which to keep the audit log records."
+	DecryptUntil               = "ISO 8601-formatted time until which to keep the audit log records."
+	DecryptFormat              = "Format of the decrypted audit log records. Valid values are ndjson, cef and ocsf."
+	LogsClusterName            = "Name of the cluster whose logs to download with --allHosts."
+	AllHosts                   = "Flag that indicates whether to download the logs of all the hosts of the cluster set with --clusterName."
+	LogTypes                   = "Types of logs to download with --allHosts. Valid values are mongodb, mongos and audit."
+	LogsParallelism            = "Maximum number of logs to download at the same time with --allHosts."
+	DecryptLogs                = "Flag that indicates whether to decompress and decrypt the audit logs downloaded with --allHosts, with the key provider credentials of the logs decrypt command."
+	VerifyOnly                 = "Flag that indicates whether to only verify the integrity of the audit log. Atlas CLI prints a report of the header sections, missing or out-of-order key invocation counts, corrupted lines and unknown record types instead of the decrypted records, and exits with an error when it finds an integrity issue."
+	LogFollow                  = "Flag that indicates whether to keep printing the new log lines until you stop the command. For Atlas deployments, Atlas CLI downloads the log periodically from the start date and only prints the lines that it hasn't printed yet."
+	LogGrep                    = "Regular expression that the log lines to print with --follow must match."
+	LogComponent               = "Components, like NETWORK or REPL, of the log lines to print with --follow."
+	LogSeverity                = "Severities, like F, E, W, I or D, of the log lines to print with --follow."
+	ForPlugin                  = "Flag that indicates whether to return the context that plugins receive from Atlas CLI as JSON: the profile, project and organization IDs, output format, base URL and a refreshed access token of the profile."
+	PluginSHA256               = "SHA-256 checksum of the plugin archive. Required to install a plugin from a local archive, a URL or an OCI registry. For GitHub repositories, Atlas CLI checks the checksum when you set it."
+	PluginLockfile             = "Path to the lockfile with the sources and SHA-256 checksums of the plugins to install."
+	AuditLogSince              = "Date and time from which to return the audit log entries, as an ISO 8601-formatted time or a duration like 24h."
+	AuditLogCommand            = "Command path prefix, like \"atlas clusters\", of the audit log entries to return."
+	AuditLogLimit              = "Maximum number of the most recent audit log entries to return. Use 0 to return all the entries."
+	Effective                  = "Flag that indicates whether to return the values that commands use in the current directory and where they come from."
+	ListExtends                = "Flag that indicates whether to return the profiles that each profile extends."
 )
@@ -15,13 +15,16 @@
 package watchers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/internal/tracing"
 	atlasClustersPinned "go.mongodb.org/atlas-sdk/v20240530005/admin"
 	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
 	atlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type InvalidStateError struct {
@@ -57,13 +60,89 @@ func (err *InvalidStateError) Error() string {
 	return fmt.Sprintf("%s %s", got, expected)
 }
 
+// ErrTimeout is returned when a watch doesn't reach its expected state before its timeout.
+var ErrTimeout = errors.New("watch timed out")
+
+// Event describes a state observed while watching a resource.
+type Event struct {
+	Time     time.Time `json:"time"`
+	Resource string    `json:"resource,omitempty"`
+	State    string    `json:"state"`
+}
+
+// NewEvent returns an Event for the given resource and state at the current time.
+func NewEvent(resource, state string) Event {
+	return Event{
+		Time:     time.Now().UTC(),
+		Resource: resource,
+		State:    state,
+	}
+}
+
+type stateNameGetter interface {
+	GetStateName() string
+}
+
+type statusGetter interface {
+	GetStatus() string
+}
+
+type stateGetter interface {
+	GetState() string
+}
+
+// StateOf returns the state reported by an Atlas resource, if any.
+func StateOf(v any) (string, bool) {
+	switch r := v.(type) {
+	case stateNameGetter:
+		return r.GetStateName(), true
+	case statusGetter:
+		return r.GetStatus(), true
+	case stateGetter:
+		return r.GetState(), true
+	default:
+		return "", false
+	}
+}
+
+// WithTimeout returns a context that is cancelled after timeout, a zero or negative timeout means no timeout.
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// Sleep pauses for d or until ctx is done, whichever happens first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// TimeoutError wraps context deadline errors with ErrTimeout, other errors are returned unchanged.
+func TimeoutError(err error, timeout time.Duration) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %s", ErrTimeout, timeout)
+	}
+	return err
+}
+
 type StateDescriber interface {
 	GetState() (string, error)
 }
 
 type Watcher struct {
-	Timeout            time.Duration // TODO: Timeout support - CLOUDP-181597
+	Timeout            time.Duration
 	NonConstantBackoff bool
+	OnStateChange      func(Event)
+	lastState          string
 	stateTransition    StateTransition
 	describer          StateDescriber
 	hasStarted         bool
@@ -85,36 +164,72 @@ func NewWatcherWithDefaultWait(stateTransition StateTransition, describer StateD
 }
 
 func (watcher *Watcher) Watch() error {
+	return watcher.WatchContext(context.Background())
+}
+
+// WatchContext polls until the expected state is reached, the Timeout expires or ctx is done.
+func (watcher *Watcher) WatchContext(ctx context.Context) error {
 	watcher.hasStarted = false
+	watcher.lastState = ""
+
+	ctx, cancel := WithTimeout(ctx, watcher.Timeout)
+	defer cancel()
+
+	var err error
 	if watcher.NonConstantBackoff {
-		return watcher.fibonacciBackoff()
+		err = watcher.fibonacciBackoff(ctx)
+	} else {
+		err = watcher.constantBackoff(ctx)
 	}
 
-	return watcher.constantBackoff()
+	return TimeoutError(err, watcher.Timeout)
 }
 
-func (watcher *Watcher) fibonacciBackoff() error {
+func (watcher *Watcher) fibonacciBackoff(ctx context.Context) error {
 	previousBackoff := 0 * time.Second
 	currentBackoff := watcher.defaultWait
 
 	for {
-		done, err := watcher.IsDone()
+		done, err := watcher.poll(ctx)
 		if err != nil || done {
 			return err
 		}
-		time.Sleep(currentBackoff)
+		if err := Sleep(ctx, currentBackoff); err != nil {
+			return err
+		}
 		currentBackoff += previousBackoff
 		previousBackoff = currentBackoff - previousBackoff
 	}
 }
 
-func (watcher *Watcher) constantBackoff() error {
+func (watcher *Watcher) constantBackoff(ctx context.Context) error {
 	for {
-		done, err := watcher.IsDone()
+		done, err := watcher.poll(ctx)
 		if err != nil || done {
 			return err
 		}
-		time.Sleep(watcher.defaultWait)
+		if err := Sleep(ctx, watcher.defaultWait); err != nil {
+			return err
+		}
+	}
+}
+
+// poll records a span around IsDone.
+func (watcher *Watcher) poll(ctx context.Context) (bool, error) {
+	_, span := tracing.Start(ctx, "watcher poll")
+	done, err := watcher.IsDone()
+	span.SetAttributes(attribute.String("watcher.state", watcher.lastState), attribute.Bool("watcher.done", done))
+	tracing.End(span, err)
+	return done, err
+}
+
+func (watcher *Watcher) notify(state string) {
+	if state == "" || state == watcher.lastState {
+		return
+	}
+	watcher.lastState = state
+	if watcher.OnStateChange != nil {
+		watcher.OnStateChange(NewEvent("", state))
 	}
 }
 
@@ -124,6 +239,9 @@ func (watcher *Watcher) IsDone() (bool, error) {
 	}
 
 	state, err := watcher.describer.GetState()
+	if err == nil {
+		watcher.notify(state)
+	}
 
 	if !watcher.hasStarted {
 		if !watcher.stateTransition.IsStartState(state) {
@@ -0,0 +1,54 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yamlwriter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrint(t *testing.T) {
+	type cluster struct {
+		Name      string            `json:"name"`
+		DiskSize  float64           `json:"diskSizeGB"`
+		Instances int64             `json:"instances"`
+		Paused    *bool             `json:"paused,omitempty"`
+		Labels    map[string]string `json:"labels"`
+		Regions   []string          `json:"regions"`
+	}
+
+	buf := new(bytes.Buffer)
+	err := Print(buf, []cluster{{
+		Name:      "Cluster0",
+		DiskSize:  10.5,
+		Instances: 9007199254740993,
+		Labels:    map[string]string{"env": "dev"},
+		Regions:   []string{"US_EAST_1"},
+	}})
+	require.NoError(t, err)
+
+	want := `- diskSizeGB: 10.5
+  instances: 9007199254740993
+  labels:
+    env: dev
+  name: Cluster0
+  regions:
+    - US_EAST_1
+`
+	assert.Equal(t, want, buf.String())
+}
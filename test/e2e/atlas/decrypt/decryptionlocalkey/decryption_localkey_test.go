@@ -71,3 +71,51 @@ func TestDecryptWithLocalKey(t *testing.T) {
 
 	decryption.LogsAreEqual(t, expectedContents, gotContents)
 }
+
+func TestDecryptWithLocalKeyFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+
+	req := require.New(t)
+	mode, err := internal.TestRunMode()
+	req.NoError(err)
+
+	if mode != internal.TestModeLive {
+		t.Skip("skipping test in snapshot mode")
+	}
+
+	_ = internal.NewAtlasE2ETestGenerator(t, internal.WithSnapshot())
+
+	cliPath, err := internal.AtlasCLIBin()
+	req.NoError(err)
+
+	tmpDir := t.TempDir()
+	inputFile := decryption.GenerateFileName(tmpDir, "input-keyfile")
+	err = decryption.DumpToTemp(filesLocalKey, decryption.GenerateFileName(localKeyTestsInputDir, "input-keyfile"), inputFile)
+	req.NoError(err)
+
+	keyFile := decryption.GenerateFileName(tmpDir, "key")
+	err = decryption.DumpToTemp(filesLocalKey, decryption.GenerateFileName(localKeyTestsInputDir, "key"), keyFile)
+	req.NoError(err)
+
+	expectedContents, err := filesLocalKey.ReadFile(decryption.GenerateFileName(localKeyTestsInputDir, "output-keyfile"))
+	req.NoError(err)
+
+	cmd := exec.Command(cliPath,
+		"logs",
+		"decrypt",
+		"--file",
+		inputFile,
+		"--localKeyFile",
+		keyFile,
+		"-P",
+		internal.ProfileName(),
+	)
+	cmd.Env = os.Environ()
+
+	gotContents, err := internal.RunAndGetStdOut(cmd)
+	req.NoError(err, string(gotContents))
+
+	decryption.LogsAreEqual(t, expectedContents, gotContents)
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentslocalsnapshot
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/mongodb-atlas-cli/atlascli/test/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	atlasv2 "go.mongodb.org/atlas-sdk/v20250312023/admin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	deploymentEntity = "deployments"
+	backupsEntity    = "backups"
+	snapshotsEntity  = "snapshots"
+)
+
+// TestDeploymentsLocalFromSnapshot seeds a local deployment with the latest snapshot of the E2E flex cluster.
+// The snapshot data holds the replica set configuration of the flex cluster, the deployment must still become primary.
+func TestDeploymentsLocalFromSnapshot(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+
+	req := require.New(t)
+	mode, err := internal.TestRunMode()
+	req.NoError(err)
+
+	if mode != internal.TestModeLive {
+		t.Skip("skipping test in snapshot mode")
+	}
+
+	const deploymentName = "test-from-snapshot"
+
+	cliPath, err := internal.AtlasCLIBin()
+	req.NoError(err)
+
+	clusterName, err := internal.FlexInstanceName()
+	req.NoError(err)
+
+	var snapshotID string
+	t.Run("Snapshot List", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			backupsEntity,
+			snapshotsEntity,
+			"list",
+			clusterName,
+			"-o=json",
+			"-P",
+			internal.ProfileName(),
+		)
+		cmd.Env = os.Environ()
+		resp, err := internal.RunAndGetStdOut(cmd)
+		require.NoError(t, err, string(resp))
+
+		var r atlasv2.PaginatedApiAtlasFlexBackupSnapshot20241113
+		require.NoError(t, json.Unmarshal(resp, &r), string(resp))
+		require.NotEmpty(t, r.GetResults())
+		snapshotID = r.GetResults()[0].GetId()
+		require.NotEmpty(t, snapshotID)
+	})
+
+	t.Run("Setup", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			deploymentEntity,
+			"setup",
+			deploymentName,
+			"--type",
+			"local",
+			"--fromSnapshot",
+			clusterName+"/"+snapshotID,
+			"--force",
+			"-P",
+			internal.ProfileName(),
+		)
+
+		cmd.Env = os.Environ()
+
+		r, setupErr := internal.RunAndGetStdOut(cmd)
+		req.NoError(setupErr, string(r))
+	})
+
+	t.Cleanup(func() {
+		cmd := exec.Command(cliPath,
+			deploymentEntity,
+			"delete",
+			deploymentName,
+			"--type",
+			"local",
+			"--force",
+			"-P",
+			internal.ProfileName(),
+		)
+
+		cmd.Env = os.Environ()
+
+		r, delErr := internal.RunAndGetStdOut(cmd)
+		req.NoError(delErr, string(r))
+	})
+
+	var connectionString string
+	t.Run("Get connection string", func(t *testing.T) {
+		cmd := exec.Command(cliPath,
+			deploymentEntity,
+			"connect",
+			deploymentName,
+			"--type",
+			"local",
+			"--connectWith",
+			"connectionString",
+			"-P",
+			internal.ProfileName(),
+		)
+
+		cmd.Env = os.Environ()
+
+		r, err := internal.RunAndGetStdOut(cmd)
+		require.NoError(t, err, string(r))
+
+		connectionString = strings.TrimSpace(string(r))
+	})
+
+	t.Run("Primary serves reads", func(t *testing.T) {
+		ctx := t.Context()
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(connectionString))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, client.Disconnect(ctx))
+		})
+
+		var hello bson.M
+		require.NoError(t, client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello))
+		assert.Equal(t, true, hello["isWritablePrimary"])
+
+		databases, err := client.ListDatabaseNames(ctx, bson.D{})
+		require.NoError(t, err)
+		assert.Contains(t, databases, "admin")
+	})
+}